@@ -0,0 +1,79 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type AABB = AABBOf[float32]
+
+func AABBCopy(result, box *AABB) {
+	aabbCopy(result, box)
+}
+
+// AABBMakeEmpty sets result to the canonical empty box, which has +Inf for
+// Min and -Inf for Max. It is the identity for AABBUnion and AABBExpandByPoint.
+func AABBMakeEmpty(result *AABB) {
+	aabbMakeEmpty(result)
+}
+
+func AABBMakeFromMinMax(result *AABB, minPnt, maxPnt *Point3) {
+	aabbMakeFromMinMax(result, minPnt, maxPnt)
+}
+
+// AABBMakeFromCenterExtents builds a box from its center and its extents,
+// which are half of its size along each axis.
+func AABBMakeFromCenterExtents(result *AABB, center *Point3, extents *Vector3) {
+	aabbMakeFromCenterExtents(result, center, extents)
+}
+
+// AABBMakeFromPoints sets result to the smallest box containing pnts. An
+// empty slice yields the empty box.
+func AABBMakeFromPoints(result *AABB, pnts []Point3) {
+	aabbMakeFromPoints(result, pnts)
+}
+
+func AABBUnion(result, box0, box1 *AABB) {
+	aabbUnion(result, box0, box1)
+}
+
+// AABBIntersection sets result to the overlap of box0 and box1. Boxes that
+// only touch produce a box with zero thickness; disjoint boxes produce the
+// empty box.
+func AABBIntersection(result, box0, box1 *AABB) {
+	aabbIntersection(result, box0, box1)
+}
+
+func AABBExpandByPoint(result, box *AABB, pnt *Point3) {
+	aabbExpandByPoint(result, box, pnt)
+}
+
+// AABBExpandByScalar grows box by margin on every side. The empty box stays
+// empty.
+func AABBExpandByScalar(result, box *AABB, margin float32) {
+	aabbExpandByScalar(result, box, margin)
+}
+
+func AABBGetCenter(result *Point3, box *AABB) {
+	aabbGetCenter(result, box)
+}
+
+// AABBGetExtents sets result to half the size of box along each axis, or zero
+// for the empty box.
+func AABBGetExtents(result *Vector3, box *AABB) {
+	aabbGetExtents(result, box)
+}
+
+func AABBGetSize(result *Vector3, box *AABB) {
+	aabbGetSize(result, box)
+}
+
+// AABBTransformT3 sets result to the smallest box containing box after it has
+// been transformed by tfrm, using Arvo's method.
+func AABBTransformT3(result, box *AABB, tfrm *Transform3) {
+	aabbTransformT3(result, box, tfrm)
+}
+
+// AABBTransformM4 is AABBTransformT3 for an affine Matrix4; the bottom row of
+// mat is ignored.
+func AABBTransformM4(result, box *AABB, mat *Matrix4) {
+	aabbTransformM4(result, box, mat)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"fmt"
+	"math"
+)
+
+// AABBOf is an axis-aligned bounding box. A box whose Min is greater than its
+// Max on any axis is empty; aabbMakeEmpty produces the canonical empty box,
+// which has +Inf for Min and -Inf for Max. Every empty box behaves the same:
+// union and expand-by-point ignore it rather than relying on the infinities.
+// Boxes are closed: points on the faces are inside, and boxes that only
+// touch still intersect.
+type AABBOf[T Float] struct {
+	Min, Max Point3Of[T]
+}
+
+func aabbCopy[T Float](result, box *AABBOf[T]) {
+	p3Copy(&result.Min, &box.Min)
+	p3Copy(&result.Max, &box.Max)
+}
+
+func aabbMakeEmpty[T Float](result *AABBOf[T]) {
+	inf := T(math.Inf(1))
+	p3MakeFromScalar(&result.Min, inf)
+	p3MakeFromScalar(&result.Max, -inf)
+}
+
+func aabbMakeFromMinMax[T Float](result *AABBOf[T], minPnt, maxPnt *Point3Of[T]) {
+	p3Copy(&result.Min, minPnt)
+	p3Copy(&result.Max, maxPnt)
+}
+
+func aabbMakeFromCenterExtents[T Float](result *AABBOf[T], center *Point3Of[T], extents *Vector3Of[T]) {
+	p3SubV3(&result.Min, center, extents)
+	p3AddV3(&result.Max, center, extents)
+}
+
+func aabbMakeFromPoints[T Float](result *AABBOf[T], pnts []Point3Of[T]) {
+	aabbMakeEmpty(result)
+	for i := range pnts {
+		p3MinPerElem(&result.Min, &result.Min, &pnts[i])
+		p3MaxPerElem(&result.Max, &result.Max, &pnts[i])
+	}
+}
+
+func aabbUnion[T Float](result, box0, box1 *AABBOf[T]) {
+	switch {
+	case box1.IsEmpty():
+		aabbCopy(result, box0)
+	case box0.IsEmpty():
+		aabbCopy(result, box1)
+	default:
+		p3MinPerElem(&result.Min, &box0.Min, &box1.Min)
+		p3MaxPerElem(&result.Max, &box0.Max, &box1.Max)
+	}
+}
+
+func aabbIntersection[T Float](result, box0, box1 *AABBOf[T]) {
+	p3MaxPerElem(&result.Min, &box0.Min, &box1.Min)
+	p3MinPerElem(&result.Max, &box0.Max, &box1.Max)
+	if result.IsEmpty() {
+		aabbMakeEmpty(result)
+	}
+}
+
+func aabbExpandByPoint[T Float](result, box *AABBOf[T], pnt *Point3Of[T]) {
+	if box.IsEmpty() {
+		aabbMakeFromMinMax(result, pnt, pnt)
+		return
+	}
+	p3MinPerElem(&result.Min, &box.Min, pnt)
+	p3MaxPerElem(&result.Max, &box.Max, pnt)
+}
+
+func aabbExpandByScalar[T Float](result, box *AABBOf[T], margin T) {
+	if box.IsEmpty() {
+		aabbCopy(result, box)
+		return
+	}
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromScalar(&tmpV3_0, margin)
+	p3SubV3(&result.Min, &box.Min, &tmpV3_0)
+	p3AddV3(&result.Max, &box.Max, &tmpV3_0)
+}
+
+func aabbGetCenter[T Float](result *Point3Of[T], box *AABBOf[T]) {
+	p3Lerp(result, 0.5, &box.Min, &box.Max)
+}
+
+func aabbGetExtents[T Float](result *Vector3Of[T], box *AABBOf[T]) {
+	var tmpV3_0 Vector3Of[T]
+	if box.IsEmpty() {
+		v3MakeFromScalar(result, 0.0)
+		return
+	}
+	p3Sub(&tmpV3_0, &box.Max, &box.Min)
+	v3ScalarMul(result, &tmpV3_0, 0.5)
+}
+
+func aabbGetSize[T Float](result *Vector3Of[T], box *AABBOf[T]) {
+	if box.IsEmpty() {
+		v3MakeFromScalar(result, 0.0)
+		return
+	}
+	p3Sub(result, &box.Max, &box.Min)
+}
+
+// aabbTransformT3 uses Arvo's method in its center/extents form: the new
+// center is the transformed center, and the new extents are the old extents
+// transformed by the element-wise absolute value of the upper 3x3.
+func aabbTransformT3[T Float](result, box *AABBOf[T], tfrm *Transform3Of[T]) {
+	var center, newCenter Point3Of[T]
+	var extents, newExtents Vector3Of[T]
+	var absTfrm Transform3Of[T]
+	if box.IsEmpty() {
+		aabbMakeEmpty(result)
+		return
+	}
+	aabbGetCenter(&center, box)
+	aabbGetExtents(&extents, box)
+	t3MulP3(&newCenter, tfrm, &center)
+	t3AbsPerElem(&absTfrm, tfrm)
+	t3MulV3(&newExtents, &absTfrm, &extents)
+	aabbMakeFromCenterExtents(result, &newCenter, &newExtents)
+}
+
+func aabbTransformM4[T Float](result, box *AABBOf[T], mat *Matrix4Of[T]) {
+	var tfrm Transform3Of[T]
+	v4GetXYZ(&tfrm.col0, &mat.col0)
+	v4GetXYZ(&tfrm.col1, &mat.col1)
+	v4GetXYZ(&tfrm.col2, &mat.col2)
+	v4GetXYZ(&tfrm.col3, &mat.col3)
+	aabbTransformT3(result, box, &tfrm)
+}
+
+func (b *AABBOf[T]) IsEmpty() bool {
+	return b.Min.X > b.Max.X || b.Min.Y > b.Max.Y || b.Min.Z > b.Max.Z
+}
+
+func (b *AABBOf[T]) ContainsPoint(pnt *Point3Of[T]) bool {
+	return pnt.X >= b.Min.X && pnt.X <= b.Max.X &&
+		pnt.Y >= b.Min.Y && pnt.Y <= b.Max.Y &&
+		pnt.Z >= b.Min.Z && pnt.Z <= b.Max.Z
+}
+
+// ContainsAABB reports whether box1 lies entirely inside b. The empty box is
+// contained in every box, including another empty one.
+func (b *AABBOf[T]) ContainsAABB(box1 *AABBOf[T]) bool {
+	if box1.IsEmpty() {
+		return true
+	}
+	return b.ContainsPoint(&box1.Min) && b.ContainsPoint(&box1.Max)
+}
+
+func (b *AABBOf[T]) Intersects(box1 *AABBOf[T]) bool {
+	if b.IsEmpty() || box1.IsEmpty() {
+		return false
+	}
+	return b.Min.X <= box1.Max.X && b.Max.X >= box1.Min.X &&
+		b.Min.Y <= box1.Max.Y && b.Max.Y >= box1.Min.Y &&
+		b.Min.Z <= box1.Max.Z && b.Max.Z >= box1.Min.Z
+}
+
+func (b *AABBOf[T]) SurfaceArea() T {
+	var size Vector3Of[T]
+	aabbGetSize(&size, b)
+	return 2.0 * (size.X*size.Y + size.Y*size.Z + size.Z*size.X)
+}
+
+func (b *AABBOf[T]) Volume() T {
+	var size Vector3Of[T]
+	aabbGetSize(&size, b)
+	return size.X * size.Y * size.Z
+}
+
+func (b *AABBOf[T]) String() string {
+	return fmt.Sprintf("[ %f %f %f ]-[ %f %f %f ]", b.Min.X, b.Min.Y, b.Min.Z, b.Max.X, b.Max.Y, b.Max.Z)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type AABBd = AABBOf[float64]
+
+func AABBdCopy(result, box *AABBd) {
+	aabbCopy(result, box)
+}
+
+func AABBdMakeEmpty(result *AABBd) {
+	aabbMakeEmpty(result)
+}
+
+func AABBdMakeFromMinMax(result *AABBd, minPnt, maxPnt *Point3d) {
+	aabbMakeFromMinMax(result, minPnt, maxPnt)
+}
+
+func AABBdMakeFromCenterExtents(result *AABBd, center *Point3d, extents *Vector3d) {
+	aabbMakeFromCenterExtents(result, center, extents)
+}
+
+func AABBdMakeFromPoints(result *AABBd, pnts []Point3d) {
+	aabbMakeFromPoints(result, pnts)
+}
+
+func AABBdUnion(result, box0, box1 *AABBd) {
+	aabbUnion(result, box0, box1)
+}
+
+func AABBdIntersection(result, box0, box1 *AABBd) {
+	aabbIntersection(result, box0, box1)
+}
+
+func AABBdExpandByPoint(result, box *AABBd, pnt *Point3d) {
+	aabbExpandByPoint(result, box, pnt)
+}
+
+func AABBdExpandByScalar(result, box *AABBd, margin float64) {
+	aabbExpandByScalar(result, box, margin)
+}
+
+func AABBdGetCenter(result *Point3d, box *AABBd) {
+	aabbGetCenter(result, box)
+}
+
+func AABBdGetExtents(result *Vector3d, box *AABBd) {
+	aabbGetExtents(result, box)
+}
+
+func AABBdGetSize(result *Vector3d, box *AABBd) {
+	aabbGetSize(result, box)
+}
+
+func AABBdTransformT3d(result, box *AABBd, tfrm *Transform3d) {
+	aabbTransformT3(result, box, tfrm)
+}
+
+func AABBdTransformM4d(result, box *AABBd, mat *Matrix4d) {
+	aabbTransformM4(result, box, mat)
+}
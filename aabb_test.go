@@ -0,0 +1,140 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"testing"
+)
+
+func makeAABB(minX, minY, minZ, maxX, maxY, maxZ float32) AABB {
+	var box AABB
+	P3MakeFromElems(&box.Min, minX, minY, minZ)
+	P3MakeFromElems(&box.Max, maxX, maxY, maxZ)
+	return box
+}
+
+func TestAABBUnion(t *testing.T) {
+	var canonical, result AABB
+	AABBMakeEmpty(&canonical)
+	box := makeAABB(-1.0, 2.0, 0.0, 1.0, 3.0, 0.5)
+	other := makeAABB(0.0, -5.0, 0.25, 4.0, 2.5, 0.25)
+	inverted := makeAABB(1.0, 1.0, 1.0, 0.0, 0.0, 0.0)
+
+	AABBUnion(&result, &box, &other)
+	if want := makeAABB(-1.0, -5.0, 0.0, 4.0, 3.0, 0.5); result != want {
+		t.Errorf("AABBUnion(%v, %v) = %v, want %v", box.String(), other.String(), result.String(), want.String())
+	}
+	for _, empty := range []AABB{canonical, inverted} {
+		AABBUnion(&result, &box, &empty)
+		if result != box {
+			t.Errorf("AABBUnion(%v, %v) = %v, want the first box", box.String(), empty.String(), result.String())
+		}
+		AABBUnion(&result, &empty, &box)
+		if result != box {
+			t.Errorf("AABBUnion(%v, %v) = %v, want the second box", empty.String(), box.String(), result.String())
+		}
+	}
+	AABBUnion(&result, &inverted, &canonical)
+	if !result.IsEmpty() {
+		t.Errorf("union of two empty boxes = %v, want empty", result.String())
+	}
+}
+
+func TestAABBExpandByPoint(t *testing.T) {
+	var pnt Point3
+	var result AABB
+	P3MakeFromElems(&pnt, 5.0, 5.0, 5.0)
+	inverted := makeAABB(1.0, 1.0, 1.0, 0.0, 0.0, 0.0)
+	AABBExpandByPoint(&result, &inverted, &pnt)
+	if want := makeAABB(5.0, 5.0, 5.0, 5.0, 5.0, 5.0); result != want {
+		t.Errorf("AABBExpandByPoint(%v, %v) = %v, want %v", inverted.String(), pnt, result.String(), want.String())
+	}
+	P3MakeFromElems(&pnt, -1.0, 7.0, 5.0)
+	AABBExpandByPoint(&result, &result, &pnt)
+	if want := makeAABB(-1.0, 5.0, 5.0, 5.0, 7.0, 5.0); result != want {
+		t.Errorf("AABBExpandByPoint = %v, want %v", result.String(), want.String())
+	}
+
+	pnts := []Point3{{X: 1.0, Y: 2.0, Z: 3.0}, {X: -1.0, Y: 0.0, Z: 4.0}}
+	AABBMakeFromPoints(&result, pnts)
+	if want := makeAABB(-1.0, 0.0, 3.0, 1.0, 2.0, 4.0); result != want {
+		t.Errorf("AABBMakeFromPoints = %v, want %v", result.String(), want.String())
+	}
+	AABBMakeFromPoints(&result, nil)
+	if !result.IsEmpty() {
+		t.Errorf("AABBMakeFromPoints(nil) = %v, want empty", result.String())
+	}
+}
+
+func TestAABBIntersection(t *testing.T) {
+	var result AABB
+	box := makeAABB(0.0, 0.0, 0.0, 2.0, 2.0, 2.0)
+	touching := makeAABB(2.0, 0.5, 0.5, 3.0, 1.0, 1.0)
+	disjoint := makeAABB(2.5, 0.0, 0.0, 3.0, 1.0, 1.0)
+
+	if !box.Intersects(&touching) {
+		t.Errorf("%v does not intersect %v, which touches it", box.String(), touching.String())
+	}
+	AABBIntersection(&result, &box, &touching)
+	if want := makeAABB(2.0, 0.5, 0.5, 2.0, 1.0, 1.0); result != want {
+		t.Errorf("AABBIntersection = %v, want %v", result.String(), want.String())
+	}
+	if box.Intersects(&disjoint) {
+		t.Errorf("%v intersects %v", box.String(), disjoint.String())
+	}
+	AABBIntersection(&result, &box, &disjoint)
+	if !result.IsEmpty() || !math.IsInf(float64(result.Min.X), 1) {
+		t.Errorf("AABBIntersection of disjoint boxes = %v, want the canonical empty box", result.String())
+	}
+	if !box.ContainsPoint(&box.Max) || !box.ContainsAABB(&result) || box.ContainsAABB(&disjoint) {
+		t.Errorf("containment of %v is wrong", box.String())
+	}
+}
+
+func TestAABBMeasures(t *testing.T) {
+	var extents Vector3
+	var center Point3
+	box := makeAABB(-1.0, 0.0, 2.0, 1.0, 4.0, 5.0)
+	if got := box.Volume(); got != 24.0 {
+		t.Errorf("Volume() = %v, want 24", got)
+	}
+	if got := box.SurfaceArea(); got != 52.0 {
+		t.Errorf("SurfaceArea() = %v, want 52", got)
+	}
+	AABBGetCenter(&center, &box)
+	AABBGetExtents(&extents, &box)
+	if center != (Point3{X: 0.0, Y: 2.0, Z: 3.5}) || extents != (Vector3{X: 1.0, Y: 2.0, Z: 1.5}) {
+		t.Errorf("center %v, extents %v", center, extents)
+	}
+	inverted := makeAABB(1.0, 1.0, 1.0, 0.0, 0.0, 0.0)
+	if inverted.Volume() != 0.0 || inverted.SurfaceArea() != 0.0 {
+		t.Errorf("empty box has volume %v and area %v", inverted.Volume(), inverted.SurfaceArea())
+	}
+	AABBExpandByScalar(&box, &inverted, 1.0)
+	if !box.IsEmpty() {
+		t.Errorf("AABBExpandByScalar made the empty box %v", box.String())
+	}
+}
+
+func TestAABBTransformT3(t *testing.T) {
+	var tfrm Transform3
+	var translation Vector3
+	var result AABB
+	box := makeAABB(-1.0, -1.0, -1.0, 1.0, 1.0, 1.0)
+	T3MakeRotationZ(&tfrm, g_PI_OVER_2/2.0)
+	V3MakeFromElems(&translation, 10.0, 0.0, 0.0)
+	tfrm.SetTranslation(&translation)
+	AABBTransformT3(&result, &box, &tfrm)
+	r := float32(math.Sqrt2)
+	want := makeAABB(10.0-r, -r, -1.0, 10.0+r, r, 1.0)
+	if !p3Near(&result.Min, &want.Min, 1e-5) || !p3Near(&result.Max, &want.Max, 1e-5) {
+		t.Errorf("AABBTransformT3 = %v, want %v", result.String(), want.String())
+	}
+	AABBMakeEmpty(&box)
+	AABBTransformT3(&result, &box, &tfrm)
+	if !result.IsEmpty() {
+		t.Errorf("AABBTransformT3 of the empty box = %v", result.String())
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+//go:build amd64 && !purego
+
+package vectormath
+
+import "unsafe"
+
+// The kernels in asm_amd64.s use SSE2, which every amd64 processor has,
+// and M4Mul additionally has an AVX version that is selected at startup.
+// Apart from the estimates behind V3RsqrtPerElemFast and the other Fast
+// functions, they perform the same operations in the same order as the Go
+// code, rounding after every multiply and add. With the default GOAMD64=v1
+// the results match the Go code bit for bit.
+// With GOAMD64=v3 or above the compiler fuses the Go code's multiply-adds
+// into FMA instructions, which round once, so the two can differ in the last
+// bits. Build with the purego tag to use the Go code instead.
+
+var useAVX = hasAVX()
+
+func hasAVX() bool {
+	const (
+		osxsave = 1 << 27
+		avx     = 1 << 28
+	)
+	_, _, ecx, _ := cpuid(1, 0)
+	if ecx&osxsave == 0 || ecx&avx == 0 {
+		return false
+	}
+	// The OS must save the XMM and YMM registers on context switches.
+	eax, _ := xgetbv()
+	return eax&6 == 6
+}
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+//go:noescape
+func m4MulSSE(result, mat0, mat1 *Matrix4)
+
+//go:noescape
+func m4MulAVX(result, mat0, mat1 *Matrix4)
+
+//go:noescape
+func m4MulV4SSE(result *Vector4, mat *Matrix4, vec *Vector4)
+
+//go:noescape
+func m4InverseSSE(result, mat *Matrix4)
+
+//go:noescape
+func t3MulSSE(result, tfrm0, tfrm1 *Transform3)
+
+//go:noescape
+func qMulSSE(result, quat0, quat1 *Quat)
+
+//go:noescape
+func v3CrossSSE(result, vec0, vec1 *Vector3)
+
+// rsqrtFastSSE and recipFastSSE operate on four float32s, which also covers
+// the padded Vector3 and Point3; the pad element of the result is garbage.
+//
+//go:noescape
+func rsqrtFastSSE(result, vec unsafe.Pointer)
+
+//go:noescape
+func recipFastSSE(result, vec unsafe.Pointer)
+
+func m4MulF32(result, mat0, mat1 *Matrix4) {
+	if useAVX {
+		m4MulAVX(result, mat0, mat1)
+	} else {
+		m4MulSSE(result, mat0, mat1)
+	}
+}
+
+func m4MulV4F32(result *Vector4, mat *Matrix4, vec *Vector4) {
+	m4MulV4SSE(result, mat, vec)
+}
+
+func m4InverseF32(result, mat *Matrix4) {
+	m4InverseSSE(result, mat)
+}
+
+func t3MulF32(result, tfrm0, tfrm1 *Transform3) {
+	t3MulSSE(result, tfrm0, tfrm1)
+}
+
+func qMulF32(result, quat0, quat1 *Quat) {
+	qMulSSE(result, quat0, quat1)
+}
+
+func v3CrossF32(result, vec0, vec1 *Vector3) {
+	v3CrossSSE(result, vec0, vec1)
+}
+
+func v3RsqrtFastF32(result, vec *Vector3) {
+	rsqrtFastSSE(unsafe.Pointer(result), unsafe.Pointer(vec))
+}
+
+func v3RecipFastF32(result, vec *Vector3) {
+	recipFastSSE(unsafe.Pointer(result), unsafe.Pointer(vec))
+}
+
+func v4RsqrtFastF32(result, vec *Vector4) {
+	rsqrtFastSSE(unsafe.Pointer(result), unsafe.Pointer(vec))
+}
+
+func v4RecipFastF32(result, vec *Vector4) {
+	recipFastSSE(unsafe.Pointer(result), unsafe.Pointer(vec))
+}
+
+func p3RsqrtFastF32(result, pnt *Point3) {
+	rsqrtFastSSE(unsafe.Pointer(result), unsafe.Pointer(pnt))
+}
+
+func p3RecipFastF32(result, pnt *Point3) {
+	recipFastSSE(unsafe.Pointer(result), unsafe.Pointer(pnt))
+}
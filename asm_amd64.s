@@ -0,0 +1,424 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// Vector3, Point3 and Quat are 16 bytes and every matrix column is 16 bytes,
+// so each one fits an XMM register. Every kernel loads all of its inputs
+// before storing, so result may alias any argument.
+
+DATA signW<>+0(SB)/4, $0x00000000
+DATA signW<>+4(SB)/4, $0x00000000
+DATA signW<>+8(SB)/4, $0x00000000
+DATA signW<>+12(SB)/4, $0x80000000
+GLOBL signW<>(SB), RODATA|NOPTR, $16
+
+DATA signXY<>+0(SB)/4, $0x80000000
+DATA signXY<>+4(SB)/4, $0x80000000
+DATA signXY<>+8(SB)/4, $0x00000000
+DATA signXY<>+12(SB)/4, $0x00000000
+GLOBL signXY<>(SB), RODATA|NOPTR, $16
+
+DATA signZW<>+0(SB)/4, $0x00000000
+DATA signZW<>+4(SB)/4, $0x00000000
+DATA signZW<>+8(SB)/4, $0x80000000
+DATA signZW<>+12(SB)/4, $0x80000000
+GLOBL signZW<>(SB), RODATA|NOPTR, $16
+
+DATA one<>+0(SB)/4, $0x3f800000
+GLOBL one<>(SB), RODATA|NOPTR, $4
+
+DATA half4<>+0(SB)/4, $0x3f000000
+DATA half4<>+4(SB)/4, $0x3f000000
+DATA half4<>+8(SB)/4, $0x3f000000
+DATA half4<>+12(SB)/4, $0x3f000000
+GLOBL half4<>(SB), RODATA|NOPTR, $16
+
+DATA threeHalves4<>+0(SB)/4, $0x3fc00000
+DATA threeHalves4<>+4(SB)/4, $0x3fc00000
+DATA threeHalves4<>+8(SB)/4, $0x3fc00000
+DATA threeHalves4<>+12(SB)/4, $0x3fc00000
+GLOBL threeHalves4<>(SB), RODATA|NOPTR, $16
+
+DATA two4<>+0(SB)/4, $0x40000000
+DATA two4<>+4(SB)/4, $0x40000000
+DATA two4<>+8(SB)/4, $0x40000000
+DATA two4<>+12(SB)/4, $0x40000000
+GLOBL two4<>(SB), RODATA|NOPTR, $16
+
+// SHUF sets dst to (a[i0], a[i1], b[i2], b[i3]) for imm = i0 | i1<<2 |
+// i2<<4 | i3<<6.
+#define SHUF(imm, a, b, dst) \
+	MOVAPS a, dst; \
+	SHUFPS $imm, b, dst
+
+// MULCOL4 sets dst to the columns in X0-X3 multiplied by the vector in vec,
+// summing in the same order as m4MulV4. X15 is clobbered.
+#define MULCOL4(vec, dst) \
+	SHUF(0x00, vec, vec, dst); \
+	MULPS X0, dst; \
+	SHUF(0x55, vec, vec, X15); \
+	MULPS X1, X15; \
+	ADDPS X15, dst; \
+	SHUF(0xAA, vec, vec, X15); \
+	MULPS X2, X15; \
+	ADDPS X15, dst; \
+	SHUF(0xFF, vec, vec, X15); \
+	MULPS X3, X15; \
+	ADDPS X15, dst
+
+// MULCOL3 is MULCOL4 without the fourth column, as in t3MulV3.
+#define MULCOL3(vec, dst) \
+	SHUF(0x00, vec, vec, dst); \
+	MULPS X0, dst; \
+	SHUF(0x55, vec, vec, X15); \
+	MULPS X1, X15; \
+	ADDPS X15, dst; \
+	SHUF(0xAA, vec, vec, X15); \
+	MULPS X2, X15; \
+	ADDPS X15, dst
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
+
+// func m4MulSSE(result, mat0, mat1 *Matrix4)
+TEXT ·m4MulSSE(SB), NOSPLIT, $0-24
+	MOVQ result+0(FP), DI
+	MOVQ mat0+8(FP), SI
+	MOVQ mat1+16(FP), DX
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+	MOVUPS 0(DX), X4
+	MOVUPS 16(DX), X5
+	MOVUPS 32(DX), X6
+	MOVUPS 48(DX), X7
+	MULCOL4(X4, X8)
+	MULCOL4(X5, X9)
+	MULCOL4(X6, X10)
+	MULCOL4(X7, X11)
+	MOVUPS X8, 0(DI)
+	MOVUPS X9, 16(DI)
+	MOVUPS X10, 32(DI)
+	MOVUPS X11, 48(DI)
+	RET
+
+// func m4MulAVX(result, mat0, mat1 *Matrix4)
+//
+// Each YMM register holds two columns of mat1, or the same column of mat0
+// twice, so two result columns are computed per pass.
+TEXT ·m4MulAVX(SB), NOSPLIT, $0-24
+	MOVQ result+0(FP), DI
+	MOVQ mat0+8(FP), SI
+	MOVQ mat1+16(FP), DX
+	VBROADCASTF128 0(SI), Y0
+	VBROADCASTF128 16(SI), Y1
+	VBROADCASTF128 32(SI), Y2
+	VBROADCASTF128 48(SI), Y3
+	VMOVUPS 0(DX), Y4
+	VMOVUPS 32(DX), Y5
+
+	VPERMILPS $0x00, Y4, Y6
+	VMULPS Y6, Y0, Y6
+	VPERMILPS $0x55, Y4, Y7
+	VMULPS Y7, Y1, Y7
+	VADDPS Y7, Y6, Y6
+	VPERMILPS $0xAA, Y4, Y7
+	VMULPS Y7, Y2, Y7
+	VADDPS Y7, Y6, Y6
+	VPERMILPS $0xFF, Y4, Y7
+	VMULPS Y7, Y3, Y7
+	VADDPS Y7, Y6, Y6
+
+	VPERMILPS $0x00, Y5, Y8
+	VMULPS Y8, Y0, Y8
+	VPERMILPS $0x55, Y5, Y9
+	VMULPS Y9, Y1, Y9
+	VADDPS Y9, Y8, Y8
+	VPERMILPS $0xAA, Y5, Y9
+	VMULPS Y9, Y2, Y9
+	VADDPS Y9, Y8, Y8
+	VPERMILPS $0xFF, Y5, Y9
+	VMULPS Y9, Y3, Y9
+	VADDPS Y9, Y8, Y8
+
+	VMOVUPS Y6, 0(DI)
+	VMOVUPS Y8, 32(DI)
+	VZEROUPPER
+	RET
+
+// func m4MulV4SSE(result *Vector4, mat *Matrix4, vec *Vector4)
+TEXT ·m4MulV4SSE(SB), NOSPLIT, $0-24
+	MOVQ result+0(FP), DI
+	MOVQ mat+8(FP), SI
+	MOVQ vec+16(FP), DX
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+	MOVUPS 0(DX), X4
+	MULCOL4(X4, X5)
+	MOVUPS X5, 0(DI)
+	RET
+
+// func m4InverseSSE(result, mat *Matrix4)
+//
+// This follows m4Inverse term by term. The matrix is transposed so that
+// with columns (A B C D), (E F G H), (I J K L) and (M N O P) the rows are
+// r0 = (A E I M), r1 = (B F J N), r2 = (C G K O) and r3 = (D H L P), from
+// which each lane of every intermediate is gathered. Where m4Inverse mixes
+// additions and subtractions across the lanes of one vector, the affected
+// lanes of an operand are negated and the other operation is used instead,
+// which gives identical results.
+TEXT ·m4InverseSSE(SB), NOSPLIT, $0-16
+	MOVQ result+0(FP), DI
+	MOVQ mat+8(FP), SI
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+
+	// Transpose into rows.
+	MOVAPS X0, X4
+	UNPCKLPS X1, X4 // (A E B F)
+	MOVAPS X0, X5
+	UNPCKHPS X1, X5 // (C G D H)
+	MOVAPS X2, X6
+	UNPCKLPS X3, X6 // (I M J N)
+	MOVAPS X2, X7
+	UNPCKHPS X3, X7 // (K O L P)
+	SHUF(0x44, X4, X6, X0) // r0
+	SHUF(0xEE, X4, X6, X1) // r1
+	SHUF(0x44, X5, X7, X2) // r2
+	SHUF(0xEE, X5, X7, X3) // r3
+
+	SHUF(0x4E, X2, X1, X4) // (K O B F)
+	SHUF(0x4E, X1, X3, X5) // (J N D H)
+	SHUF(0x4E, X3, X2, X6) // (L P C G)
+	MOVUPS signZW<>(SB), X15
+
+	// X7 = (tmp0 tmp1 tmp2 tmp3), X8 = (tmp4 tmp5 - -)
+	SHUF(0xE4, X3, X2, X7) // (D H K O)
+	MULPS X4, X7
+	SHUF(0xE4, X2, X1, X8) // (C G J N)
+	MULPS X6, X8
+	SUBPS X8, X7
+	SHUF(0xEE, X1, X1, X8) // (J N J N)
+	MULPS X3, X8
+	SHUF(0xEE, X3, X3, X9) // (L P L P)
+	MULPS X1, X9
+	SUBPS X9, X8
+
+	// X9 and X10 hold the second set of tmp0-tmp5 the same way.
+	SHUF(0xEE, X0, X0, X10) // (I M I M)
+	SHUF(0x44, X0, X0, X11) // (A E A E)
+	SHUF(0x44, X1, X3, X9)  // (B F D H)
+	MULPS X10, X9
+	SHUF(0xEE, X1, X3, X12) // (J N L P)
+	MULPS X11, X12
+	SUBPS X12, X9
+	SHUF(0xEE, X2, X2, X12) // (K O K O)
+	MULPS X11, X12
+	MULPS X2, X10
+	SUBPS X12, X10
+
+	// res0
+	SHUF(0xB1, X7, X7, X11) // (tmp1 tmp0 tmp3 tmp2)
+	MULPS X5, X11
+	SHUF(0x1B, X7, X8, X12) // (tmp3 tmp2 tmp5 tmp4)
+	MOVAPS X6, X13
+	XORPS X15, X13          // (L P -C -G)
+	MULPS X13, X12
+	SUBPS X12, X11
+	SHUF(0x11, X8, X7, X12) // (tmp5 tmp4 tmp1 tmp0)
+	MULPS X4, X12
+	SUBPS X12, X11
+
+	// detInv, summing the lanes of r0 * res0 in order
+	MOVAPS X0, X12
+	MULPS X11, X12
+	SHUF(0x55, X12, X12, X13)
+	ADDSS X13, X12
+	SHUF(0xAA, X12, X12, X13)
+	ADDSS X13, X12
+	SHUF(0xFF, X12, X12, X13)
+	ADDSS X13, X12
+	MOVSS one<>(SB), X13
+	DIVSS X12, X13
+	SHUFPS $0x00, X13, X13
+
+	MULPS X13, X11
+	MOVUPS X11, 0(DI)
+
+	// The initial values of res1, res2 and res3, with the lanes that
+	// m4Inverse later subtracts negated.
+	SHUF(0x4E, X0, X0, X12) // (I M A E)
+	SHUF(0x11, X8, X8, X11) // (tmp5 tmp4 tmp5 tmp4)
+	MULPS X12, X11
+	XORPS X15, X11
+	SHUF(0xBB, X7, X7, X8)  // (tmp3 tmp2 tmp3 tmp2)
+	MULPS X12, X8
+	XORPS X15, X8
+	SHUFPS $0x11, X7, X7    // (tmp1 tmp0 tmp1 tmp0)
+	MULPS X12, X7
+	MOVUPS signXY<>(SB), X0
+	XORPS X0, X7
+
+	// res1
+	SHUF(0x4E, X2, X3, X0)   // (K O D H)
+	SHUF(0x1B, X9, X10, X12) // (tmp3 tmp2 tmp5 tmp4)
+	MULPS X12, X0
+	SHUF(0xB1, X10, X9, X12) // (tmp5 tmp4 tmp3 tmp2)
+	MULPS X6, X12
+	SUBPS X12, X0
+	ADDPS X7, X0
+	MULPS X13, X0
+	MOVUPS X0, 16(DI)
+
+	// res2
+	SHUF(0x4E, X3, X1, X0)  // (L P B F)
+	SHUF(0xB1, X9, X9, X12) // (tmp1 tmp0 tmp3 tmp2)
+	MULPS X12, X0
+	SHUF(0x1B, X9, X9, X12) // (tmp3 tmp2 tmp1 tmp0)
+	MULPS X5, X12
+	SUBPS X12, X0
+	ADDPS X11, X0
+	MULPS X13, X0
+	MOVUPS X0, 32(DI)
+
+	// res3
+	SHUF(0x4E, X1, X2, X0)   // (J N C G)
+	SHUF(0x11, X10, X9, X12) // (tmp5 tmp4 tmp1 tmp0)
+	MULPS X12, X0
+	SHUF(0x11, X9, X10, X12) // (tmp1 tmp0 tmp5 tmp4)
+	MULPS X4, X12
+	SUBPS X12, X0
+	ADDPS X8, X0
+	MULPS X13, X0
+	MOVUPS X0, 48(DI)
+	RET
+
+// func t3MulSSE(result, tfrm0, tfrm1 *Transform3)
+TEXT ·t3MulSSE(SB), NOSPLIT, $0-24
+	MOVQ result+0(FP), DI
+	MOVQ tfrm0+8(FP), SI
+	MOVQ tfrm1+16(FP), DX
+	MOVUPS 0(SI), X0
+	MOVUPS 16(SI), X1
+	MOVUPS 32(SI), X2
+	MOVUPS 48(SI), X3
+	MOVUPS 0(DX), X4
+	MOVUPS 16(DX), X5
+	MOVUPS 32(DX), X6
+	MOVUPS 48(DX), X7
+	MULCOL3(X4, X8)
+	MULCOL3(X5, X9)
+	MULCOL3(X6, X10)
+	MULCOL3(X7, X11)
+	ADDPS X3, X11
+	MOVUPS X8, 0(DI)
+	MOVUPS X9, 16(DI)
+	MOVUPS X10, 32(DI)
+	MOVUPS X11, 48(DI)
+	RET
+
+// func qMulSSE(result, quat0, quat1 *Quat)
+//
+// With quat0 = (x y z w), qMul sums w*quat1, (x y z -x)*(w1 w1 w1 x1),
+// (y z x -y)*(z1 x1 y1 y1) and -(z x y z)*(y1 z1 x1 z1).
+TEXT ·qMulSSE(SB), NOSPLIT, $0-24
+	MOVQ result+0(FP), DI
+	MOVQ quat0+8(FP), SI
+	MOVQ quat1+16(FP), DX
+	MOVUPS 0(SI), X0
+	MOVUPS 0(DX), X1
+	MOVUPS signW<>(SB), X15
+	SHUF(0xFF, X0, X0, X2)
+	MULPS X1, X2
+	SHUF(0x24, X0, X0, X3)
+	SHUF(0x3F, X1, X1, X4)
+	MULPS X4, X3
+	XORPS X15, X3
+	ADDPS X3, X2
+	SHUF(0x49, X0, X0, X3)
+	SHUF(0x52, X1, X1, X4)
+	MULPS X4, X3
+	XORPS X15, X3
+	ADDPS X3, X2
+	SHUF(0x92, X0, X0, X3)
+	SHUF(0x89, X1, X1, X4)
+	MULPS X4, X3
+	SUBPS X3, X2
+	MOVUPS X2, 0(DI)
+	RET
+
+// func v3CrossSSE(result, vec0, vec1 *Vector3)
+TEXT ·v3CrossSSE(SB), NOSPLIT, $0-24
+	MOVQ result+0(FP), DI
+	MOVQ vec0+8(FP), SI
+	MOVQ vec1+16(FP), DX
+	MOVUPS 0(SI), X0
+	MOVUPS 0(DX), X1
+	SHUF(0xC9, X0, X0, X2) // (y z x)
+	SHUF(0xD2, X1, X1, X3) // (z x y)
+	MULPS X3, X2
+	SHUF(0xD2, X0, X0, X4)
+	SHUF(0xC9, X1, X1, X5)
+	MULPS X5, X4
+	SUBPS X4, X2
+	MOVUPS X2, 0(DI)
+	RET
+
+// func rsqrtFastSSE(result, vec unsafe.Pointer)
+//
+// Refines the 12-bit RSQRTPS estimate y with one Newton-Raphson step,
+// y * (1.5 - 0.5*x*y*y). Unlike the kernels above, this does not match the
+// Go code.
+TEXT ·rsqrtFastSSE(SB), NOSPLIT, $0-16
+	MOVQ result+0(FP), DI
+	MOVQ vec+8(FP), SI
+	MOVUPS 0(SI), X0
+	RSQRTPS X0, X1
+	MULPS half4<>(SB), X0
+	MULPS X1, X0
+	MULPS X1, X0
+	MOVUPS threeHalves4<>(SB), X2
+	SUBPS X0, X2
+	MULPS X2, X1
+	MOVUPS X1, 0(DI)
+	RET
+
+// func recipFastSSE(result, vec unsafe.Pointer)
+//
+// Refines the 12-bit RCPPS estimate y with one Newton-Raphson step,
+// y * (2 - x*y).
+TEXT ·recipFastSSE(SB), NOSPLIT, $0-16
+	MOVQ result+0(FP), DI
+	MOVQ vec+8(FP), SI
+	MOVUPS 0(SI), X0
+	RCPPS X0, X1
+	MULPS X1, X0
+	MOVUPS two4<>(SB), X2
+	SUBPS X0, X2
+	MULPS X2, X1
+	MOVUPS X1, 0(DI)
+	RET
@@ -0,0 +1,147 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+//go:build amd64 && !purego
+
+package vectormath
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+//go:noinline
+func mulAdd[T Float](a, b, c T) T {
+	return a*b + c
+}
+
+// goFusesMulAdd reports whether the compiler turned a*b+c into a fused
+// multiply-add, as it does for GOAMD64=v3 and above. With a = 1+2^-12 and
+// c = -(1+2^-11), the product rounded to float32 cancels c exactly, while
+// the fused result keeps the 2^-24 term.
+func goFusesMulAdd() bool {
+	a := float32(1.0 + 1.0/(1<<12))
+	c := float32(-(1.0 + 1.0/(1<<11)))
+	return mulAdd(a, a, c) != 0.0
+}
+
+func randAsmMatrix(rng *rand.Rand) Matrix4 {
+	var mat Matrix4
+	for col := 0; col < 4; col++ {
+		for row := 0; row < 4; row++ {
+			mat.SetElem(col, row, float32(rng.NormFloat64()*3.0))
+		}
+	}
+	return mat
+}
+
+// asmMatches compares the assembly results against the Go code. Without
+// fused multiply-adds they must agree bit for bit. With them the Go code
+// rounds each product-sum once instead of twice, so only agreement to
+// within a relative tolerance is expected.
+func asmMatches(t *testing.T, what string, got, want []float32, fused bool) {
+	t.Helper()
+	scale := float32(1.0)
+	for _, w := range want {
+		scale = max(scale, abs(w))
+	}
+	for i := range got {
+		if fused {
+			if !near(got[i], want[i], 1e-5*scale) {
+				t.Errorf("%s: element %d = %v, Go code gives %v", what, i, got[i], want[i])
+				return
+			}
+		} else if math.Float32bits(got[i]) != math.Float32bits(want[i]) {
+			t.Errorf("%s: element %d = %v, Go code gives %v", what, i, got[i], want[i])
+			return
+		}
+	}
+}
+
+func m4Elems(mat *Matrix4) []float32 {
+	return []float32{
+		mat.col0.X, mat.col0.Y, mat.col0.Z, mat.col0.W,
+		mat.col1.X, mat.col1.Y, mat.col1.Z, mat.col1.W,
+		mat.col2.X, mat.col2.Y, mat.col2.Z, mat.col2.W,
+		mat.col3.X, mat.col3.Y, mat.col3.Z, mat.col3.W,
+	}
+}
+
+func TestAsmMatchesGo(t *testing.T) {
+	fused := goFusesMulAdd()
+	t.Logf("AVX %v, Go code fuses multiply-adds %v", useAVX, fused)
+	rng := rand.New(rand.NewSource(5))
+	for n := 0; n < 2000; n++ {
+		mat0, mat1 := randAsmMatrix(rng), randAsmMatrix(rng)
+		var want, got Matrix4
+		m4Mul(&want, &mat0, &mat1)
+		m4MulSSE(&got, &mat0, &mat1)
+		asmMatches(t, "m4MulSSE", m4Elems(&got), m4Elems(&want), fused)
+		if useAVX {
+			m4MulAVX(&got, &mat0, &mat1)
+			asmMatches(t, "m4MulAVX", m4Elems(&got), m4Elems(&want), fused)
+		}
+		got = mat0
+		M4Mul(&got, &got, &mat1)
+		asmMatches(t, "M4Mul in place", m4Elems(&got), m4Elems(&want), fused)
+
+		// Orthonormal plus a translation keeps the inverse well conditioned,
+		// so the tolerance covers the fused rounding.
+		var rot Matrix4
+		var axis Vector3
+		V3MakeFromElems(&axis, mat0.col0.X, mat0.col0.Y, mat0.col0.Z)
+		V3Normalize(&axis, &axis)
+		M4MakeRotationAxis(&rot, mat0.col1.X, &axis)
+		rot.col3 = mat1.col3
+		m4Inverse(&want, &rot)
+		m4InverseSSE(&got, &rot)
+		asmMatches(t, "m4InverseSSE", m4Elems(&got), m4Elems(&want), fused)
+
+		var vec, wantV4, gotV4 Vector4
+		vec = mat1.col2
+		m4MulV4(&wantV4, &mat0, &vec)
+		m4MulV4SSE(&gotV4, &mat0, &vec)
+		asmMatches(t, "m4MulV4SSE", []float32{gotV4.X, gotV4.Y, gotV4.Z, gotV4.W},
+			[]float32{wantV4.X, wantV4.Y, wantV4.Z, wantV4.W}, fused)
+
+		var tfrm0, tfrm1, wantT3, gotT3 Transform3
+		for col := 0; col < 4; col++ {
+			for row := 0; row < 3; row++ {
+				tfrm0.SetElem(col, row, mat0.GetElem(col, row))
+				tfrm1.SetElem(col, row, mat1.GetElem(col, row))
+			}
+		}
+		t3Mul(&wantT3, &tfrm0, &tfrm1)
+		t3MulSSE(&gotT3, &tfrm0, &tfrm1)
+		asmMatches(t, "t3MulSSE", t3Elems(&gotT3), t3Elems(&wantT3), fused)
+
+		quat0 := Quat(mat0.col0)
+		quat1 := Quat(mat1.col0)
+		var wantQ, gotQ Quat
+		qMul(&wantQ, &quat0, &quat1)
+		qMulSSE(&gotQ, &quat0, &quat1)
+		asmMatches(t, "qMulSSE", []float32{gotQ.X, gotQ.Y, gotQ.Z, gotQ.W},
+			[]float32{wantQ.X, wantQ.Y, wantQ.Z, wantQ.W}, fused)
+
+		var vec0, vec1, wantV3, gotV3 Vector3
+		V3MakeFromElems(&vec0, mat0.col1.X, mat0.col1.Y, mat0.col1.Z)
+		V3MakeFromElems(&vec1, mat1.col1.X, mat1.col1.Y, mat1.col1.Z)
+		v3Cross(&wantV3, &vec0, &vec1)
+		v3CrossSSE(&gotV3, &vec0, &vec1)
+		asmMatches(t, "v3CrossSSE", []float32{gotV3.X, gotV3.Y, gotV3.Z},
+			[]float32{wantV3.X, wantV3.Y, wantV3.Z}, fused)
+		if t.Failed() {
+			return
+		}
+	}
+}
+
+func t3Elems(tfrm *Transform3) []float32 {
+	return []float32{
+		tfrm.col0.X, tfrm.col0.Y, tfrm.col0.Z,
+		tfrm.col1.X, tfrm.col1.Y, tfrm.col1.Z,
+		tfrm.col2.X, tfrm.col2.Y, tfrm.col2.Z,
+		tfrm.col3.X, tfrm.col3.Y, tfrm.col3.Z,
+	}
+}
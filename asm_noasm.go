@@ -0,0 +1,58 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+//go:build !amd64 || purego
+
+package vectormath
+
+// A software estimate refined to the accuracy of the SSE versions is no
+// faster than dividing, so V3RsqrtPerElemFast and the other Fast functions
+// are the same as their precise counterparts here.
+
+func m4MulF32(result, mat0, mat1 *Matrix4) {
+	m4Mul(result, mat0, mat1)
+}
+
+func m4MulV4F32(result *Vector4, mat *Matrix4, vec *Vector4) {
+	m4MulV4(result, mat, vec)
+}
+
+func m4InverseF32(result, mat *Matrix4) {
+	m4Inverse(result, mat)
+}
+
+func t3MulF32(result, tfrm0, tfrm1 *Transform3) {
+	t3Mul(result, tfrm0, tfrm1)
+}
+
+func qMulF32(result, quat0, quat1 *Quat) {
+	qMul(result, quat0, quat1)
+}
+
+func v3CrossF32(result, vec0, vec1 *Vector3) {
+	v3Cross(result, vec0, vec1)
+}
+
+func v3RsqrtFastF32(result, vec *Vector3) {
+	v3RsqrtPerElem(result, vec)
+}
+
+func v3RecipFastF32(result, vec *Vector3) {
+	v3RecipPerElem(result, vec)
+}
+
+func v4RsqrtFastF32(result, vec *Vector4) {
+	v4RsqrtPerElem(result, vec)
+}
+
+func v4RecipFastF32(result, vec *Vector4) {
+	v4RecipPerElem(result, vec)
+}
+
+func p3RsqrtFastF32(result, pnt *Point3) {
+	p3RsqrtPerElem(result, pnt)
+}
+
+func p3RecipFastF32(result, pnt *Point3) {
+	p3RecipPerElem(result, pnt)
+}
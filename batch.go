@@ -0,0 +1,58 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// T3MulP3s transforms each point in pnts by tfrm. It panics if result and
+// pnts differ in length. result may be pnts itself, but must not otherwise
+// overlap it.
+func T3MulP3s(result []Point3, tfrm *Transform3, pnts []Point3) {
+	t3MulP3s(result, tfrm, pnts)
+}
+
+// T3MulV3s transforms each vector in vecs by tfrm. It panics if result and
+// vecs differ in length. result may be vecs itself, but must not otherwise
+// overlap it.
+func T3MulV3s(result []Vector3, tfrm *Transform3, vecs []Vector3) {
+	t3MulV3s(result, tfrm, vecs)
+}
+
+/*******/
+
+// M4MulP3s transforms each point in pnts by mat. It panics if result and pnts
+// differ in length.
+func M4MulP3s(result []Vector4, mat *Matrix4, pnts []Point3) {
+	m4MulP3s(result, mat, pnts)
+}
+
+// M4MulV4s transforms each vector in vecs by mat. It panics if result and vecs
+// differ in length. result may be vecs itself, but must not otherwise overlap
+// it.
+func M4MulV4s(result []Vector4, mat *Matrix4, vecs []Vector4) {
+	m4MulV4s(result, mat, vecs)
+}
+
+// M4ProjectP3s transforms each point in pnts by mat and divides by w. It
+// panics if result and pnts differ in length. result may be pnts itself, but
+// must not otherwise overlap it.
+func M4ProjectP3s(result []Point3, mat *Matrix4, pnts []Point3) {
+	m4ProjectP3s(result, mat, pnts)
+}
+
+/*******/
+
+// V3NormalizeV3s normalizes each vector in vecs. It panics if result and vecs
+// differ in length. result may be vecs itself, but must not otherwise overlap
+// it.
+func V3NormalizeV3s(result, vecs []Vector3) {
+	v3NormalizeV3s(result, vecs)
+}
+
+/*******/
+
+// QRotateV3s rotates each vector in vecs by unitQuat. It panics if result and
+// vecs differ in length. result may be vecs itself, but must not otherwise
+// overlap it.
+func QRotateV3s(result []Vector3, unitQuat *Quat, vecs []Vector3) {
+	qRotateV3s(result, unitQuat, vecs)
+}
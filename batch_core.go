@@ -0,0 +1,112 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// The batch functions below apply one operation across a slice. result must
+// have the same length as the input slice, and may be the same slice to
+// transform in place. Each element is read before it is written, which is
+// only safe when result and the input are identical: if they partially
+// overlap, an element can be overwritten before it is read. Matrix elements
+// are loaded once per call rather than once per element.
+
+func t3MulP3s[T Float](result []Point3Of[T], tfrm *Transform3Of[T], pnts []Point3Of[T]) {
+	if len(result) != len(pnts) {
+		panic("vectormath: slice length mismatch")
+	}
+	c0, c1, c2, c3 := tfrm.col0, tfrm.col1, tfrm.col2, tfrm.col3
+	for i := range pnts {
+		x, y, z := pnts[i].X, pnts[i].Y, pnts[i].Z
+		result[i].X = c0.X*x + c1.X*y + c2.X*z + c3.X
+		result[i].Y = c0.Y*x + c1.Y*y + c2.Y*z + c3.Y
+		result[i].Z = c0.Z*x + c1.Z*y + c2.Z*z + c3.Z
+	}
+}
+
+func t3MulV3s[T Float](result []Vector3Of[T], tfrm *Transform3Of[T], vecs []Vector3Of[T]) {
+	if len(result) != len(vecs) {
+		panic("vectormath: slice length mismatch")
+	}
+	c0, c1, c2 := tfrm.col0, tfrm.col1, tfrm.col2
+	for i := range vecs {
+		x, y, z := vecs[i].X, vecs[i].Y, vecs[i].Z
+		result[i].X = c0.X*x + c1.X*y + c2.X*z
+		result[i].Y = c0.Y*x + c1.Y*y + c2.Y*z
+		result[i].Z = c0.Z*x + c1.Z*y + c2.Z*z
+	}
+}
+
+func m4MulP3s[T Float](result []Vector4Of[T], mat *Matrix4Of[T], pnts []Point3Of[T]) {
+	if len(result) != len(pnts) {
+		panic("vectormath: slice length mismatch")
+	}
+	c0, c1, c2, c3 := mat.col0, mat.col1, mat.col2, mat.col3
+	for i := range pnts {
+		x, y, z := pnts[i].X, pnts[i].Y, pnts[i].Z
+		result[i].X = c0.X*x + c1.X*y + c2.X*z + c3.X
+		result[i].Y = c0.Y*x + c1.Y*y + c2.Y*z + c3.Y
+		result[i].Z = c0.Z*x + c1.Z*y + c2.Z*z + c3.Z
+		result[i].W = c0.W*x + c1.W*y + c2.W*z + c3.W
+	}
+}
+
+func m4MulV4s[T Float](result []Vector4Of[T], mat *Matrix4Of[T], vecs []Vector4Of[T]) {
+	if len(result) != len(vecs) {
+		panic("vectormath: slice length mismatch")
+	}
+	c0, c1, c2, c3 := mat.col0, mat.col1, mat.col2, mat.col3
+	for i := range vecs {
+		x, y, z, w := vecs[i].X, vecs[i].Y, vecs[i].Z, vecs[i].W
+		result[i].X = c0.X*x + c1.X*y + c2.X*z + c3.X*w
+		result[i].Y = c0.Y*x + c1.Y*y + c2.Y*z + c3.Y*w
+		result[i].Z = c0.Z*x + c1.Z*y + c2.Z*z + c3.Z*w
+		result[i].W = c0.W*x + c1.W*y + c2.W*z + c3.W*w
+	}
+}
+
+// m4ProjectP3s transforms each point by mat and divides by the resulting w,
+// taking points through a projection matrix to normalized device
+// coordinates. Points with w = 0 come out infinite or NaN.
+func m4ProjectP3s[T Float](result []Point3Of[T], mat *Matrix4Of[T], pnts []Point3Of[T]) {
+	if len(result) != len(pnts) {
+		panic("vectormath: slice length mismatch")
+	}
+	c0, c1, c2, c3 := mat.col0, mat.col1, mat.col2, mat.col3
+	for i := range pnts {
+		x, y, z := pnts[i].X, pnts[i].Y, pnts[i].Z
+		wInv := 1.0 / (c0.W*x + c1.W*y + c2.W*z + c3.W)
+		result[i].X = (c0.X*x + c1.X*y + c2.X*z + c3.X) * wInv
+		result[i].Y = (c0.Y*x + c1.Y*y + c2.Y*z + c3.Y) * wInv
+		result[i].Z = (c0.Z*x + c1.Z*y + c2.Z*z + c3.Z) * wInv
+	}
+}
+
+func v3NormalizeV3s[T Float](result, vecs []Vector3Of[T]) {
+	if len(result) != len(vecs) {
+		panic("vectormath: slice length mismatch")
+	}
+	for i := range vecs {
+		x, y, z := vecs[i].X, vecs[i].Y, vecs[i].Z
+		lenInv := 1.0 / sqrt(x*x+y*y+z*z)
+		result[i].X = x * lenInv
+		result[i].Y = y * lenInv
+		result[i].Z = z * lenInv
+	}
+}
+
+// qRotateV3s converts quat to a matrix once, which is cheaper than QRotate
+// for more than a couple of vectors.
+func qRotateV3s[T Float](result []Vector3Of[T], unitQuat *QuatOf[T], vecs []Vector3Of[T]) {
+	var rot Matrix3Of[T]
+	if len(result) != len(vecs) {
+		panic("vectormath: slice length mismatch")
+	}
+	m3MakeFromQ(&rot, unitQuat)
+	c0, c1, c2 := rot.col0, rot.col1, rot.col2
+	for i := range vecs {
+		x, y, z := vecs[i].X, vecs[i].Y, vecs[i].Z
+		result[i].X = c0.X*x + c1.X*y + c2.X*z
+		result[i].Y = c0.Y*x + c1.Y*y + c2.Y*z
+		result[i].Z = c0.Z*x + c1.Z*y + c2.Z*z
+	}
+}
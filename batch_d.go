@@ -0,0 +1,38 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func T3dMulP3s(result []Point3d, tfrm *Transform3d, pnts []Point3d) {
+	t3MulP3s(result, tfrm, pnts)
+}
+
+func T3dMulV3s(result []Vector3d, tfrm *Transform3d, vecs []Vector3d) {
+	t3MulV3s(result, tfrm, vecs)
+}
+
+/*******/
+
+func M4dMulP3s(result []Vector4d, mat *Matrix4d, pnts []Point3d) {
+	m4MulP3s(result, mat, pnts)
+}
+
+func M4dMulV4s(result []Vector4d, mat *Matrix4d, vecs []Vector4d) {
+	m4MulV4s(result, mat, vecs)
+}
+
+func M4dProjectP3s(result []Point3d, mat *Matrix4d, pnts []Point3d) {
+	m4ProjectP3s(result, mat, pnts)
+}
+
+/*******/
+
+func V3dNormalizeV3s(result, vecs []Vector3d) {
+	v3NormalizeV3s(result, vecs)
+}
+
+/*******/
+
+func QdRotateV3s(result []Vector3d, unitQuat *Quatd, vecs []Vector3d) {
+	qRotateV3s(result, unitQuat, vecs)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func makeBatchTransform() Transform3 {
+	var tfrm Transform3
+	var axis, translation Vector3
+	V3MakeFromElems(&axis, 1.0, 2.0, -2.0)
+	V3Normalize(&axis, &axis)
+	V3MakeFromElems(&translation, 3.0, -1.0, 0.5)
+	T3MakeRotationAxis(&tfrm, 0.8, &axis)
+	tfrm.SetTranslation(&translation)
+	return tfrm
+}
+
+func makeBatchPoints(n int) []Point3 {
+	rng := rand.New(rand.NewSource(int64(n)))
+	pnts := make([]Point3, n)
+	for i := range pnts {
+		P3MakeFromElems(&pnts[i], rng.Float32()*10.0-5.0, rng.Float32()*10.0-5.0, rng.Float32()*10.0-5.0)
+	}
+	return pnts
+}
+
+func TestT3MulP3s(t *testing.T) {
+	tfrm := makeBatchTransform()
+	pnts := makeBatchPoints(17)
+	result := make([]Point3, len(pnts))
+	T3MulP3s(result, &tfrm, pnts)
+	for i := range pnts {
+		var want Point3
+		T3MulP3(&want, &tfrm, &pnts[i])
+		if !p3Near(&result[i], &want, 1e-6) {
+			t.Errorf("T3MulP3s[%d] = %v, want %v", i, result[i], want)
+		}
+	}
+
+	// In place gives the same answer.
+	T3MulP3s(pnts, &tfrm, pnts)
+	for i := range pnts {
+		if pnts[i] != result[i] {
+			t.Errorf("in place T3MulP3s[%d] = %v, want %v", i, pnts[i], result[i])
+		}
+	}
+}
+
+func TestBatchMatchesPerElement(t *testing.T) {
+	var quat Quat
+	var mat, proj Matrix4
+	tfrm := makeBatchTransform()
+	pnts := makeBatchPoints(9)
+	vecs := make([]Vector3, len(pnts))
+	vec4s := make([]Vector4, len(pnts))
+	for i := range pnts {
+		V3MakeFromP3(&vecs[i], &pnts[i])
+		V4MakeFromP3(&vec4s[i], &pnts[i])
+	}
+	QMakeRotationY(&quat, -1.3)
+	M4MakeFromT3(&mat, &tfrm)
+	M4MakePerspective(&proj, 1.0, 1.5, 0.1, 100.0)
+
+	tfrmV3 := make([]Vector3, len(vecs))
+	rotV3 := make([]Vector3, len(vecs))
+	unitV3 := make([]Vector3, len(vecs))
+	mulP3 := make([]Vector4, len(vecs))
+	mulV4 := make([]Vector4, len(vecs))
+	projP3 := make([]Point3, len(vecs))
+	T3MulV3s(tfrmV3, &tfrm, vecs)
+	QRotateV3s(rotV3, &quat, vecs)
+	V3NormalizeV3s(unitV3, vecs)
+	M4MulP3s(mulP3, &mat, pnts)
+	M4MulV4s(mulV4, &mat, vec4s)
+	M4ProjectP3s(projP3, &proj, pnts)
+	for i := range vecs {
+		var wantV3 Vector3
+		var wantV4 Vector4
+		T3MulV3(&wantV3, &tfrm, &vecs[i])
+		if !v3Near(&tfrmV3[i], &wantV3, 1e-6) {
+			t.Errorf("T3MulV3s[%d] = %v, want %v", i, tfrmV3[i], wantV3)
+		}
+		QRotate(&wantV3, &quat, &vecs[i])
+		if !v3Near(&rotV3[i], &wantV3, 1e-5) {
+			t.Errorf("QRotateV3s[%d] = %v, want %v", i, rotV3[i], wantV3)
+		}
+		V3Normalize(&wantV3, &vecs[i])
+		if !v3Near(&unitV3[i], &wantV3, 1e-6) {
+			t.Errorf("V3NormalizeV3s[%d] = %v, want %v", i, unitV3[i], wantV3)
+		}
+		M4MulP3(&wantV4, &mat, &pnts[i])
+		if !v4Near(&mulP3[i], &wantV4, 1e-6) {
+			t.Errorf("M4MulP3s[%d] = %v, want %v", i, mulP3[i], wantV4)
+		}
+		M4MulV4(&wantV4, &mat, &vec4s[i])
+		if !v4Near(&mulV4[i], &wantV4, 1e-6) {
+			t.Errorf("M4MulV4s[%d] = %v, want %v", i, mulV4[i], wantV4)
+		}
+		M4MulP3(&wantV4, &proj, &pnts[i])
+		wantP3 := Point3{X: wantV4.X / wantV4.W, Y: wantV4.Y / wantV4.W, Z: wantV4.Z / wantV4.W}
+		if !p3Near(&projP3[i], &wantP3, 1e-4) {
+			t.Errorf("M4ProjectP3s[%d] = %v, want %v", i, projP3[i], wantP3)
+		}
+	}
+}
+
+func TestBatchLengthMismatch(t *testing.T) {
+	tfrm := makeBatchTransform()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("T3MulP3s did not panic on a length mismatch")
+		}
+	}()
+	T3MulP3s(make([]Point3, 3), &tfrm, make([]Point3, 4))
+}
+
+// Each batch benchmark has a per-element counterpart calling the pointer
+// function in a loop over the same 1024 elements.
+
+const benchBatchLen = 1024
+
+var benchBatchP3 []Point3
+var benchBatchV3 []Vector3
+
+func BenchmarkT3MulP3s(b *testing.B) {
+	tfrm := makeBatchTransform()
+	pnts := makeBatchPoints(benchBatchLen)
+	result := make([]Point3, len(pnts))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		T3MulP3s(result, &tfrm, pnts)
+	}
+	benchBatchP3 = result
+}
+
+func BenchmarkT3MulP3Loop(b *testing.B) {
+	tfrm := makeBatchTransform()
+	pnts := makeBatchPoints(benchBatchLen)
+	result := make([]Point3, len(pnts))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range pnts {
+			T3MulP3(&result[j], &tfrm, &pnts[j])
+		}
+	}
+	benchBatchP3 = result
+}
+
+func BenchmarkQRotateV3s(b *testing.B) {
+	var quat Quat
+	QMakeRotationY(&quat, 0.4)
+	vecs := make([]Vector3, benchBatchLen)
+	for i, pnt := range makeBatchPoints(benchBatchLen) {
+		V3MakeFromP3(&vecs[i], &pnt)
+	}
+	result := make([]Vector3, len(vecs))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		QRotateV3s(result, &quat, vecs)
+	}
+	benchBatchV3 = result
+}
+
+func BenchmarkQRotateLoop(b *testing.B) {
+	var quat Quat
+	QMakeRotationY(&quat, 0.4)
+	vecs := make([]Vector3, benchBatchLen)
+	for i, pnt := range makeBatchPoints(benchBatchLen) {
+		V3MakeFromP3(&vecs[i], &pnt)
+	}
+	result := make([]Vector3, len(vecs))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range vecs {
+			QRotate(&result[j], &quat, &vecs[j])
+		}
+	}
+	benchBatchV3 = result
+}
+
+func BenchmarkV3NormalizeV3s(b *testing.B) {
+	vecs := make([]Vector3, benchBatchLen)
+	for i, pnt := range makeBatchPoints(benchBatchLen) {
+		V3MakeFromP3(&vecs[i], &pnt)
+	}
+	result := make([]Vector3, len(vecs))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		V3NormalizeV3s(result, vecs)
+	}
+	benchBatchV3 = result
+}
+
+func BenchmarkV3NormalizeLoop(b *testing.B) {
+	vecs := make([]Vector3, benchBatchLen)
+	for i, pnt := range makeBatchPoints(benchBatchLen) {
+		V3MakeFromP3(&vecs[i], &pnt)
+	}
+	result := make([]Vector3, len(vecs))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range vecs {
+			V3Normalize(&result[j], &vecs[j])
+		}
+	}
+	benchBatchV3 = result
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMachineEpsilon(t *testing.T) {
+	type meters float32
+	if eps := machineEpsilon[float32](); eps != 1.0/(1<<23) {
+		t.Errorf("machineEpsilon[float32]() = %v", eps)
+	}
+	if eps := machineEpsilon[float64](); eps != 1.0/(1<<52) {
+		t.Errorf("machineEpsilon[float64]() = %v", eps)
+	}
+	if eps := machineEpsilon[meters](); eps != 1.0/(1<<23) {
+		t.Errorf("machineEpsilon[meters]() = %v", eps)
+	}
+}
+
+// TestPrecisionsAgree runs the same operations through the float32 and
+// float64 functions, which share one generic implementation, and checks
+// that they differ only by float32 rounding.
+func TestPrecisionsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	elem := func() float32 { return float32(rng.Float64()*4.0 - 2.0) }
+	for i := 0; i < 200; i++ {
+		var axis, vec Vector3
+		var quat0, quat1, slerp Quat
+		var mat0, mat1, prod, inv Matrix4
+		var axisd, vecd Vector3d
+		var quat0d, quat1d, slerpd Quatd
+		var mat0d, mat1d, prodd, invd Matrix4d
+		var want Matrix4
+		var wantQ Quat
+		var wantV Vector3
+
+		V3MakeFromElems(&axis, elem(), elem(), elem())
+		V3Normalize(&axis, &axis)
+		V3MakeFromElems(&vec, elem(), elem(), elem())
+		QMakeRotationAxis(&quat0, elem(), &axis)
+		QMakeRotationX(&quat1, elem())
+		M4MakeFromQV3(&mat0, &quat0, &vec)
+		M4MakeRotationZYX(&mat1, &vec)
+		M4AppendScale(&mat1, &mat1, &axis)
+
+		V3dMakeFromV3(&axisd, &axis)
+		V3dMakeFromV3(&vecd, &vec)
+		QdMakeFromQ(&quat0d, &quat0)
+		QdMakeFromQ(&quat1d, &quat1)
+		M4dMakeFromM4(&mat0d, &mat0)
+		M4dMakeFromM4(&mat1d, &mat1)
+
+		M4Mul(&prod, &mat0, &mat1)
+		M4dMul(&prodd, &mat0d, &mat1d)
+		M4MakeFromM4d(&want, &prodd)
+		if !m4Near(&prod, &want, 1e-5) {
+			t.Errorf("M4Mul = %v, M4dMul = %v", prod.String(), want.String())
+		}
+		M4Inverse(&inv, &mat0)
+		M4dInverse(&invd, &mat0d)
+		M4MakeFromM4d(&want, &invd)
+		if !m4Near(&inv, &want, 1e-5) {
+			t.Errorf("M4Inverse = %v, M4dInverse = %v", inv.String(), want.String())
+		}
+		QSlerp(&slerp, 0.3, &quat0, &quat1)
+		QdSlerp(&slerpd, 0.3, &quat0d, &quat1d)
+		QMakeFromQd(&wantQ, &slerpd)
+		if !qNear(&slerp, &wantQ, 1e-5) {
+			t.Errorf("QSlerp = %v, QdSlerp = %v", slerp, wantQ)
+		}
+		V3Normalize(&vec, &vec)
+		V3dNormalize(&vecd, &vecd)
+		V3MakeFromV3d(&wantV, &vecd)
+		if !v3Near(&vec, &wantV, 1e-6) {
+			t.Errorf("V3Normalize = %v, V3dNormalize = %v", vec, wantV)
+		}
+	}
+}
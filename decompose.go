@@ -0,0 +1,49 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// M3Decompose factors mat as rotation * shear * scale, where shear is the unit
+// upper triangular matrix with shear.X, shear.Y and shear.Z in its XY, XZ and
+// YZ entries. The rotation's x axis follows mat's first column, and a
+// reflection is always reported as a negative Z scale. shear may be nil to
+// discard any shear. It returns false, leaving the results unchanged, if the
+// columns of mat are linearly dependent to within rounding: a column is
+// rejected when its component orthogonal to the earlier columns is at most
+// 16 machine epsilons of its own length.
+func M3Decompose(rotation *Matrix3, scale, shear *Vector3, mat *Matrix3) bool {
+	return m3Decompose(rotation, scale, shear, mat)
+}
+
+// M3Compose is the inverse of M3Decompose. A nil shear means no shear.
+func M3Compose(result, rotation *Matrix3, scale, shear *Vector3) {
+	m3Compose(result, rotation, scale, shear)
+}
+
+/*******/
+
+// M4Decompose splits an affine matrix into translation, rotation, scale and
+// shear as described for M3Decompose. The bottom row of mat is ignored.
+func M4Decompose(translation *Vector3, rotation *Quat, scale, shear *Vector3, mat *Matrix4) bool {
+	return m4Decompose(translation, rotation, scale, shear, mat)
+}
+
+// M4Compose builds translation * rotation * shear * scale, the inverse of
+// M4Decompose. A nil shear means no shear.
+func M4Compose(result *Matrix4, translation *Vector3, rotation *Quat, scale, shear *Vector3) {
+	m4Compose(result, translation, rotation, scale, shear)
+}
+
+/*******/
+
+// T3Decompose splits a transform into translation, rotation, scale and shear
+// as described for M3Decompose.
+func T3Decompose(translation *Vector3, rotation *Quat, scale, shear *Vector3, tfrm *Transform3) bool {
+	return t3Decompose(translation, rotation, scale, shear, tfrm)
+}
+
+// T3Compose builds translation * rotation * shear * scale, the inverse of
+// T3Decompose. A nil shear means no shear.
+func T3Compose(result *Transform3, translation *Vector3, rotation *Quat, scale, shear *Vector3) {
+	t3Compose(result, translation, rotation, scale, shear)
+}
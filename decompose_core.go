@@ -0,0 +1,123 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// m3Decompose factors mat as R * H * S, where R is a rotation, S is the
+// diagonal scale matrix and H is the unit upper triangular shear matrix
+//
+//	| 1 shear.X shear.Y |
+//	| 0    1    shear.Z |
+//	| 0    0       1    |
+//
+// The columns of R come from Gram-Schmidt orthogonalization of the columns
+// of mat, so R's x axis always follows mat's first column. A reflection is
+// always reported as a negative Z scale. shear may be nil, in which case
+// any shear in mat is discarded. It returns false, leaving the results
+// unchanged, if a column of mat is zero or the columns are linearly
+// dependent. Rounding leaves a small residual when a column lies in the span
+// of the columns before it, so a column counts as dependent when less than
+// 16 machine epsilons of its length remains after orthogonalization.
+func m3Decompose[T Float](rotation *Matrix3Of[T], scale, shear *Vector3Of[T], mat *Matrix3Of[T]) bool {
+	var x, y, z, tmpV3_0 Vector3Of[T]
+	tol := 16.0 * machineEpsilon[T]()
+	sx := mat.col0.Length()
+	if sx == 0.0 {
+		return false
+	}
+	v3ScalarDiv(&x, &mat.col0, sx)
+	hxy := v3Dot(&x, &mat.col1)
+	v3ScalarMul(&tmpV3_0, &x, hxy)
+	v3Sub(&y, &mat.col1, &tmpV3_0)
+	sy := y.Length()
+	if sy <= tol*mat.col1.Length() {
+		return false
+	}
+	v3ScalarDiv(&y, &y, sy)
+	hxz := v3Dot(&x, &mat.col2)
+	hyz := v3Dot(&y, &mat.col2)
+	v3ScalarMul(&tmpV3_0, &x, hxz)
+	v3Sub(&z, &mat.col2, &tmpV3_0)
+	v3ScalarMul(&tmpV3_0, &y, hyz)
+	v3Sub(&z, &z, &tmpV3_0)
+	sz := z.Length()
+	if sz <= tol*mat.col2.Length() {
+		return false
+	}
+	if mat.Determinant() < 0.0 {
+		sz = -sz
+	}
+	v3ScalarDiv(&z, &z, sz)
+	m3MakeFromCols(rotation, &x, &y, &z)
+	v3MakeFromElems(scale, sx, sy, sz)
+	if shear != nil {
+		v3MakeFromElems(shear, hxy/sy, hxz/sz, hyz/sz)
+	}
+	return true
+}
+
+// m3Compose is the inverse of m3Decompose. A nil shear means no shear.
+func m3Compose[T Float](result, rotation *Matrix3Of[T], scale, shear *Vector3Of[T]) {
+	var col0, col1, col2, tmpV3_0 Vector3Of[T]
+	col0 = rotation.col0
+	col1 = rotation.col1
+	col2 = rotation.col2
+	if shear != nil {
+		v3ScalarMul(&tmpV3_0, &rotation.col0, shear.X)
+		v3Add(&col1, &col1, &tmpV3_0)
+		v3ScalarMul(&tmpV3_0, &rotation.col0, shear.Y)
+		v3Add(&col2, &col2, &tmpV3_0)
+		v3ScalarMul(&tmpV3_0, &rotation.col1, shear.Z)
+		v3Add(&col2, &col2, &tmpV3_0)
+	}
+	v3ScalarMul(&result.col0, &col0, scale.X)
+	v3ScalarMul(&result.col1, &col1, scale.Y)
+	v3ScalarMul(&result.col2, &col2, scale.Z)
+}
+
+func t3Decompose[T Float](translation *Vector3Of[T], rotation *QuatOf[T], scale, shear *Vector3Of[T], tfrm *Transform3Of[T]) bool {
+	var upper, rot Matrix3Of[T]
+	var tmpScale, tmpShear Vector3Of[T]
+	t3GetUpper3x3(&upper, tfrm)
+	if !m3Decompose(&rot, &tmpScale, &tmpShear, &upper) {
+		return false
+	}
+	t3GetTranslation(translation, tfrm)
+	qMakeFromM3(rotation, &rot)
+	v3Copy(scale, &tmpScale)
+	if shear != nil {
+		v3Copy(shear, &tmpShear)
+	}
+	return true
+}
+
+func t3Compose[T Float](result *Transform3Of[T], translation *Vector3Of[T], rotation *QuatOf[T], scale, shear *Vector3Of[T]) {
+	var rot, upper Matrix3Of[T]
+	m3MakeFromQ(&rot, rotation)
+	m3Compose(&upper, &rot, scale, shear)
+	t3MakeFromM3V3(result, &upper, translation)
+}
+
+// m4Decompose ignores the bottom row of mat, which should be (0, 0, 0, 1).
+func m4Decompose[T Float](translation *Vector3Of[T], rotation *QuatOf[T], scale, shear *Vector3Of[T], mat *Matrix4Of[T]) bool {
+	var upper, rot Matrix3Of[T]
+	var tmpScale, tmpShear Vector3Of[T]
+	m4GetUpper3x3(&upper, mat)
+	if !m3Decompose(&rot, &tmpScale, &tmpShear, &upper) {
+		return false
+	}
+	m4GetTranslation(translation, mat)
+	qMakeFromM3(rotation, &rot)
+	v3Copy(scale, &tmpScale)
+	if shear != nil {
+		v3Copy(shear, &tmpShear)
+	}
+	return true
+}
+
+func m4Compose[T Float](result *Matrix4Of[T], translation *Vector3Of[T], rotation *QuatOf[T], scale, shear *Vector3Of[T]) {
+	var rot, upper Matrix3Of[T]
+	m3MakeFromQ(&rot, rotation)
+	m3Compose(&upper, &rot, scale, shear)
+	m4MakeFromM3V3(result, &upper, translation)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func M3dDecompose(rotation *Matrix3d, scale, shear *Vector3d, mat *Matrix3d) bool {
+	return m3Decompose(rotation, scale, shear, mat)
+}
+
+func M3dCompose(result, rotation *Matrix3d, scale, shear *Vector3d) {
+	m3Compose(result, rotation, scale, shear)
+}
+
+/*******/
+
+func M4dDecompose(translation *Vector3d, rotation *Quatd, scale, shear *Vector3d, mat *Matrix4d) bool {
+	return m4Decompose(translation, rotation, scale, shear, mat)
+}
+
+func M4dCompose(result *Matrix4d, translation *Vector3d, rotation *Quatd, scale, shear *Vector3d) {
+	m4Compose(result, translation, rotation, scale, shear)
+}
+
+/*******/
+
+func T3dDecompose(translation *Vector3d, rotation *Quatd, scale, shear *Vector3d, tfrm *Transform3d) bool {
+	return t3Decompose(translation, rotation, scale, shear, tfrm)
+}
+
+func T3dCompose(result *Transform3d, translation *Vector3d, rotation *Quatd, scale, shear *Vector3d) {
+	t3Compose(result, translation, rotation, scale, shear)
+}
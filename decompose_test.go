@@ -0,0 +1,127 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestM3DecomposeRoundTrip(t *testing.T) {
+	var axis, scale, shear, gotScale, gotShear Vector3
+	var rot, mat, gotRot, back Matrix3
+	V3MakeFromElems(&axis, 2.0, -1.0, 0.5)
+	V3Normalize(&axis, &axis)
+	M3MakeRotationAxis(&rot, 1.3, &axis)
+	V3MakeFromElems(&scale, 2.0, 0.5, -3.0)
+	V3MakeFromElems(&shear, 0.25, -0.5, 0.75)
+	M3Compose(&mat, &rot, &scale, &shear)
+
+	if !M3Decompose(&gotRot, &gotScale, &gotShear, &mat) {
+		t.Fatalf("M3Decompose(%v) failed", mat.String())
+	}
+	if !m3Near(&gotRot, &rot, 1e-5) {
+		t.Errorf("rotation = %v, want %v", gotRot.String(), rot.String())
+	}
+	if !v3Near(&gotScale, &scale, 1e-5) || !v3Near(&gotShear, &shear, 1e-5) {
+		t.Errorf("scale %v, shear %v, want %v and %v", gotScale, gotShear, scale, shear)
+	}
+	M3Compose(&back, &gotRot, &gotScale, &gotShear)
+	if !m3Near(&back, &mat, 1e-5) {
+		t.Errorf("M3Compose(M3Decompose(mat)) = %v, want %v", back.String(), mat.String())
+	}
+}
+
+func TestM3DecomposeReflection(t *testing.T) {
+	var scale, gotScale Vector3
+	var rot, mat Matrix3
+	V3MakeFromElems(&scale, -1.0, 2.0, 3.0)
+	M3MakeScale(&mat, &scale)
+	if !M3Decompose(&rot, &gotScale, nil, &mat) {
+		t.Fatalf("M3Decompose(%v) failed", mat.String())
+	}
+	if want := (Vector3{X: 1.0, Y: 2.0, Z: -3.0}); !v3Near(&gotScale, &want, 1e-6) {
+		t.Errorf("scale = %v, want the reflection on Z: %v", gotScale, want)
+	}
+	if det := rot.Determinant(); !near(det, 1.0, 1e-6) {
+		t.Errorf("rotation determinant = %v, want 1", det)
+	}
+}
+
+func TestM3DecomposeDependentColumns(t *testing.T) {
+	var col0, col1, col2 Vector3
+	var mat Matrix3
+	V3MakeFromElems(&col0, 1.0, 2.0, 3.0)
+	V3MakeFromElems(&col2, 1.0, 0.0, 0.0)
+	cases := []struct {
+		name string
+		make func()
+	}{
+		{"col1 = 2*col0", func() {
+			V3ScalarMul(&col1, &col0, 2.0)
+			M3MakeFromCols(&mat, &col0, &col1, &col2)
+		}},
+		{"col2 = col0 - 3*col1", func() {
+			var tmp Vector3
+			V3MakeFromElems(&col1, -1.1, 0.4, 0.6)
+			V3ScalarMul(&tmp, &col1, 3.0)
+			V3Sub(&tmp, &col0, &tmp)
+			M3MakeFromCols(&mat, &col0, &col1, &tmp)
+		}},
+		{"zero col0", func() {
+			M3MakeFromCols(&mat, &Vector3{}, &col0, &col2)
+		}},
+	}
+	for _, c := range cases {
+		c.make()
+		var rot Matrix3
+		scale := Vector3{X: 7.0, Y: 7.0, Z: 7.0}
+		M3MakeIdentity(&rot)
+		if M3Decompose(&rot, &scale, nil, &mat) {
+			t.Errorf("%s: M3Decompose succeeded with scale %v", c.name, scale)
+		}
+		if scale != (Vector3{X: 7.0, Y: 7.0, Z: 7.0}) || rot != (Matrix3{col0: Vector3{X: 1.0}, col1: Vector3{Y: 1.0}, col2: Vector3{Z: 1.0}}) {
+			t.Errorf("%s: M3Decompose changed its results on failure", c.name)
+		}
+	}
+
+	// Widely different but independent column lengths are accepted.
+	M3MakeFromCols(&mat, &Vector3{X: 1e-3}, &Vector3{Y: 1e3}, &Vector3{Z: 1.0})
+	var rot Matrix3
+	var scale Vector3
+	if !M3Decompose(&rot, &scale, nil, &mat) {
+		t.Errorf("M3Decompose rejected %v", mat.String())
+	}
+}
+
+func TestT3DecomposeRoundTrip(t *testing.T) {
+	var translation, scale, shear, gotTranslation, gotScale, gotShear Vector3
+	var rotation, gotRotation Quat
+	var tfrm, back Transform3
+	var mat Matrix4
+	V3MakeFromElems(&translation, 10.0, -20.0, 30.0)
+	V3MakeFromElems(&scale, 1.5, 2.5, 0.5)
+	V3MakeFromElems(&shear, 0.1, 0.0, -0.2)
+	QMakeRotationZ(&rotation, 0.7)
+	T3Compose(&tfrm, &translation, &rotation, &scale, &shear)
+	if !T3Decompose(&gotTranslation, &gotRotation, &gotScale, &gotShear, &tfrm) {
+		t.Fatalf("T3Decompose(%v) failed", tfrm.String())
+	}
+	if gotRotation.Dot(&rotation) < 0.0 {
+		QNeg(&gotRotation, &gotRotation)
+	}
+	if !v3Near(&gotTranslation, &translation, 1e-5) || !qNear(&gotRotation, &rotation, 1e-5) ||
+		!v3Near(&gotScale, &scale, 1e-5) || !v3Near(&gotShear, &shear, 1e-5) {
+		t.Errorf("T3Decompose = %v %v %v %v", gotTranslation, gotRotation, gotScale, gotShear)
+	}
+	T3Compose(&back, &gotTranslation, &gotRotation, &gotScale, &gotShear)
+	if !t3Near(&back, &tfrm, 1e-5) {
+		t.Errorf("T3Compose(T3Decompose(tfrm)) = %v, want %v", back.String(), tfrm.String())
+	}
+
+	M4Compose(&mat, &translation, &rotation, &scale, &shear)
+	if !M4Decompose(&gotTranslation, &gotRotation, &gotScale, nil, &mat) {
+		t.Fatalf("M4Decompose(%v) failed", mat.String())
+	}
+	if !v3Near(&gotTranslation, &translation, 1e-5) || !v3Near(&gotScale, &scale, 1e-5) {
+		t.Errorf("M4Decompose translation %v, scale %v", gotTranslation, gotScale)
+	}
+}
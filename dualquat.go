@@ -0,0 +1,117 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// DualQuat represents a rigid transform as Real + εDual, where ε² = 0.
+type DualQuat = DualQuatOf[float32]
+
+func DQCopy(result, dq *DualQuat) {
+	dqCopy(result, dq)
+}
+
+// DQMakeFromQQ sets the real and dual parts directly.
+func DQMakeFromQQ(result *DualQuat, realPart, dualPart *Quat) {
+	dqMakeFromQQ(result, realPart, dualPart)
+}
+
+func DQMakeIdentity(result *DualQuat) {
+	dqMakeIdentity(result)
+}
+
+// DQMakeFromQV3 builds the rigid transform that rotates by unitQuat and then
+// translates by translateVec, like T3MakeFromQV3.
+func DQMakeFromQV3(result *DualQuat, unitQuat *Quat, translateVec *Vector3) {
+	dqMakeFromQV3(result, unitQuat, translateVec)
+}
+
+// DQMakeFromT3 converts a rigid transform. tfrm must not contain scale or
+// shear.
+func DQMakeFromT3(result *DualQuat, tfrm *Transform3) {
+	dqMakeFromT3(result, tfrm)
+}
+
+func DQGetRotation(result *Quat, unitDQ *DualQuat) {
+	dqGetRotation(result, unitDQ)
+}
+
+func DQGetTranslation(result *Vector3, unitDQ *DualQuat) {
+	dqGetTranslation(result, unitDQ)
+}
+
+func T3MakeFromDQ(result *Transform3, unitDQ *DualQuat) {
+	t3MakeFromDQ(result, unitDQ)
+}
+
+func M4MakeFromDQ(result *Matrix4, unitDQ *DualQuat) {
+	m4MakeFromDQ(result, unitDQ)
+}
+
+/*******/
+
+func DQAdd(result, dq0, dq1 *DualQuat) {
+	dqAdd(result, dq0, dq1)
+}
+
+func DQScalarMul(result, dq *DualQuat, scalar float32) {
+	dqScalarMul(result, dq, scalar)
+}
+
+// DQMul composes two transforms so that dq1 is applied first, matching
+// T3Mul.
+func DQMul(result, dq0, dq1 *DualQuat) {
+	dqMul(result, dq0, dq1)
+}
+
+// DQConj conjugates the real and dual quaternions. For a unit dual
+// quaternion this gives the inverse transform.
+func DQConj(result, dq *DualQuat) {
+	dqConj(result, dq)
+}
+
+// DQDualConj negates the dual part.
+func DQDualConj(result, dq *DualQuat) {
+	dqDualConj(result, dq)
+}
+
+// DQCombinedConj applies both DQConj and DQDualConj. A point p is
+// transformed by dq as dq * (1 + εp) * DQCombinedConj(dq).
+func DQCombinedConj(result, dq *DualQuat) {
+	dqCombinedConj(result, dq)
+}
+
+// DQNormalize makes dq a unit dual quaternion: the real part is given unit
+// length and the dual part is made orthogonal to it. Renormalize after long
+// chains of DQMul, and after blending.
+func DQNormalize(result, dq *DualQuat) {
+	dqNormalize(result, dq)
+}
+
+// DQMulV3 rotates vec, ignoring the translation.
+func DQMulV3(result *Vector3, unitDQ *DualQuat, vec *Vector3) {
+	dqMulV3(result, unitDQ, vec)
+}
+
+func DQMulP3(result *Point3, unitDQ *DualQuat, pnt *Point3) {
+	dqMulP3(result, unitDQ, pnt)
+}
+
+/*******/
+
+// DQSclerp is screw linear interpolation, the dual quaternion equivalent of
+// QSlerp: the result moves along the screw motion from unitDQ0 to unitDQ1, with
+// the rotation angle and the translation along the screw axis both changing
+// at a constant rate. unitDQ1 is negated if necessary to take the shorter
+// path.
+func DQSclerp(result *DualQuat, t float32, unitDQ0, unitDQ1 *DualQuat) {
+	dqSclerp(result, t, unitDQ0, unitDQ1)
+}
+
+// DQBlend is dual quaternion linear blending, as used for skinning: it
+// normalizes the weighted sum of dqs. Unlike blending matrices, the result is
+// always a rigid transform, so joints do not collapse under twisting. Each
+// element is negated if necessary to lie in the same hemisphere as dqs[0]. An
+// empty slice gives the identity. It panics if the slices differ in length.
+func DQBlend(result *DualQuat, dqs []DualQuat, weights []float32) {
+	dqBlend(result, dqs, weights)
+}
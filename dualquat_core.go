@@ -0,0 +1,209 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+// DualQuatOf is Real + ε*Dual with ε² = 0. A unit dual quaternion represents
+// a rigid transform: Real is the rotation and Dual is half the translation
+// times Real.
+type DualQuatOf[T Float] struct {
+	Real QuatOf[T]
+	Dual QuatOf[T]
+}
+
+func dqCopy[T Float](result, dq *DualQuatOf[T]) {
+	qCopy(&result.Real, &dq.Real)
+	qCopy(&result.Dual, &dq.Dual)
+}
+
+func dqMakeFromQQ[T Float](result *DualQuatOf[T], realPart, dualPart *QuatOf[T]) {
+	qCopy(&result.Real, realPart)
+	qCopy(&result.Dual, dualPart)
+}
+
+func dqMakeIdentity[T Float](result *DualQuatOf[T]) {
+	qMakeIdentity(&result.Real)
+	qMakeFromScalar(&result.Dual, 0.0)
+}
+
+// dqMakeFromQV3 builds the transform that rotates by unitQuat and then
+// translates by translateVec.
+func dqMakeFromQV3[T Float](result *DualQuatOf[T], unitQuat *QuatOf[T], translateVec *Vector3Of[T]) {
+	var tmpQ_0 QuatOf[T]
+	qMakeFromV3Scalar(&tmpQ_0, translateVec, 0.0)
+	qMul(&tmpQ_0, &tmpQ_0, unitQuat)
+	qCopy(&result.Real, unitQuat)
+	qScalarMul(&result.Dual, &tmpQ_0, 0.5)
+}
+
+func dqMakeFromT3[T Float](result *DualQuatOf[T], tfrm *Transform3Of[T]) {
+	var tmpM3_0 Matrix3Of[T]
+	var tmpQ_0 QuatOf[T]
+	t3GetUpper3x3(&tmpM3_0, tfrm)
+	qMakeFromM3(&tmpQ_0, &tmpM3_0)
+	dqMakeFromQV3(result, &tmpQ_0, &tfrm.col3)
+}
+
+func dqGetRotation[T Float](result *QuatOf[T], unitDQ *DualQuatOf[T]) {
+	qCopy(result, &unitDQ.Real)
+}
+
+// dqGetTranslation computes the vector part of 2 * Dual * conj(Real).
+func dqGetTranslation[T Float](result *Vector3Of[T], unitDQ *DualQuatOf[T]) {
+	var tmpQ_0, tmpQ_1 QuatOf[T]
+	qConj(&tmpQ_0, &unitDQ.Real)
+	qMul(&tmpQ_1, &unitDQ.Dual, &tmpQ_0)
+	v3MakeFromElems(result, 2.0*tmpQ_1.X, 2.0*tmpQ_1.Y, 2.0*tmpQ_1.Z)
+}
+
+func t3MakeFromDQ[T Float](result *Transform3Of[T], unitDQ *DualQuatOf[T]) {
+	var tmpV3_0 Vector3Of[T]
+	dqGetTranslation(&tmpV3_0, unitDQ)
+	t3MakeFromQV3(result, &unitDQ.Real, &tmpV3_0)
+}
+
+func m4MakeFromDQ[T Float](result *Matrix4Of[T], unitDQ *DualQuatOf[T]) {
+	var tmpV3_0 Vector3Of[T]
+	dqGetTranslation(&tmpV3_0, unitDQ)
+	m4MakeFromQV3(result, &unitDQ.Real, &tmpV3_0)
+}
+
+func dqAdd[T Float](result, dq0, dq1 *DualQuatOf[T]) {
+	qAdd(&result.Real, &dq0.Real, &dq1.Real)
+	qAdd(&result.Dual, &dq0.Dual, &dq1.Dual)
+}
+
+func dqScalarMul[T Float](result, dq *DualQuatOf[T], scalar T) {
+	qScalarMul(&result.Real, &dq.Real, scalar)
+	qScalarMul(&result.Dual, &dq.Dual, scalar)
+}
+
+// dqMul composes the transforms so that dq1 is applied first, as with
+// t3Mul.
+func dqMul[T Float](result, dq0, dq1 *DualQuatOf[T]) {
+	var rot, tmpQ_0, tmpQ_1 QuatOf[T]
+	qMul(&rot, &dq0.Real, &dq1.Real)
+	qMul(&tmpQ_0, &dq0.Real, &dq1.Dual)
+	qMul(&tmpQ_1, &dq0.Dual, &dq1.Real)
+	qAdd(&result.Dual, &tmpQ_0, &tmpQ_1)
+	qCopy(&result.Real, &rot)
+}
+
+// dqConj conjugates both quaternions. For a unit dual quaternion this is
+// the inverse transform.
+func dqConj[T Float](result, dq *DualQuatOf[T]) {
+	qConj(&result.Real, &dq.Real)
+	qConj(&result.Dual, &dq.Dual)
+}
+
+// dqDualConj negates the dual part, i.e. replaces ε with -ε.
+func dqDualConj[T Float](result, dq *DualQuatOf[T]) {
+	qCopy(&result.Real, &dq.Real)
+	qNeg(&result.Dual, &dq.Dual)
+}
+
+// dqCombinedConj applies both conjugates. Points are transformed as
+// dq * (1 + ε*p) * dqCombinedConj(dq).
+func dqCombinedConj[T Float](result, dq *DualQuatOf[T]) {
+	qConj(&result.Real, &dq.Real)
+	qMakeFromElems(&result.Dual, dq.Dual.X, dq.Dual.Y, dq.Dual.Z, -dq.Dual.W)
+}
+
+// dqNormalize scales dq so that Real has unit length, then removes the part
+// of Dual parallel to Real so that Real . Dual = 0, as required of a rigid
+// transform.
+func dqNormalize[T Float](result, dq *DualQuatOf[T]) {
+	var rot, tmpQ_0 QuatOf[T]
+	lenInv := 1.0 / dq.Real.Length()
+	qScalarMul(&rot, &dq.Real, lenInv)
+	qScalarMul(&result.Dual, &dq.Dual, lenInv)
+	qScalarMul(&tmpQ_0, &rot, qDot(&rot, &result.Dual))
+	qSub(&result.Dual, &result.Dual, &tmpQ_0)
+	qCopy(&result.Real, &rot)
+}
+
+func dqMulV3[T Float](result *Vector3Of[T], unitDQ *DualQuatOf[T], vec *Vector3Of[T]) {
+	qRotate(result, &unitDQ.Real, vec)
+}
+
+func dqMulP3[T Float](result *Point3Of[T], unitDQ *DualQuatOf[T], pnt *Point3Of[T]) {
+	var tmpV3_0, tmpV3_1 Vector3Of[T]
+	v3MakeFromP3(&tmpV3_0, pnt)
+	qRotate(&tmpV3_0, &unitDQ.Real, &tmpV3_0)
+	dqGetTranslation(&tmpV3_1, unitDQ)
+	v3Add(&tmpV3_0, &tmpV3_0, &tmpV3_1)
+	p3MakeFromV3(result, &tmpV3_0)
+}
+
+// dqSclerp moves along the screw motion from unitDQ0 to unitDQ1, so both the
+// rotation and the translation change at a constant rate. As with qSlerp,
+// unitDQ1 is negated if necessary to take the shorter path.
+func dqSclerp[T Float](result *DualQuatOf[T], t T, unitDQ0, unitDQ1 *DualQuatOf[T]) {
+	var end, diff, tmpDQ_0 DualQuatOf[T]
+	var vr, vd, axis, moment, tmpV3_0 Vector3Of[T]
+	dqCopy(&end, unitDQ1)
+	if qDot(&unitDQ0.Real, &unitDQ1.Real) < 0.0 {
+		dqScalarMul(&end, &end, -1.0)
+	}
+	dqConj(&tmpDQ_0, unitDQ0)
+	dqMul(&diff, &tmpDQ_0, &end)
+
+	// Raise diff to the power t by scaling its screw angle and pitch.
+	v3MakeFromElems(&vr, diff.Real.X, diff.Real.Y, diff.Real.Z)
+	v3MakeFromElems(&vd, diff.Dual.X, diff.Dual.Y, diff.Dual.Z)
+	sinHalf := vr.Length()
+	if sinHalf < 16.0*machineEpsilon[T]() {
+		// Pure translation.
+		qMakeIdentity(&tmpDQ_0.Real)
+		qScalarMul(&tmpDQ_0.Dual, &diff.Dual, t)
+	} else {
+		recipSinHalf := 1.0 / sinHalf
+		halfAngle := atan2(sinHalf, diff.Real.W)
+		v3ScalarMul(&axis, &vr, recipSinHalf)
+		halfPitch := -diff.Dual.W * recipSinHalf
+		v3ScalarMul(&tmpV3_0, &axis, halfPitch*diff.Real.W)
+		v3Sub(&moment, &vd, &tmpV3_0)
+		v3ScalarMul(&moment, &moment, recipSinHalf)
+
+		halfAngle *= t
+		halfPitch *= t
+		s, c := sincos(halfAngle)
+		v3ScalarMul(&tmpV3_0, &axis, s)
+		qMakeFromV3Scalar(&tmpDQ_0.Real, &tmpV3_0, c)
+		v3ScalarMul(&tmpV3_0, &axis, halfPitch*c)
+		v3ScalarMul(&moment, &moment, s)
+		v3Add(&tmpV3_0, &tmpV3_0, &moment)
+		qMakeFromV3Scalar(&tmpDQ_0.Dual, &tmpV3_0, -halfPitch*s)
+	}
+	dqMul(result, unitDQ0, &tmpDQ_0)
+}
+
+// dqBlend is dual quaternion linear blending: the weighted sum of dqs,
+// normalized. Each element is negated if necessary to lie in the same
+// hemisphere as dqs[0], so the blend takes the shorter path. An empty slice
+// gives the identity.
+func dqBlend[T Float](result *DualQuatOf[T], dqs []DualQuatOf[T], weights []T) {
+	var sum, tmpDQ_0 DualQuatOf[T]
+	if len(weights) != len(dqs) {
+		panic("vectormath: slice length mismatch")
+	}
+	if len(dqs) == 0 {
+		dqMakeIdentity(result)
+		return
+	}
+	for i := range dqs {
+		w := weights[i]
+		if qDot(&dqs[0].Real, &dqs[i].Real) < 0.0 {
+			w = -w
+		}
+		dqScalarMul(&tmpDQ_0, &dqs[i], w)
+		dqAdd(&sum, &sum, &tmpDQ_0)
+	}
+	dqNormalize(result, &sum)
+}
+
+func (dq *DualQuatOf[T]) String() string {
+	return fmt.Sprintf("real ( %f %f %f %f ) dual ( %f %f %f %f )\n", dq.Real.X, dq.Real.Y, dq.Real.Z, dq.Real.W, dq.Dual.X, dq.Dual.Y, dq.Dual.Z, dq.Dual.W)
+}
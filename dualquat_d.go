@@ -0,0 +1,90 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type DualQuatd = DualQuatOf[float64]
+
+func DQdCopy(result, dq *DualQuatd) {
+	dqCopy(result, dq)
+}
+
+func DQdMakeFromQdQd(result *DualQuatd, realPart, dualPart *Quatd) {
+	dqMakeFromQQ(result, realPart, dualPart)
+}
+
+func DQdMakeIdentity(result *DualQuatd) {
+	dqMakeIdentity(result)
+}
+
+func DQdMakeFromQdV3d(result *DualQuatd, unitQuat *Quatd, translateVec *Vector3d) {
+	dqMakeFromQV3(result, unitQuat, translateVec)
+}
+
+func DQdMakeFromT3d(result *DualQuatd, tfrm *Transform3d) {
+	dqMakeFromT3(result, tfrm)
+}
+
+func DQdGetRotation(result *Quatd, unitDQ *DualQuatd) {
+	dqGetRotation(result, unitDQ)
+}
+
+func DQdGetTranslation(result *Vector3d, unitDQ *DualQuatd) {
+	dqGetTranslation(result, unitDQ)
+}
+
+func T3dMakeFromDQd(result *Transform3d, unitDQ *DualQuatd) {
+	t3MakeFromDQ(result, unitDQ)
+}
+
+func M4dMakeFromDQd(result *Matrix4d, unitDQ *DualQuatd) {
+	m4MakeFromDQ(result, unitDQ)
+}
+
+/*******/
+
+func DQdAdd(result, dq0, dq1 *DualQuatd) {
+	dqAdd(result, dq0, dq1)
+}
+
+func DQdScalarMul(result, dq *DualQuatd, scalar float64) {
+	dqScalarMul(result, dq, scalar)
+}
+
+func DQdMul(result, dq0, dq1 *DualQuatd) {
+	dqMul(result, dq0, dq1)
+}
+
+func DQdConj(result, dq *DualQuatd) {
+	dqConj(result, dq)
+}
+
+func DQdDualConj(result, dq *DualQuatd) {
+	dqDualConj(result, dq)
+}
+
+func DQdCombinedConj(result, dq *DualQuatd) {
+	dqCombinedConj(result, dq)
+}
+
+func DQdNormalize(result, dq *DualQuatd) {
+	dqNormalize(result, dq)
+}
+
+func DQdMulV3d(result *Vector3d, unitDQ *DualQuatd, vec *Vector3d) {
+	dqMulV3(result, unitDQ, vec)
+}
+
+func DQdMulP3d(result *Point3d, unitDQ *DualQuatd, pnt *Point3d) {
+	dqMulP3(result, unitDQ, pnt)
+}
+
+/*******/
+
+func DQdSclerp(result *DualQuatd, t float64, unitDQ0, unitDQ1 *DualQuatd) {
+	dqSclerp(result, t, unitDQ0, unitDQ1)
+}
+
+func DQdBlend(result *DualQuatd, dqs []DualQuatd, weights []float64) {
+	dqBlend(result, dqs, weights)
+}
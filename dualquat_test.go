@@ -0,0 +1,223 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func dqNear(dq0, dq1 *DualQuat, tolerance float32) bool {
+	return qNear(&dq0.Real, &dq1.Real, tolerance) && qNear(&dq0.Dual, &dq1.Dual, tolerance)
+}
+
+func makeTestDQ(radians float32, x, y, z float32) (DualQuat, Quat, Vector3) {
+	var dq DualQuat
+	var axis, translation Vector3
+	var quat Quat
+	V3MakeFromElems(&axis, 1.0, -2.0, 0.5)
+	V3Normalize(&axis, &axis)
+	QMakeRotationAxis(&quat, radians, &axis)
+	V3MakeFromElems(&translation, x, y, z)
+	DQMakeFromQV3(&dq, &quat, &translation)
+	return dq, quat, translation
+}
+
+func TestDQMatchesTransform3(t *testing.T) {
+	var tfrm, fromDQ Transform3
+	var mat, wantMat Matrix4
+	var pnt, gotP3, wantP3 Point3
+	var vec, gotV3, wantV3, gotTranslation Vector3
+	var gotQuat Quat
+	dq, quat, translation := makeTestDQ(1.2, 3.0, -4.0, 5.0)
+	T3MakeFromQV3(&tfrm, &quat, &translation)
+	P3MakeFromElems(&pnt, 0.5, 1.5, -2.5)
+	V3MakeFromElems(&vec, -1.0, 2.0, 0.25)
+
+	DQMulP3(&gotP3, &dq, &pnt)
+	T3MulP3(&wantP3, &tfrm, &pnt)
+	if !p3Near(&gotP3, &wantP3, 1e-5) {
+		t.Errorf("DQMulP3 = %v, T3MulP3 gives %v", gotP3, wantP3)
+	}
+	DQMulV3(&gotV3, &dq, &vec)
+	T3MulV3(&wantV3, &tfrm, &vec)
+	if !v3Near(&gotV3, &wantV3, 1e-5) {
+		t.Errorf("DQMulV3 = %v, T3MulV3 gives %v", gotV3, wantV3)
+	}
+	DQGetRotation(&gotQuat, &dq)
+	DQGetTranslation(&gotTranslation, &dq)
+	if !qNear(&gotQuat, &quat, 1e-6) || !v3Near(&gotTranslation, &translation, 1e-5) {
+		t.Errorf("DQGetRotation, DQGetTranslation = %v, %v, want %v, %v", gotQuat, gotTranslation, quat, translation)
+	}
+	T3MakeFromDQ(&fromDQ, &dq)
+	if !t3Near(&fromDQ, &tfrm, 1e-5) {
+		t.Errorf("T3MakeFromDQ = %v, want %v", fromDQ.String(), tfrm.String())
+	}
+	M4MakeFromDQ(&mat, &dq)
+	M4MakeFromT3(&wantMat, &tfrm)
+	if !m4Near(&mat, &wantMat, 1e-5) {
+		t.Errorf("M4MakeFromDQ = %v, want %v", mat.String(), wantMat.String())
+	}
+
+	var back DualQuat
+	DQMakeFromT3(&back, &tfrm)
+	if back.Real.Dot(&dq.Real) < 0.0 {
+		DQScalarMul(&back, &back, -1.0)
+	}
+	if !dqNear(&back, &dq, 1e-5) {
+		t.Errorf("DQMakeFromT3 = %v, want %v", back, dq)
+	}
+}
+
+func TestDQMulOrder(t *testing.T) {
+	var dq, inv, ident DualQuat
+	var tfrm0, tfrm1, tfrm Transform3
+	var pnt, got, want Point3
+	dq0, quat0, translation0 := makeTestDQ(0.7, 1.0, 2.0, 3.0)
+	dq1, quat1, translation1 := makeTestDQ(-2.1, -4.0, 0.0, 6.0)
+	T3MakeFromQV3(&tfrm0, &quat0, &translation0)
+	T3MakeFromQV3(&tfrm1, &quat1, &translation1)
+	P3MakeFromElems(&pnt, 2.0, -1.0, 0.5)
+
+	DQMul(&dq, &dq0, &dq1)
+	T3Mul(&tfrm, &tfrm0, &tfrm1)
+	DQMulP3(&got, &dq, &pnt)
+	T3MulP3(&want, &tfrm, &pnt)
+	if !p3Near(&got, &want, 1e-4) {
+		t.Errorf("DQMul(dq0, dq1) moves %v to %v, T3Mul gives %v", pnt, got, want)
+	}
+
+	DQConj(&inv, &dq)
+	DQMul(&dq, &dq, &inv)
+	DQMakeIdentity(&ident)
+	if !dqNear(&dq, &ident, 1e-5) {
+		t.Errorf("dq * DQConj(dq) = %v, want identity", dq)
+	}
+}
+
+func TestDQCombinedConj(t *testing.T) {
+	var pntDQ, conj, tmp DualQuat
+	var pnt, want Point3
+	dq, _, _ := makeTestDQ(0.9, 1.0, -1.0, 2.0)
+	P3MakeFromElems(&pnt, 3.0, 0.5, -1.0)
+	DQMakeFromQQ(&pntDQ, &Quat{W: 1.0}, &Quat{X: pnt.X, Y: pnt.Y, Z: pnt.Z})
+	DQCombinedConj(&conj, &dq)
+	DQMul(&tmp, &dq, &pntDQ)
+	DQMul(&tmp, &tmp, &conj)
+	DQMulP3(&want, &dq, &pnt)
+	got := Point3{X: tmp.Dual.X, Y: tmp.Dual.Y, Z: tmp.Dual.Z}
+	if !p3Near(&got, &want, 1e-5) || !near(tmp.Real.W, 1.0, 1e-6) {
+		t.Errorf("dq * (1 + εp) * DQCombinedConj(dq) = %v, want 1 + ε%v", tmp, want)
+	}
+
+	DQDualConj(&conj, &dq)
+	if conj.Real != dq.Real || conj.Dual.X != -dq.Dual.X || conj.Dual.W != -dq.Dual.W {
+		t.Errorf("DQDualConj(%v) = %v", dq, conj)
+	}
+}
+
+func TestDQNormalize(t *testing.T) {
+	var got DualQuat
+	dq, _, _ := makeTestDQ(2.4, 0.0, 7.0, -3.0)
+	DQScalarMul(&got, &dq, 3.5)
+	// Drift the dual part out of orthogonality.
+	got.Dual.W += 0.1
+	DQNormalize(&got, &got)
+	if !near(got.Real.Length(), 1.0, 1e-6) {
+		t.Errorf("DQNormalize real part has length %v", got.Real.Length())
+	}
+	if d := got.Real.Dot(&got.Dual); !near(d, 0.0, 1e-6) {
+		t.Errorf("DQNormalize real and dual parts have dot product %v", d)
+	}
+	DQScalarMul(&got, &dq, 3.5)
+	DQNormalize(&got, &got)
+	if !dqNear(&got, &dq, 1e-6) {
+		t.Errorf("DQNormalize(3.5 * dq) = %v, want %v", got, dq)
+	}
+}
+
+func TestDQSclerp(t *testing.T) {
+	var got, half, delta, tmp, negated DualQuat
+	dq0, _, _ := makeTestDQ(0.3, 1.0, 0.0, 0.0)
+	dq1, _, _ := makeTestDQ(2.0, -3.0, 4.0, 2.0)
+
+	DQSclerp(&got, 0.0, &dq0, &dq1)
+	if !dqNear(&got, &dq0, 1e-5) {
+		t.Errorf("DQSclerp(0) = %v, want %v", got, dq0)
+	}
+	DQSclerp(&got, 1.0, &dq0, &dq1)
+	if !dqNear(&got, &dq1, 1e-5) {
+		t.Errorf("DQSclerp(1) = %v, want %v", got, dq1)
+	}
+
+	// Halfway along a screw motion, the same step again reaches the end.
+	DQSclerp(&half, 0.5, &dq0, &dq1)
+	DQConj(&tmp, &dq0)
+	DQMul(&delta, &tmp, &half)
+	DQMul(&got, &half, &delta)
+	if !dqNear(&got, &dq1, 1e-5) {
+		t.Errorf("two half steps of DQSclerp reach %v, want %v", got, dq1)
+	}
+
+	// The sign of the end makes no difference.
+	DQScalarMul(&negated, &dq1, -1.0)
+	DQSclerp(&got, 0.5, &dq0, &negated)
+	if !dqNear(&got, &half, 1e-5) {
+		t.Errorf("DQSclerp to -dq1 = %v, want %v", got, half)
+	}
+
+	// A pure translation interpolates linearly.
+	var quat Quat
+	var translation0, translation1 Vector3
+	var trans0, trans1 DualQuat
+	QMakeIdentity(&quat)
+	V3MakeFromElems(&translation0, 0.0, 0.0, 0.0)
+	V3MakeFromElems(&translation1, 4.0, -2.0, 8.0)
+	DQMakeFromQV3(&trans0, &quat, &translation0)
+	DQMakeFromQV3(&trans1, &quat, &translation1)
+	DQSclerp(&got, 0.25, &trans0, &trans1)
+	var gotTranslation Vector3
+	DQGetTranslation(&gotTranslation, &got)
+	if want := (Vector3{X: 1.0, Y: -0.5, Z: 2.0}); !v3Near(&gotTranslation, &want, 1e-6) {
+		t.Errorf("DQSclerp of a translation = %v, want %v", gotTranslation, want)
+	}
+}
+
+func TestDQBlend(t *testing.T) {
+	var got, ident, negated DualQuat
+	dq0, _, _ := makeTestDQ(0.5, 1.0, 2.0, 3.0)
+	dq1, _, _ := makeTestDQ(0.9, 1.5, 2.0, 2.0)
+
+	DQBlend(&got, nil, nil)
+	DQMakeIdentity(&ident)
+	if got != ident {
+		t.Errorf("DQBlend of nothing = %v, want identity", got)
+	}
+	DQBlend(&got, []DualQuat{dq0}, []float32{0.3})
+	if !dqNear(&got, &dq0, 1e-6) {
+		t.Errorf("DQBlend of one = %v, want %v", got, dq0)
+	}
+	// An element in the other hemisphere is flipped before blending.
+	DQScalarMul(&negated, &dq0, -1.0)
+	DQBlend(&got, []DualQuat{dq0, negated}, []float32{0.5, 0.5})
+	if !dqNear(&got, &dq0, 1e-6) {
+		t.Errorf("DQBlend(dq0, -dq0) = %v, want %v", got, dq0)
+	}
+	// The blend is a unit dual quaternion between the two.
+	DQBlend(&got, []DualQuat{dq0, dq1}, []float32{0.5, 0.5})
+	if !near(got.Real.Length(), 1.0, 1e-6) || !near(got.Real.Dot(&got.Dual), 0.0, 1e-6) {
+		t.Errorf("DQBlend = %v, want a unit dual quaternion", got)
+	}
+	var rot0, rot1, rot Quat
+	DQGetRotation(&rot0, &dq0)
+	DQGetRotation(&rot1, &dq1)
+	DQGetRotation(&rot, &got)
+	if rot.Dot(&rot0) < rot0.Dot(&rot1) || rot.Dot(&rot1) < rot0.Dot(&rot1) {
+		t.Errorf("DQBlend rotation %v is not between %v and %v", rot, rot0, rot1)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("DQBlend did not panic on a length mismatch")
+		}
+	}()
+	DQBlend(&got, []DualQuat{dq0}, nil)
+}
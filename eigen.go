@@ -0,0 +1,15 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// M3SymmetricEigen computes the eigenvalues and eigenvectors of the symmetric
+// matrix mat, such as a covariance matrix or inertia tensor, so that
+// mat = vectors * diag(values) * vectors^T. The eigenvalues are sorted in
+// decreasing order, and column i of vectors is the unit eigenvector for
+// element i of values. The columns form a right-handed frame, so vectors is a
+// rotation, e.g. the axes of a box fitted to a point cloud. Only the upper
+// triangle of mat is read.
+func M3SymmetricEigen(values *Vector3, vectors *Matrix3, mat *Matrix3) {
+	m3SymmetricEigen(values, vectors, mat)
+}
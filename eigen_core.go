@@ -0,0 +1,64 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// m3SymmetricEigen diagonalizes the symmetric matrix mat as
+// vectors * diag(values) * vectors^T using cyclic Jacobi: each step is a
+// plane rotation, accumulated in vectors, that zeroes one off-diagonal
+// element. Only the upper triangle of mat is read.
+//
+// The eigenvalues are sorted in decreasing order, with the eigenvectors in
+// the matching columns. The eigenvectors are orthonormal and form a
+// right-handed frame, so vectors is a rotation.
+func m3SymmetricEigen[T Float](values *Vector3Of[T], vectors *Matrix3Of[T], mat *Matrix3Of[T]) {
+	var a [3][3]T
+	var vc [3]Vector3Of[T]
+	var d [3]T
+	a[0][0], a[1][1], a[2][2] = mat.col0.X, mat.col1.Y, mat.col2.Z
+	a[0][1], a[0][2], a[1][2] = mat.col1.X, mat.col2.X, mat.col2.Y
+	a[1][0], a[2][0], a[2][1] = a[0][1], a[0][2], a[1][2]
+	v3MakeXAxis(&vc[0])
+	v3MakeYAxis(&vc[1])
+	v3MakeZAxis(&vc[2])
+	eps := machineEpsilon[T]()
+	for sweep := 0; sweep < g_JACOBI_MAX_SWEEPS; sweep++ {
+		rotated := false
+		for _, pqr := range [3][3]int{{0, 1, 2}, {0, 2, 1}, {1, 2, 0}} {
+			p, q, r := pqr[0], pqr[1], pqr[2]
+			apq := a[p][q]
+			if !(abs(apq) > eps*sqrt(abs(a[p][p]*a[q][q]))) {
+				continue
+			}
+			c, s := jacobiRotation(a[p][p], a[q][q], apq)
+			t := s / c
+			a[p][p] -= t * apq
+			a[q][q] += t * apq
+			a[p][q], a[q][p] = 0.0, 0.0
+			arp, arq := a[r][p], a[r][q]
+			a[r][p] = c*arp - s*arq
+			a[r][q] = s*arp + c*arq
+			a[p][r], a[q][r] = a[r][p], a[r][q]
+			v3Rotate2(&vc[p], &vc[q], c, s)
+			rotated = true
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	d[0], d[1], d[2] = a[0][0], a[1][1], a[2][2]
+	for i := 0; i < 2; i++ {
+		for j := 2; j > i; j-- {
+			if d[j] > d[j-1] {
+				d[j], d[j-1] = d[j-1], d[j]
+				vc[j], vc[j-1] = vc[j-1], vc[j]
+			}
+		}
+	}
+	m3MakeFromCols(vectors, &vc[0], &vc[1], &vc[2])
+	if vectors.Determinant() < 0.0 {
+		v3Neg(&vectors.col2, &vectors.col2)
+	}
+	v3MakeFromElems(values, d[0], d[1], d[2])
+}
@@ -0,0 +1,8 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func M3dSymmetricEigen(values *Vector3d, vectors *Matrix3d, mat *Matrix3d) {
+	m3SymmetricEigen(values, vectors, mat)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randSymmetricM3d(rng *rand.Rand) Matrix3d {
+	var mat, matT Matrix3d
+	mat = randM3d(rng)
+	M3dTranspose(&matT, &mat)
+	M3dAdd(&mat, &mat, &matT)
+	return mat
+}
+
+// checkEigen checks the properties M3SymmetricEigen documents for the
+// eigendecomposition of the symmetric matrix mat.
+func checkEigen[T Float](t *testing.T, name string, mat *Matrix3Of[T], values *Vector3Of[T], vectors *Matrix3Of[T], tol T) {
+	t.Helper()
+	if !isRotation(vectors, tol) {
+		t.Errorf("%s: vectors = %v, want a rotation", name, vectors.String())
+	}
+	if !(values.X >= values.Y && values.Y >= values.Z) {
+		t.Errorf("%s: values = %v, want decreasing order", name, values)
+	}
+	scale := 1.0 + max(abs(values.X), abs(values.Z))
+	if prod := svdProduct(vectors, values, vectors); !m3Near(&prod, mat, tol*scale) {
+		t.Errorf("%s: vectors * diag(values) * vectors^T = %v, want %v", name, prod.String(), mat.String())
+	}
+	for i := 0; i < 3; i++ {
+		var vec, got, want Vector3Of[T]
+		m3GetCol(&vec, vectors, i)
+		m3MulV3(&got, mat, &vec)
+		v3ScalarMul(&want, &vec, values.GetElem(i))
+		if !v3Near(&got, &want, tol*scale) {
+			t.Errorf("%s: mat * vectors[%d] = %v, want %v", name, i, got, want)
+		}
+	}
+}
+
+func TestM3SymmetricEigenRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(25))
+	for i := 0; i < 500; i++ {
+		var values Vector3d
+		var vectors Matrix3d
+		mat := randSymmetricM3d(rng)
+		M3dSymmetricEigen(&values, &vectors, &mat)
+		checkEigen(t, "random", &mat, &values, &vectors, 1e-12)
+
+		var mat32, vectors32 Matrix3
+		var values32 Vector3
+		M3MakeFromM3d(&mat32, &mat)
+		M3SymmetricEigen(&values32, &vectors32, &mat32)
+		checkEigen(t, "random float32", &mat32, &values32, &vectors32, 2e-5)
+	}
+}
+
+func TestM3SymmetricEigenKnownValues(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for _, want := range []Vector3d{
+		{X: 3.0, Y: 2.0, Z: 1.0},
+		{X: 2.0, Y: -1.0, Z: -4.0},
+		{X: 5.0, Y: 5.0, Z: -1.0},
+		{X: 1.0, Y: 1.0, Z: 1.0},
+		{X: 0.0, Y: 0.0, Z: 0.0},
+		{X: 1e6, Y: 1.0, Z: 1e-6},
+	} {
+		var values Vector3d
+		var vectors Matrix3d
+		rot := randRotationd(rng)
+		mat := svdProduct(&rot, &want, &rot)
+		M3dSymmetricEigen(&values, &vectors, &mat)
+		checkEigen(t, "known values", &mat, &values, &vectors, 1e-12)
+		scale := max(abs(want.X), abs(want.Z))
+		if !v3Near(&values, &want, 1e-12*(1.0+scale)) {
+			t.Errorf("M3dSymmetricEigen of %v gives eigenvalues %v, want %v", mat.String(), values, want)
+		}
+	}
+}
+
+func TestM3SymmetricEigenUpperTriangle(t *testing.T) {
+	var upper, garbage, vectors0, vectors1 Matrix3d
+	var values0, values1 Vector3d
+	rng := rand.New(rand.NewSource(6))
+	upper = randSymmetricM3d(rng)
+	garbage = upper
+	garbage.SetElem(0, 1, 100.0)
+	garbage.SetElem(0, 2, -7.0)
+	garbage.SetElem(1, 2, 0.5)
+	M3dSymmetricEigen(&values0, &vectors0, &upper)
+	M3dSymmetricEigen(&values1, &vectors1, &garbage)
+	if values0 != values1 || vectors0 != vectors1 {
+		t.Errorf("the lower triangle changed the result: %v, %v and %v, %v",
+			values0, vectors0.String(), values1, vectors1.String())
+	}
+}
+
+func TestM3SymmetricEigenCovariance(t *testing.T) {
+	// The covariance of points spread along a known frame has that frame
+	// as its eigenvectors, longest axis first, as for fitting a box.
+	var axis Vector3
+	var frame, cov Matrix3
+	var values Vector3
+	var vectors Matrix3
+	V3MakeFromElems(&axis, 1.0, 2.0, 2.0)
+	V3Normalize(&axis, &axis)
+	M3MakeRotationAxis(&frame, 0.8, &axis)
+	extents := Vector3{X: 0.5, Y: 4.0, Z: 1.5}
+	rng := rand.New(rand.NewSource(7))
+	for i := 0; i < 1000; i++ {
+		var local, pnt Vector3
+		var outer Matrix3
+		V3MakeFromElems(&local, rng.Float32()*2.0-1.0, rng.Float32()*2.0-1.0, rng.Float32()*2.0-1.0)
+		V3MulPerElem(&local, &local, &extents)
+		M3MulV3(&pnt, &frame, &local)
+		V3Outer(&outer, &pnt, &pnt)
+		M3Add(&cov, &cov, &outer)
+	}
+	M3SymmetricEigen(&values, &vectors, &cov)
+	checkEigen(t, "covariance", &cov, &values, &vectors, 1e-5)
+	// Local Y, Z and X in order of decreasing extent.
+	for i, col := range []int{1, 2, 0} {
+		var got, want Vector3
+		M3GetCol(&got, &vectors, i)
+		M3GetCol(&want, &frame, col)
+		if d := abs(V3Dot(&got, &want)); !near(d, 1.0, 1e-2) {
+			t.Errorf("eigenvector %d = %v, want +-%v", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// M3MakeFromEuler builds the rotation described by radians and order.
+func M3MakeFromEuler(result *Matrix3, radians *Vector3, order EulerOrder) {
+	m3MakeFromEuler(result, radians, order)
+}
+
+// M3GetEuler extracts Euler angles in the given order from a rotation matrix.
+// The middle angle lies in [-pi/2, pi/2] for Tait-Bryan orders and [0, pi] for
+// proper Euler orders; the others lie in [-pi, pi]. At gimbal lock the last
+// rotation is reported as zero and the first carries the combined angle.
+func M3GetEuler(result *Vector3, mat *Matrix3, order EulerOrder) {
+	m3GetEuler(result, mat, order)
+}
+
+/*******/
+
+// QMakeFromEuler builds the rotation described by radians and order.
+func QMakeFromEuler(result *Quat, radians *Vector3, order EulerOrder) {
+	qMakeFromEuler(result, radians, order)
+}
+
+// QGetEuler extracts Euler angles in the given order from a unit quaternion,
+// with the same ranges as M3GetEuler.
+func QGetEuler(result *Vector3, unitQuat *Quat, order EulerOrder) {
+	qGetEuler(result, unitQuat, order)
+}
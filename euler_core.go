@@ -0,0 +1,194 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"fmt"
+	"math"
+)
+
+// EulerOrder selects the axes and order of the three rotations making up a
+// set of Euler angles. As with M3MakeRotationZYX, the name gives the order
+// of the matrix product, so EulerZYX is Rz * Ry * Rx: the X rotation is
+// applied to a vector first and the Z rotation last.
+//
+// For the six Tait-Bryan orders, which use three different axes, the angle
+// for each axis is stored in the matching component of the angles vector,
+// so M3MakeFromEuler(m, angles, EulerZYX) is M3MakeRotationZYX(m, angles).
+// For the six proper Euler orders, which repeat the first axis last, the X,
+// Y and Z components hold the angles of the first, second and third letter
+// of the name.
+type EulerOrder int
+
+const (
+	EulerXYZ EulerOrder = iota
+	EulerXZY
+	EulerYXZ
+	EulerYZX
+	EulerZXY
+	EulerZYX
+	EulerXYX
+	EulerXZX
+	EulerYXY
+	EulerYZY
+	EulerZXZ
+	EulerZYZ
+)
+
+var eulerAxes = [...][3]int{
+	EulerXYZ: {0, 1, 2},
+	EulerXZY: {0, 2, 1},
+	EulerYXZ: {1, 0, 2},
+	EulerYZX: {1, 2, 0},
+	EulerZXY: {2, 0, 1},
+	EulerZYX: {2, 1, 0},
+	EulerXYX: {0, 1, 0},
+	EulerXZX: {0, 2, 0},
+	EulerYXY: {1, 0, 1},
+	EulerYZY: {1, 2, 1},
+	EulerZXZ: {2, 0, 2},
+	EulerZYZ: {2, 1, 2},
+}
+
+var eulerNames = [...]string{
+	"XYZ", "XZY", "YXZ", "YZX", "ZXY", "ZYX",
+	"XYX", "XZX", "YXY", "YZY", "ZXZ", "ZYZ",
+}
+
+func (o EulerOrder) String() string {
+	if o >= 0 && int(o) < len(eulerNames) {
+		return "Euler" + eulerNames[o]
+	}
+	return fmt.Sprintf("EulerOrder(%d)", int(o))
+}
+
+func (o EulerOrder) isProper() bool {
+	return o >= EulerXYX
+}
+
+// eulerGetAngle returns the angle of the n'th rotation in the product.
+func eulerGetAngle[T Float](radians *Vector3Of[T], order EulerOrder, n int) T {
+	if order.isProper() {
+		return radians.GetElem(n)
+	}
+	return radians.GetElem(eulerAxes[order][n])
+}
+
+func eulerSetAngle[T Float](radians *Vector3Of[T], order EulerOrder, n int, value T) {
+	if order.isProper() {
+		radians.SetElem(n, value)
+	} else {
+		radians.SetElem(eulerAxes[order][n], value)
+	}
+}
+
+func m3MakeRotationAxisIndex[T Float](result *Matrix3Of[T], axis int, radians T) {
+	switch axis {
+	case 0:
+		m3MakeRotationX(result, radians)
+	case 1:
+		m3MakeRotationY(result, radians)
+	case 2:
+		m3MakeRotationZ(result, radians)
+	}
+}
+
+func qMakeRotationAxisIndex[T Float](result *QuatOf[T], axis int, radians T) {
+	switch axis {
+	case 0:
+		qMakeRotationX(result, radians)
+	case 1:
+		qMakeRotationY(result, radians)
+	case 2:
+		qMakeRotationZ(result, radians)
+	}
+}
+
+func m3MakeFromEuler[T Float](result *Matrix3Of[T], radians *Vector3Of[T], order EulerOrder) {
+	var tmpM3_0, tmpM3_1 Matrix3Of[T]
+	axes := &eulerAxes[order]
+	m3MakeRotationAxisIndex(&tmpM3_0, axes[0], eulerGetAngle(radians, order, 0))
+	m3MakeRotationAxisIndex(&tmpM3_1, axes[1], eulerGetAngle(radians, order, 1))
+	m3Mul(&tmpM3_0, &tmpM3_0, &tmpM3_1)
+	m3MakeRotationAxisIndex(&tmpM3_1, axes[2], eulerGetAngle(radians, order, 2))
+	m3Mul(result, &tmpM3_0, &tmpM3_1)
+}
+
+func qMakeFromEuler[T Float](result *QuatOf[T], radians *Vector3Of[T], order EulerOrder) {
+	var tmpQ_0, tmpQ_1 QuatOf[T]
+	axes := &eulerAxes[order]
+	qMakeRotationAxisIndex(&tmpQ_0, axes[0], eulerGetAngle(radians, order, 0))
+	qMakeRotationAxisIndex(&tmpQ_1, axes[1], eulerGetAngle(radians, order, 1))
+	qMul(&tmpQ_0, &tmpQ_0, &tmpQ_1)
+	qMakeRotationAxisIndex(&tmpQ_1, axes[2], eulerGetAngle(radians, order, 2))
+	qMul(result, &tmpQ_0, &tmpQ_1)
+}
+
+// m3GetEuler relabels the axes so that every order reduces to either
+// Rx*Ry*Rz or Rx*Ry*Rx. The relabelling is a rotation for cyclic axis
+// sequences and a reflection otherwise, and a reflection reverses the sense
+// of every rotation, so in that case the angles are negated afterwards.
+//
+// When the middle rotation lines the first and last axes up (gimbal lock)
+// only their combined angle is defined; it is assigned to the first
+// rotation and the last one is set to zero.
+func m3GetEuler[T Float](result *Vector3Of[T], mat *Matrix3Of[T], order EulerOrder) {
+	axes := &eulerAxes[order]
+	i, j := axes[0], axes[1]
+	k := 3 - i - j
+	sign := T(1.0)
+	if (i+1)%3 != j {
+		sign = -1.0
+	}
+	idx := [3]int{i, j, k}
+	// r(row, col) reads the relabelled matrix.
+	r := func(row, col int) T {
+		return mat.GetElem(idx[col], idx[row])
+	}
+	tol := 16.0 * machineEpsilon[T]()
+	var a, b, c T
+	if order.isProper() {
+		// Rx(a) Ry(b) Rx(c). Negating the angles would leave b in [-pi, 0],
+		// so use the equivalent Rx(a+pi) Ry(-b) Rx(c+pi) instead.
+		sb := hypot(r(0, 1), r(0, 2))
+		b = atan2(sb, r(0, 0))
+		if sb > tol {
+			a = atan2(r(1, 0), -r(2, 0))
+			c = atan2(r(0, 1), r(0, 2))
+			if sign < 0.0 {
+				a, b, c = eulerWrap(a+math.Pi), -b, eulerWrap(c+math.Pi)
+			}
+		} else {
+			a = atan2(r(2, 1), r(1, 1))
+			b *= sign
+		}
+	} else {
+		// Rx(a) Ry(b) Rz(c)
+		cb := hypot(r(0, 0), r(0, 1))
+		b = atan2(r(0, 2), cb)
+		if cb > tol {
+			a = atan2(-r(1, 2), r(2, 2))
+			c = atan2(-r(0, 1), r(0, 0))
+		} else {
+			a = atan2(r(2, 1), r(1, 1))
+		}
+	}
+	eulerSetAngle(result, order, 0, sign*a)
+	eulerSetAngle(result, order, 1, sign*b)
+	eulerSetAngle(result, order, 2, sign*c)
+}
+
+// eulerWrap maps an angle in [0, 2pi] back into [-pi, pi].
+func eulerWrap[T Float](radians T) T {
+	if radians > math.Pi {
+		return radians - 2.0*math.Pi
+	}
+	return radians
+}
+
+func qGetEuler[T Float](result *Vector3Of[T], unitQuat *QuatOf[T], order EulerOrder) {
+	var tmpM3_0 Matrix3Of[T]
+	m3MakeFromQ(&tmpM3_0, unitQuat)
+	m3GetEuler(result, &tmpM3_0, order)
+}
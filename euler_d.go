@@ -0,0 +1,22 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func M3dMakeFromEuler(result *Matrix3d, radians *Vector3d, order EulerOrder) {
+	m3MakeFromEuler(result, radians, order)
+}
+
+func M3dGetEuler(result *Vector3d, mat *Matrix3d, order EulerOrder) {
+	m3GetEuler(result, mat, order)
+}
+
+/*******/
+
+func QdMakeFromEuler(result *Quatd, radians *Vector3d, order EulerOrder) {
+	qMakeFromEuler(result, radians, order)
+}
+
+func QdGetEuler(result *Vector3d, unitQuat *Quatd, order EulerOrder) {
+	qGetEuler(result, unitQuat, order)
+}
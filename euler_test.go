@@ -0,0 +1,112 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var eulerOrders = []EulerOrder{
+	EulerXYZ, EulerXZY, EulerYXZ, EulerYZX, EulerZXY, EulerZYX,
+	EulerXYX, EulerXZX, EulerYXY, EulerYZY, EulerZXZ, EulerZYZ,
+}
+
+// randEulerd returns angles inside the ranges M3dGetEuler reports, away
+// from gimbal lock, so that they survive a round trip unchanged.
+func randEulerd(rng *rand.Rand, order EulerOrder) Vector3d {
+	var radians Vector3d
+	for n := 0; n < 3; n++ {
+		angle := (rng.Float64()*2.0 - 1.0) * 3.1
+		if n == 1 {
+			if order.isProper() {
+				angle = 0.05 + rng.Float64()*3.0
+			} else {
+				angle = (rng.Float64()*2.0 - 1.0) * 1.5
+			}
+		}
+		eulerSetAngle(&radians, order, n, angle)
+	}
+	return radians
+}
+
+func TestEulerRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	for _, order := range eulerOrders {
+		for i := 0; i < 100; i++ {
+			var mat, back Matrix3d
+			var quat Quatd
+			var angles, fromQ Vector3d
+			radians := randEulerd(rng, order)
+			M3dMakeFromEuler(&mat, &radians, order)
+			M3dGetEuler(&angles, &mat, order)
+			if !v3Near(&angles, &radians, 1e-9) {
+				t.Errorf("%v: M3dGetEuler(M3dMakeFromEuler(%v)) = %v", order, radians, angles)
+			}
+			QdMakeFromEuler(&quat, &radians, order)
+			M3dMakeFromQd(&back, &quat)
+			if !m3Near(&back, &mat, 1e-12) {
+				t.Errorf("%v: QdMakeFromEuler(%v) differs from M3dMakeFromEuler", order, radians)
+			}
+			QdGetEuler(&fromQ, &quat, order)
+			if !v3Near(&fromQ, &radians, 1e-9) {
+				t.Errorf("%v: QdGetEuler(QdMakeFromEuler(%v)) = %v", order, radians, fromQ)
+			}
+		}
+	}
+}
+
+func TestEulerMatchesRotationZYX(t *testing.T) {
+	var radians Vector3
+	var mat, want Matrix3
+	V3MakeFromElems(&radians, 0.3, -1.1, 2.5)
+	M3MakeFromEuler(&mat, &radians, EulerZYX)
+	M3MakeRotationZYX(&want, &radians)
+	if !m3Near(&mat, &want, 1e-6) {
+		t.Errorf("M3MakeFromEuler(EulerZYX) = %v, want %v", mat.String(), want.String())
+	}
+	// Rz * Ry * Rx applies the X rotation first.
+	var rx, ry, rz Matrix3
+	M3MakeRotationX(&rx, radians.X)
+	M3MakeRotationY(&ry, radians.Y)
+	M3MakeRotationZ(&rz, radians.Z)
+	M3Mul(&want, &rz, &ry)
+	M3Mul(&want, &want, &rx)
+	if !m3Near(&mat, &want, 1e-6) {
+		t.Errorf("M3MakeFromEuler(EulerZYX) = %v, want Rz*Ry*Rx %v", mat.String(), want.String())
+	}
+}
+
+func TestEulerGimbalLock(t *testing.T) {
+	for _, order := range eulerOrders {
+		var radians, angles Vector3d
+		var mat, back Matrix3d
+		middle := math.Pi / 2.0
+		if order.isProper() {
+			middle = 0.0
+		}
+		eulerSetAngle(&radians, order, 0, 0.4)
+		eulerSetAngle(&radians, order, 1, middle)
+		eulerSetAngle(&radians, order, 2, 0.3)
+		M3dMakeFromEuler(&mat, &radians, order)
+		M3dGetEuler(&angles, &mat, order)
+		if last := eulerGetAngle(&angles, order, 2); last != 0.0 {
+			t.Errorf("%v at gimbal lock: last angle = %v, want 0", order, last)
+		}
+		M3dMakeFromEuler(&back, &angles, order)
+		if !m3Near(&back, &mat, 1e-9) {
+			t.Errorf("%v at gimbal lock: angles %v do not rebuild the rotation", order, angles)
+		}
+	}
+}
+
+func TestEulerOrderString(t *testing.T) {
+	if s := EulerYZY.String(); s != "EulerYZY" {
+		t.Errorf("EulerYZY.String() = %q", s)
+	}
+	if s := EulerOrder(12).String(); s != "EulerOrder(12)" {
+		t.Errorf("EulerOrder(12).String() = %q", s)
+	}
+}
@@ -3,44 +3,319 @@
 
 package vectormath
 
-import "math"
+import (
+	"math"
+	"unsafe"
+)
 
-func max(a, b float32) float32 {
-	return float32(math.Max(float64(a), float64(b)))
+// is32 reports whether T is float32, in which case the helpers below use
+// the native float32 implementations further down.
+func is32[T Float]() bool {
+	var zero T
+	return unsafe.Sizeof(zero) == 4
 }
 
-func min(a, b float32) float32 {
-	return float32(math.Min(float64(a), float64(b)))
+func max[T Float](a, b T) T {
+	return T(math.Max(float64(a), float64(b)))
 }
 
-func abs(a float32) float32 {
-	return float32(math.Abs(float64(a)))
+func min[T Float](a, b T) T {
+	return T(math.Min(float64(a), float64(b)))
 }
 
-func sqrt(a float32) float32 {
-	return float32(math.Sqrt(float64(a)))
+func abs[T Float](a T) T {
+	return T(math.Abs(float64(a)))
 }
 
-func sin(a float32) float32 {
-	return float32(math.Sin(float64(a)))
+// sqrt needs no float32 version: the compiler turns the conversions around
+// math.Sqrt into a single-precision square root, which is correctly rounded.
+func sqrt[T Float](a T) T {
+	return T(math.Sqrt(float64(a)))
 }
 
-func cos(a float32) float32 {
-	return float32(math.Cos(float64(a)))
+func sin[T Float](a T) T {
+	if is32[T]() {
+		return T(sinf(float32(a)))
+	}
+	return T(math.Sin(float64(a)))
 }
 
-func tan(a float32) float32 {
-	return float32(math.Tan(float64(a)))
+func cos[T Float](a T) T {
+	if is32[T]() {
+		return T(cosf(float32(a)))
+	}
+	return T(math.Cos(float64(a)))
 }
 
-func asin(a float32) float32 {
-	return float32(math.Asin(float64(a)))
+// sincos is cheaper than calling sin and cos, as the argument reduction is
+// shared.
+func sincos[T Float](a T) (T, T) {
+	if is32[T]() {
+		s, c := sincosf(float32(a))
+		return T(s), T(c)
+	}
+	s, c := math.Sincos(float64(a))
+	return T(s), T(c)
 }
 
-func acos(a float32) float32 {
-	return float32(math.Acos(float64(a)))
+func tan[T Float](a T) T {
+	if is32[T]() {
+		return T(tanf(float32(a)))
+	}
+	return T(math.Tan(float64(a)))
 }
 
-func atan(a float32) float32 {
-	return float32(math.Atan(float64(a)))
+func asin[T Float](a T) T {
+	if is32[T]() {
+		return T(asinf(float32(a)))
+	}
+	return T(math.Asin(float64(a)))
+}
+
+func acos[T Float](a T) T {
+	if is32[T]() {
+		return T(acosf(float32(a)))
+	}
+	return T(math.Acos(float64(a)))
+}
+
+func atan[T Float](a T) T {
+	if is32[T]() {
+		return T(atanf(float32(a)))
+	}
+	return T(math.Atan(float64(a)))
+}
+
+func floor[T Float](a T) T {
+	return T(math.Floor(float64(a)))
+}
+
+func round[T Float](a T) T {
+	return T(math.Round(float64(a)))
+}
+
+func trunc[T Float](a T) T {
+	return T(math.Trunc(float64(a)))
+}
+
+func atan2[T Float](y, x T) T {
+	return T(math.Atan2(float64(y), float64(x)))
+}
+
+func exp[T Float](a T) T {
+	return T(math.Exp(float64(a)))
+}
+
+func log[T Float](a T) T {
+	return T(math.Log(float64(a)))
+}
+
+func hypot[T Float](p, q T) T {
+	return T(math.Hypot(float64(p), float64(q)))
+}
+
+// machineEpsilon returns the difference between 1 and the next larger value
+// representable in T.
+func machineEpsilon[T Float]() T {
+	if T(1.0)+T(1.0/(1<<30)) == T(1.0) {
+		return 1.0 / (1 << 23)
+	}
+	return 1.0 / (1 << 52)
+}
+
+/*******/
+
+// The float32 functions below are adapted from the Cephes library's
+// single-precision versions. Apart from the argument reduction for sin, cos
+// and tan, each is evaluated in float32. The maximum errors quoted are
+// against the float64 math functions, measured over a million float32
+// samples from the stated range; TestF32MathAccuracy repeats the measurement.
+//
+// They are less accurate than the float64 round trip they replace, which
+// was correctly rounded in all but rare cases, so float32 results from the
+// trigonometric functions and everything built on them, such as
+// M3MakeRotationAxis and QSlerp, can differ from earlier versions in the
+// last bit or two.
+
+const (
+	g_PI             = 3.14159265358979323846
+	g_HALF_PI        = 1.57079632679489661923
+	g_PI_OVER_4      = 0.78539816339744830962
+	g_4_OVER_PI      = 1.27323954473516268615
+	g_TAN_3PI_OVER_8 = 2.41421356237309504880
+	g_TAN_PI_OVER_8  = 0.41421356237309504880
+	g_TRIG_MAX       = 16.0
+	g_ASIN_SMALL     = 1.0e-4
+)
+
+// reducef reduces ax >= 0 to z in [-pi/4, pi/4], returning the octant j
+// such that ax = z + j*pi/4. Splitting pi/4 into float32 parts, as Cephes
+// does, leaves an error that costs over 10 ULP near the zeros of sin and cos
+// as soon as |x| = 3pi, so this one subtraction is done in float64. Even
+// that is not enough near the zeros beyond g_TRIG_MAX, where the callers
+// switch to the float64 functions.
+func reducef(ax float32) (float32, int) {
+	j := int(g_4_OVER_PI * ax)
+	if j&1 != 0 {
+		j++
+	}
+	z := float32(float64(ax) - float64(j)*g_PI_OVER_4)
+	return z, j & 7
+}
+
+func sinPolyf(z float32) float32 {
+	zz := z * z
+	return ((-1.9515295891e-4*zz+8.3321608736e-3)*zz-1.6666654611e-1)*zz*z + z
+}
+
+func cosPolyf(z float32) float32 {
+	zz := z * z
+	return ((2.443315711809948e-5*zz-1.388731625493765e-3)*zz+4.166664568298827e-2)*zz*zz - 0.5*zz + 1.0
+}
+
+// sinf is within 1.6 ULP for |x| <= 16, and falls back to math.Sin beyond
+// that.
+func sinf(x float32) float32 {
+	ax, sign := x, float32(1.0)
+	if x < 0.0 {
+		ax, sign = -x, -1.0
+	}
+	if !(ax <= g_TRIG_MAX) {
+		return float32(math.Sin(float64(x)))
+	}
+	z, j := reducef(ax)
+	if j > 3 {
+		sign = -sign
+		j -= 4
+	}
+	if j == 1 || j == 2 {
+		return sign * cosPolyf(z)
+	}
+	return sign * sinPolyf(z)
+}
+
+// cosf has the same accuracy and range as sinf.
+func cosf(x float32) float32 {
+	ax := abs(x)
+	if !(ax <= g_TRIG_MAX) {
+		return float32(math.Cos(float64(x)))
+	}
+	z, j := reducef(ax)
+	sign := float32(1.0)
+	if j > 3 {
+		sign = -sign
+		j -= 4
+	}
+	if j > 1 {
+		sign = -sign
+	}
+	if j == 1 || j == 2 {
+		return sign * sinPolyf(z)
+	}
+	return sign * cosPolyf(z)
+}
+
+// sincosf returns exactly sinf(x), cosf(x).
+func sincosf(x float32) (float32, float32) {
+	ax, sinSign, cosSign := x, float32(1.0), float32(1.0)
+	if x < 0.0 {
+		ax, sinSign = -x, -1.0
+	}
+	if !(ax <= g_TRIG_MAX) {
+		s, c := math.Sincos(float64(x))
+		return float32(s), float32(c)
+	}
+	z, j := reducef(ax)
+	if j > 3 {
+		sinSign = -sinSign
+		cosSign = -cosSign
+		j -= 4
+	}
+	if j > 1 {
+		cosSign = -cosSign
+	}
+	s, c := sinPolyf(z), cosPolyf(z)
+	if j == 1 || j == 2 {
+		s, c = c, s
+	}
+	return sinSign * s, cosSign * c
+}
+
+// tanf is within 2.7 ULP for |x| <= 16, and falls back to math.Tan beyond
+// that.
+func tanf(x float32) float32 {
+	ax, sign := x, float32(1.0)
+	if x < 0.0 {
+		ax, sign = -x, -1.0
+	}
+	if !(ax <= g_TRIG_MAX) {
+		return float32(math.Tan(float64(x)))
+	}
+	z, j := reducef(ax)
+	zz := z * z
+	r := (((((9.38540185543e-3*zz+3.11992232697e-3)*zz+2.44301354525e-2)*zz+5.34112807005e-2)*zz+1.33387994085e-1)*zz+3.33331568548e-1)*zz*z + z
+	if j&2 != 0 {
+		r = -1.0 / r
+	}
+	return sign * r
+}
+
+// asinf is within 2.5 ULP over [-1, 1].
+func asinf(x float32) float32 {
+	a, sign := x, float32(1.0)
+	if x < 0.0 {
+		a, sign = -x, -1.0
+	}
+	if a > 1.0 {
+		return float32(math.NaN())
+	}
+	if a < g_ASIN_SMALL {
+		return x
+	}
+	var z, xx float32
+	if a > 0.5 {
+		z = 0.5 * (1.0 - a)
+		xx = sqrt(z)
+	} else {
+		z = a * a
+		xx = a
+	}
+	r := ((((4.2163199048e-2*z+2.4181311049e-2)*z+4.5470025998e-2)*z+7.4953002686e-2)*z+1.6666752422e-1)*z*xx + xx
+	if a > 0.5 {
+		r = g_HALF_PI - (r + r)
+	}
+	return sign * r
+}
+
+// acosf is within 1.3 ULP over [-1, 1].
+func acosf(x float32) float32 {
+	if x < -1.0 || x > 1.0 {
+		return float32(math.NaN())
+	}
+	if x < -0.5 {
+		return g_PI - 2.0*asinf(sqrt(0.5*(1.0+x)))
+	}
+	if x > 0.5 {
+		return 2.0 * asinf(sqrt(0.5*(1.0-x)))
+	}
+	return g_HALF_PI - asinf(x)
+}
+
+// atanf is within 2.9 ULP for all x.
+func atanf(x float32) float32 {
+	ax, sign := x, float32(1.0)
+	if x < 0.0 {
+		ax, sign = -x, -1.0
+	}
+	var y float32
+	if ax > g_TAN_3PI_OVER_8 {
+		y = g_HALF_PI
+		ax = -1.0 / ax
+	} else if ax > g_TAN_PI_OVER_8 {
+		y = g_PI_OVER_4
+		ax = (ax - 1.0) / (ax + 1.0)
+	}
+	z := ax * ax
+	y += (((8.05374449538e-2*z-1.38776856032e-1)*z+1.99777106478e-1)*z-3.33329491539e-1)*z*ax + ax
+	return sign * y
 }
@@ -0,0 +1,159 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// ulpError returns the error of got, in units in the last place of the
+// float32 nearest to the float64 reference want.
+func ulpError(got float32, want float64) float64 {
+	rounded := float32(want)
+	if got == rounded {
+		return 0.0
+	}
+	ulp := math.Nextafter32(rounded, float32(math.Inf(1))) - rounded
+	if ulp == 0.0 || math.IsInf(float64(ulp), 0) {
+		ulp = rounded - math.Nextafter32(rounded, float32(math.Inf(-1)))
+	}
+	return math.Abs(float64(got)-want) / float64(ulp)
+}
+
+// The samples mix values spread evenly over the range with values spread
+// evenly over the float32 bit patterns in it, which reach the tiny
+// magnitudes that a uniform sample misses.
+func sampleF32(rng *rand.Rand, limit float32) float32 {
+	if rng.Intn(2) == 0 {
+		return (rng.Float32()*2.0 - 1.0) * limit
+	}
+	x := math.Float32frombits(rng.Uint32() % math.Float32bits(limit))
+	if rng.Intn(2) == 0 {
+		x = -x
+	}
+	return x
+}
+
+func TestF32MathAccuracy(t *testing.T) {
+	cases := []struct {
+		name   string
+		f32    func(float32) float32
+		f64    func(float64) float64
+		limit  float32
+		maxULP float64
+	}{
+		{"sinf", sinf, math.Sin, g_TRIG_MAX, 1.6},
+		{"cosf", cosf, math.Cos, g_TRIG_MAX, 1.6},
+		{"tanf", tanf, math.Tan, g_TRIG_MAX, 2.7},
+		{"asinf", asinf, math.Asin, 1.0, 2.5},
+		{"acosf", acosf, math.Acos, 1.0, 1.3},
+		{"atanf", atanf, math.Atan, 1e6, 2.9},
+	}
+	samples := 1000000
+	if testing.Short() {
+		samples = 50000
+	}
+	rng := rand.New(rand.NewSource(14))
+	for _, c := range cases {
+		worst, worstX := 0.0, float32(0.0)
+		for i := 0; i < samples; i++ {
+			x := sampleF32(rng, c.limit)
+			if e := ulpError(c.f32(x), c.f64(float64(x))); e > worst {
+				worst, worstX = e, x
+			}
+		}
+		if worst > c.maxULP {
+			t.Errorf("%s(%v) is off by %.2f ULP, documented bound is %v", c.name, worstX, worst, c.maxULP)
+		}
+		t.Logf("%s: worst %.2f ULP at %v", c.name, worst, worstX)
+	}
+}
+
+func TestF32MathSpecialValues(t *testing.T) {
+	inf := float32(math.Inf(1))
+	if got := sinf(1e-30); got != 1e-30 {
+		t.Errorf("sinf(1e-30) = %v", got)
+	}
+	for _, x := range []float32{inf, -inf, float32(math.NaN())} {
+		if got := sinf(x); !math.IsNaN(float64(got)) {
+			t.Errorf("sinf(%v) = %v, want NaN", x, got)
+		}
+	}
+	if got := asinf(1.0000001); !math.IsNaN(float64(got)) {
+		t.Errorf("asinf(1.0000001) = %v, want NaN", got)
+	}
+	if got := atanf(inf); got != float32(g_HALF_PI) {
+		t.Errorf("atanf(+Inf) = %v, want pi/2", got)
+	}
+	// Beyond g_TRIG_MAX the float64 functions take over.
+	if got, want := sinf(1e6), float32(math.Sin(1e6)); got != want {
+		t.Errorf("sinf(1e6) = %v, want %v", got, want)
+	}
+}
+
+func TestSincosf(t *testing.T) {
+	rng := rand.New(rand.NewSource(15))
+	for i := 0; i < 100000; i++ {
+		x := float32(rng.NormFloat64() * 10.0)
+		s, c := sincosf(x)
+		if s != sinf(x) || c != cosf(x) {
+			t.Fatalf("sincosf(%v) = %v, %v, want %v, %v", x, s, c, sinf(x), cosf(x))
+		}
+	}
+}
+
+func TestRecipPerElemFast(t *testing.T) {
+	check := func(name string, x, got float64, sqrtX bool) {
+		t.Helper()
+		inv := x
+		if sqrtX {
+			inv = math.Sqrt(x)
+		}
+		if e := math.Abs(got*inv - 1.0); e > 3e-7 {
+			t.Fatalf("%s of %v has relative error %v", name, x, e)
+		}
+	}
+	rng := rand.New(rand.NewSource(16))
+	for i := 0; i < 100000; i++ {
+		var vec, recip, rsqrt Vector4
+		for j := 0; j < 4; j++ {
+			vec.SetElem(j, float32(math.Exp(rng.NormFloat64()*20.0)))
+		}
+		var vec3, recip3, rsqrt3 Vector3
+		var pnt, recipP3, rsqrtP3 Point3
+		V4GetXYZ(&vec3, &vec)
+		P3MakeFromV3(&pnt, &vec3)
+		V4RecipPerElemFast(&recip, &vec)
+		V4RsqrtPerElemFast(&rsqrt, &vec)
+		V3RecipPerElemFast(&recip3, &vec3)
+		V3RsqrtPerElemFast(&rsqrt3, &vec3)
+		P3RecipPerElemFast(&recipP3, &pnt)
+		P3RsqrtPerElemFast(&rsqrtP3, &pnt)
+		for j := 0; j < 4; j++ {
+			x := float64(vec.GetElem(j))
+			if x < 1e-37 || x > 1e37 {
+				continue
+			}
+			check("V4RecipPerElemFast", x, float64(recip.GetElem(j)), false)
+			check("V4RsqrtPerElemFast", x, float64(rsqrt.GetElem(j)), true)
+			if j == 3 {
+				continue
+			}
+			check("V3RecipPerElemFast", x, float64(recip3.GetElem(j)), false)
+			check("V3RsqrtPerElemFast", x, float64(rsqrt3.GetElem(j)), true)
+			check("P3RecipPerElemFast", x, float64(recipP3.GetElem(j)), false)
+			check("P3RsqrtPerElemFast", x, float64(rsqrtP3.GetElem(j)), true)
+		}
+	}
+
+	// The precise functions are unaffected.
+	var vec, recip Vector3
+	V3MakeFromElems(&vec, 3.0, 7.0, 11.0)
+	V3RecipPerElem(&recip, &vec)
+	if want := (Vector3{X: 1.0 / 3.0, Y: 1.0 / 7.0, Z: 1.0 / 11.0}); recip != want {
+		t.Errorf("V3RecipPerElem(%v) = %v, want %v", vec, recip, want)
+	}
+}
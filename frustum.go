@@ -0,0 +1,53 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Frustum = FrustumOf[float32]
+
+func FrustumCopy(result, fr *Frustum) {
+	frustumCopy(result, fr)
+}
+
+// FrustumMakeFromM4 extracts the six planes of the volume that mat maps into
+// OpenGL's clip cube, -w <= x, y, z <= w, as produced by M4MakePerspective,
+// M4MakeFrustum and M4MakeOrthographic. Pass projection * view to get the
+// planes in world space. The plane normals are unit length and point inwards.
+func FrustumMakeFromM4(result *Frustum, mat *Matrix4) {
+	frustumMakeFromM4(result, mat)
+}
+
+// FrustumMakeFromM4Clip is FrustumMakeFromM4 for a projection built for
+// clip, e.g. by M4MakePerspectiveClip. If the projection has no far plane,
+// the far plane has a zero normal and an infinite D, so that everything is
+// inside it, and FrustumGetCorners sets the far corners to NaN.
+func FrustumMakeFromM4Clip(result *Frustum, mat *Matrix4, clip ClipSpace) {
+	frustumMakeFromM4Clip(result, mat, clip)
+}
+
+// FrustumGetCorners stores the eight corners of fr in result. Bit 0, 1 and 2
+// of the index select the right, top and far plane respectively, so corner 0
+// is near-bottom-left and corner 7 is far-top-right. A corner whose three
+// planes do not meet in a single point, such as a far corner of a frustum
+// with no far plane, is set to NaN.
+func FrustumGetCorners(result *[8]Point3, fr *Frustum) {
+	frustumGetCorners(result, fr)
+}
+
+// FrustumClassifySpheres classifies each sphere against fr, storing the
+// outcome for centers[i] and radii[i] in result[i]. It panics if the slices
+// differ in length.
+func FrustumClassifySpheres(result []CullResult, fr *Frustum, centers []Point3, radii []float32) {
+	frustumClassifySpheres(result, fr, centers, radii)
+}
+
+// FrustumClassifyAABBs classifies each box against fr, storing the outcome
+// for boxes[i] in result[i]. It panics if the slices differ in length.
+func FrustumClassifyAABBs(result []CullResult, fr *Frustum, boxes []AABB) {
+	frustumClassifyAABBs(result, fr, boxes)
+}
+
+// FrustumClassifyOBBs is FrustumClassifyAABBs for oriented boxes.
+func FrustumClassifyOBBs(result []CullResult, fr *Frustum, boxes []OBB) {
+	frustumClassifyOBBs(result, fr, boxes)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"fmt"
+	"math"
+)
+
+// CullResult is the outcome of testing a bounding volume against a Frustum.
+type CullResult int
+
+const (
+	CullOutside CullResult = iota
+	CullIntersecting
+	CullInside
+)
+
+// Indices of the planes in a Frustum.
+const (
+	FrustumLeft = iota
+	FrustumRight
+	FrustumBottom
+	FrustumTop
+	FrustumNear
+	FrustumFar
+)
+
+// FrustumOf is a convex volume bounded by six planes whose unit normals
+// point inwards.
+type FrustumOf[T Float] struct {
+	Planes [6]PlaneOf[T]
+}
+
+func frustumCopy[T Float](result, fr *FrustumOf[T]) {
+	result.Planes = fr.Planes
+}
+
+// frustumMakeFromM4 extracts the planes of the clip volume of mat using
+// the Gribb-Hartmann method. Clip space is taken to be OpenGL's, with
+// -w <= x, y, z <= w, as produced by M4MakePerspective, M4MakeFrustum and
+// M4MakeOrthographic. When mat is projection * view the planes are in world
+// space; when it is projection * view * model they are in model space.
+func frustumMakeFromM4[T Float](result *FrustumOf[T], mat *Matrix4Of[T]) {
+	frustumMakeFromM4Clip(result, mat, ClipOpenGL)
+}
+
+// frustumMakeFromM4Clip is frustumMakeFromM4 for a projection built for the
+// given clip space. The far plane of a projection with an infinite far
+// distance has a zero normal, and is given an infinite D so that nothing
+// is outside it.
+func frustumMakeFromM4Clip[T Float](result *FrustumOf[T], mat *Matrix4Of[T], clip ClipSpace) {
+	var row0, row1, row2, row3, tmpV4_0 Vector4Of[T]
+	m4GetRow(&row0, mat, 0)
+	m4GetRow(&row1, mat, 1)
+	m4GetRow(&row2, mat, 2)
+	m4GetRow(&row3, mat, 3)
+	bottom, top := FrustumBottom, FrustumTop
+	if clip&ClipFlipY != 0 {
+		bottom, top = top, bottom
+	}
+	zMin, zMax := FrustumNear, FrustumFar
+	if clip&ClipReverseZ != 0 {
+		zMin, zMax = zMax, zMin
+	}
+	v4Add(&tmpV4_0, &row3, &row0)
+	planeMakeFromV4(&result.Planes[FrustumLeft], &tmpV4_0)
+	v4Sub(&tmpV4_0, &row3, &row0)
+	planeMakeFromV4(&result.Planes[FrustumRight], &tmpV4_0)
+	v4Add(&tmpV4_0, &row3, &row1)
+	planeMakeFromV4(&result.Planes[bottom], &tmpV4_0)
+	v4Sub(&tmpV4_0, &row3, &row1)
+	planeMakeFromV4(&result.Planes[top], &tmpV4_0)
+	if clip&ClipDepthZeroToOne != 0 {
+		planeMakeFromV4(&result.Planes[zMin], &row2)
+	} else {
+		v4Add(&tmpV4_0, &row3, &row2)
+		planeMakeFromV4(&result.Planes[zMin], &tmpV4_0)
+	}
+	v4Sub(&tmpV4_0, &row3, &row2)
+	planeMakeFromV4(&result.Planes[zMax], &tmpV4_0)
+	for i := range result.Planes {
+		if result.Planes[i].Normal.LengthSqr() == 0.0 {
+			result.Planes[i].D = T(math.Inf(1))
+			continue
+		}
+		planeNormalize(&result.Planes[i], &result.Planes[i])
+	}
+}
+
+// frustumGetCorners intersects each combination of side, top/bottom and
+// near/far planes. Bit 0, 1 and 2 of the index select the right, top and
+// far plane respectively, so corner 0 is near-bottom-left and corner 7 is
+// far-top-right. Corners whose planes do not meet in a single point are set
+// to NaN.
+func frustumGetCorners[T Float](result *[8]Point3Of[T], fr *FrustumOf[T]) {
+	nan := T(math.NaN())
+	for i := range result {
+		x, y, z := FrustumLeft, FrustumBottom, FrustumNear
+		if i&1 != 0 {
+			x = FrustumRight
+		}
+		if i&2 != 0 {
+			y = FrustumTop
+		}
+		if i&4 != 0 {
+			z = FrustumFar
+		}
+		if !planeIntersectPlanes(&result[i], &fr.Planes[x], &fr.Planes[y], &fr.Planes[z], 0.0) {
+			p3MakeFromElems(&result[i], nan, nan, nan)
+		}
+	}
+}
+
+func frustumClassifySpheres[T Float](result []CullResult, fr *FrustumOf[T], centers []Point3Of[T], radii []T) {
+	if len(result) != len(centers) || len(radii) != len(centers) {
+		panic("vectormath: slice length mismatch")
+	}
+	for i := range centers {
+		result[i] = fr.ClassifySphere(&centers[i], radii[i])
+	}
+}
+
+func frustumClassifyAABBs[T Float](result []CullResult, fr *FrustumOf[T], boxes []AABBOf[T]) {
+	if len(result) != len(boxes) {
+		panic("vectormath: slice length mismatch")
+	}
+	for i := range boxes {
+		result[i] = fr.ClassifyAABB(&boxes[i])
+	}
+}
+
+func frustumClassifyOBBs[T Float](result []CullResult, fr *FrustumOf[T], boxes []OBBOf[T]) {
+	if len(result) != len(boxes) {
+		panic("vectormath: slice length mismatch")
+	}
+	for i := range boxes {
+		result[i] = fr.ClassifyOBB(&boxes[i])
+	}
+}
+
+func (f *FrustumOf[T]) ClassifyPoint(pnt *Point3Of[T]) CullResult {
+	for i := range f.Planes {
+		if f.Planes[i].SignedDist(pnt) < 0.0 {
+			return CullOutside
+		}
+	}
+	return CullInside
+}
+
+// ClassifySphere and the other volume tests reject the volume as soon as it
+// lies entirely behind one plane, so a volume straddling the extension of
+// two planes near a corner may be reported as intersecting although it is
+// outside.
+func (f *FrustumOf[T]) ClassifySphere(center *Point3Of[T], radius T) CullResult {
+	result := CullInside
+	for i := range f.Planes {
+		d := f.Planes[i].SignedDist(center)
+		if d < -radius {
+			return CullOutside
+		}
+		if d < radius {
+			result = CullIntersecting
+		}
+	}
+	return result
+}
+
+// ClassifyAABB projects the box's extents onto each plane normal, which is
+// equivalent to testing the box corners nearest to and furthest from the
+// plane.
+func (f *FrustumOf[T]) ClassifyAABB(box *AABBOf[T]) CullResult {
+	var center Point3Of[T]
+	var extents Vector3Of[T]
+	if box.IsEmpty() {
+		return CullOutside
+	}
+	aabbGetCenter(&center, box)
+	aabbGetExtents(&extents, box)
+	result := CullInside
+	for i := range f.Planes {
+		n := &f.Planes[i].Normal
+		d := f.Planes[i].SignedDist(&center)
+		r := extents.X*abs(n.X) + extents.Y*abs(n.Y) + extents.Z*abs(n.Z)
+		if d < -r {
+			return CullOutside
+		}
+		if d < r {
+			result = CullIntersecting
+		}
+	}
+	return result
+}
+
+func (f *FrustumOf[T]) ClassifyOBB(box *OBBOf[T]) CullResult {
+	result := CullInside
+	for i := range f.Planes {
+		n := &f.Planes[i].Normal
+		d := f.Planes[i].SignedDist(&box.Center)
+		r := box.Extents.X*abs(v3Dot(n, &box.Axes.col0)) +
+			box.Extents.Y*abs(v3Dot(n, &box.Axes.col1)) +
+			box.Extents.Z*abs(v3Dot(n, &box.Axes.col2))
+		if d < -r {
+			return CullOutside
+		}
+		if d < r {
+			result = CullIntersecting
+		}
+	}
+	return result
+}
+
+func (f *FrustumOf[T]) String() string {
+	s := ""
+	for i := range f.Planes {
+		s += f.Planes[i].String() + "\n"
+	}
+	return s
+}
+
+func (c CullResult) String() string {
+	switch c {
+	case CullOutside:
+		return "Outside"
+	case CullIntersecting:
+		return "Intersecting"
+	case CullInside:
+		return "Inside"
+	}
+	return fmt.Sprintf("CullResult(%d)", int(c))
+}
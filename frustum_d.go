@@ -0,0 +1,34 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Frustumd = FrustumOf[float64]
+
+func FrustumdCopy(result, fr *Frustumd) {
+	frustumCopy(result, fr)
+}
+
+func FrustumdMakeFromM4d(result *Frustumd, mat *Matrix4d) {
+	frustumMakeFromM4(result, mat)
+}
+
+func FrustumdMakeFromM4dClip(result *Frustumd, mat *Matrix4d, clip ClipSpace) {
+	frustumMakeFromM4Clip(result, mat, clip)
+}
+
+func FrustumdGetCorners(result *[8]Point3d, fr *Frustumd) {
+	frustumGetCorners(result, fr)
+}
+
+func FrustumdClassifySpheres(result []CullResult, fr *Frustumd, centers []Point3d, radii []float64) {
+	frustumClassifySpheres(result, fr, centers, radii)
+}
+
+func FrustumdClassifyAABBs(result []CullResult, fr *Frustumd, boxes []AABBd) {
+	frustumClassifyAABBs(result, fr, boxes)
+}
+
+func FrustumdClassifyOBBs(result []CullResult, fr *Frustumd, boxes []OBBd) {
+	frustumClassifyOBBs(result, fr, boxes)
+}
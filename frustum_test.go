@@ -0,0 +1,142 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+// makeTestFrustum returns the frustum of a camera at (0, 0, 10) looking down
+// -Z, with a 90 degree field of view and near and far planes at 1 and 10,
+// so it spans z from 9 to 0 with |x|, |y| <= 10 - z.
+func makeTestFrustum() Frustum {
+	var eye, target Point3
+	var up Vector3
+	var view, proj, viewProj Matrix4
+	var fr Frustum
+	P3MakeFromElems(&eye, 0.0, 0.0, 10.0)
+	P3MakeFromElems(&target, 0.0, 0.0, 0.0)
+	V3MakeYAxis(&up)
+	M4MakeLookAt(&view, &eye, &target, &up)
+	M4MakePerspective(&proj, g_PI_OVER_2, 1.0, 1.0, 10.0)
+	M4Mul(&viewProj, &proj, &view)
+	FrustumMakeFromM4(&fr, &viewProj)
+	return fr
+}
+
+func TestFrustumMakeFromM4(t *testing.T) {
+	fr := makeTestFrustum()
+	for i := range fr.Planes {
+		if !near(fr.Planes[i].Normal.Length(), 1.0, 1e-6) {
+			t.Errorf("plane %d = %v, want a unit normal", i, fr.Planes[i].String())
+		}
+	}
+	var corners [8]Point3
+	FrustumGetCorners(&corners, &fr)
+	want := [8]Point3{
+		{X: -1.0, Y: -1.0, Z: 9.0}, {X: 1.0, Y: -1.0, Z: 9.0},
+		{X: -1.0, Y: 1.0, Z: 9.0}, {X: 1.0, Y: 1.0, Z: 9.0},
+		{X: -10.0, Y: -10.0, Z: 0.0}, {X: 10.0, Y: -10.0, Z: 0.0},
+		{X: -10.0, Y: 10.0, Z: 0.0}, {X: 10.0, Y: 10.0, Z: 0.0},
+	}
+	for i := range corners {
+		if !p3Near(&corners[i], &want[i], 1e-4) {
+			t.Errorf("corner %d = %v, want %v", i, corners[i], want[i])
+		}
+	}
+}
+
+func TestFrustumClassifyPoint(t *testing.T) {
+	fr := makeTestFrustum()
+	for _, c := range []struct {
+		pnt  Point3
+		want CullResult
+	}{
+		{Point3{X: 0.0, Y: 0.0, Z: 5.0}, CullInside},
+		{Point3{X: 4.9, Y: -4.9, Z: 5.0}, CullInside},
+		{Point3{X: 0.0, Y: 0.0, Z: 9.5}, CullOutside},
+		{Point3{X: 0.0, Y: 0.0, Z: -0.5}, CullOutside},
+		{Point3{X: 5.1, Y: 0.0, Z: 5.0}, CullOutside},
+		{Point3{X: 0.0, Y: 5.1, Z: 5.0}, CullOutside},
+	} {
+		if got := fr.ClassifyPoint(&c.pnt); got != c.want {
+			t.Errorf("ClassifyPoint(%v) = %v, want %v", c.pnt, got, c.want)
+		}
+	}
+}
+
+func TestFrustumClassifyVolumes(t *testing.T) {
+	fr := makeTestFrustum()
+	for _, c := range []struct {
+		name   string
+		center Point3
+		radius float32
+		want   CullResult
+	}{
+		{"inside", Point3{Z: 5.0}, 1.0, CullInside},
+		{"across the near plane", Point3{Z: 9.0}, 0.5, CullIntersecting},
+		{"across the far plane", Point3{Z: 0.0}, 0.5, CullIntersecting},
+		{"across a side", Point3{X: 5.0, Z: 5.0}, 0.5, CullIntersecting},
+		{"beyond the far plane", Point3{Z: -2.0}, 1.0, CullOutside},
+		{"off to the side", Point3{X: 8.0, Z: 5.0}, 1.0, CullOutside},
+	} {
+		var box AABB
+		var extents Vector3
+		var obox OBB
+		var axes Matrix3
+		if got := fr.ClassifySphere(&c.center, c.radius); got != c.want {
+			t.Errorf("ClassifySphere, %s: %v, want %v", c.name, got, c.want)
+		}
+		// Cubes whose inscribed sphere is the sphere, the second one turned
+		// 45 degrees about Y.
+		V3MakeFromScalar(&extents, c.radius)
+		AABBMakeFromCenterExtents(&box, &c.center, &extents)
+		if got := fr.ClassifyAABB(&box); got != c.want {
+			t.Errorf("ClassifyAABB, %s: %v, want %v", c.name, got, c.want)
+		}
+		M3MakeRotationY(&axes, g_PI_OVER_2/2.0)
+		OBBMakeFromCenterAxesExtents(&obox, &c.center, &axes, &extents)
+		if got := fr.ClassifyOBB(&obox); got != c.want {
+			t.Errorf("ClassifyOBB, %s: %v, want %v", c.name, got, c.want)
+		}
+	}
+
+	var box AABB
+	AABBMakeEmpty(&box)
+	if got := fr.ClassifyAABB(&box); got != CullOutside {
+		t.Errorf("ClassifyAABB(empty) = %v, want Outside", got)
+	}
+}
+
+func TestFrustumClassifyBatch(t *testing.T) {
+	fr := makeTestFrustum()
+	centers := []Point3{{Z: 5.0}, {Z: 0.0}, {X: 8.0, Z: 5.0}}
+	radii := []float32{1.0, 0.5, 1.0}
+	want := []CullResult{CullInside, CullIntersecting, CullOutside}
+	result := make([]CullResult, len(centers))
+	FrustumClassifySpheres(result, &fr, centers, radii)
+	for i := range result {
+		if result[i] != want[i] {
+			t.Errorf("FrustumClassifySpheres[%d] = %v, want %v", i, result[i], want[i])
+		}
+	}
+
+	boxes := make([]AABB, len(centers))
+	for i := range centers {
+		var extents Vector3
+		V3MakeFromScalar(&extents, radii[i])
+		AABBMakeFromCenterExtents(&boxes[i], &centers[i], &extents)
+	}
+	FrustumClassifyAABBs(result, &fr, boxes)
+	for i := range result {
+		if result[i] != want[i] {
+			t.Errorf("FrustumClassifyAABBs[%d] = %v, want %v", i, result[i], want[i])
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("FrustumClassifySpheres did not panic on mismatched slices")
+		}
+	}()
+	FrustumClassifySpheres(result[:2], &fr, centers, radii)
+}
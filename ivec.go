@@ -0,0 +1,773 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+// IVector2, IVector3 and IVector4 are integer vectors, e.g. for grid cells
+// and voxel coordinates. Unlike Vector3 they have no padding element.
+//
+// The conversions from float vectors come in Floor, Round and Trunc
+// variants, which round each element towards negative infinity, to the
+// nearest integer with halves away from zero, and towards zero. Elements
+// that are NaN or out of the int32 range after rounding give unspecified
+// results.
+type IVector2 struct {
+	X, Y int32
+}
+
+type IVector3 struct {
+	X, Y, Z int32
+}
+
+type IVector4 struct {
+	X, Y, Z, W int32
+}
+
+func imax(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func imin(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// iabs wraps around for math.MinInt32, which has no positive counterpart,
+// returning it unchanged as Go's negation does.
+func iabs(a int32) int32 {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+/*******/
+
+func IV2Copy(result, vec *IVector2) {
+	result.X = vec.X
+	result.Y = vec.Y
+}
+
+func IV2MakeFromElems(result *IVector2, x, y int32) {
+	result.X = x
+	result.Y = y
+}
+
+func IV2MakeFromScalar(result *IVector2, scalar int32) {
+	result.X = scalar
+	result.Y = scalar
+}
+
+func (v *IVector2) SetElem(index int, value int32) {
+	switch index {
+	case 0:
+		v.X = value
+	case 1:
+		v.Y = value
+	}
+}
+
+func (v *IVector2) GetElem(index int) int32 {
+	switch index {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	}
+	return 0
+}
+
+func IV2Add(result, vec0, vec1 *IVector2) {
+	result.X = vec0.X + vec1.X
+	result.Y = vec0.Y + vec1.Y
+}
+
+func IV2Sub(result, vec0, vec1 *IVector2) {
+	result.X = vec0.X - vec1.X
+	result.Y = vec0.Y - vec1.Y
+}
+
+func IV2MulPerElem(result, vec0, vec1 *IVector2) {
+	result.X = vec0.X * vec1.X
+	result.Y = vec0.Y * vec1.Y
+}
+
+func IV2ScalarMul(result, vec *IVector2, scalar int32) {
+	result.X = vec.X * scalar
+	result.Y = vec.Y * scalar
+}
+
+func IV2Neg(result, vec *IVector2) {
+	result.X = -vec.X
+	result.Y = -vec.Y
+}
+
+// IV2AbsPerElem leaves any element equal to math.MinInt32 unchanged.
+func IV2AbsPerElem(result, vec *IVector2) {
+	result.X = iabs(vec.X)
+	result.Y = iabs(vec.Y)
+}
+
+func IV2MaxPerElem(result, vec0, vec1 *IVector2) {
+	result.X = imax(vec0.X, vec1.X)
+	result.Y = imax(vec0.Y, vec1.Y)
+}
+
+func IV2MinPerElem(result, vec0, vec1 *IVector2) {
+	result.X = imin(vec0.X, vec1.X)
+	result.Y = imin(vec0.Y, vec1.Y)
+}
+
+func (v IVector2) MaxElem() int32 {
+	return imax(v.X, v.Y)
+}
+
+func (v IVector2) MinElem() int32 {
+	return imin(v.X, v.Y)
+}
+
+func (v IVector2) Sum() int32 {
+	return v.X + v.Y
+}
+
+func IV2Dot(vec0, vec1 *IVector2) int32 {
+	return vec0.X*vec1.X + vec0.Y*vec1.Y
+}
+
+// IV2CmpEq and the other comparisons return a mask with bit i set where
+// element i of vec0 and vec1 satisfies the relation: bit 0 for X and bit 1
+// for Y.
+func IV2CmpEq(vec0, vec1 *IVector2) int {
+	mask := 0
+	if vec0.X == vec1.X {
+		mask |= 1
+	}
+	if vec0.Y == vec1.Y {
+		mask |= 2
+	}
+	return mask
+}
+
+func IV2CmpLt(vec0, vec1 *IVector2) int {
+	mask := 0
+	if vec0.X < vec1.X {
+		mask |= 1
+	}
+	if vec0.Y < vec1.Y {
+		mask |= 2
+	}
+	return mask
+}
+
+func IV2CmpLe(vec0, vec1 *IVector2) int {
+	mask := 0
+	if vec0.X <= vec1.X {
+		mask |= 1
+	}
+	if vec0.Y <= vec1.Y {
+		mask |= 2
+	}
+	return mask
+}
+
+func IV2CmpGt(vec0, vec1 *IVector2) int {
+	mask := 0
+	if vec0.X > vec1.X {
+		mask |= 1
+	}
+	if vec0.Y > vec1.Y {
+		mask |= 2
+	}
+	return mask
+}
+
+func IV2CmpGe(vec0, vec1 *IVector2) int {
+	mask := 0
+	if vec0.X >= vec1.X {
+		mask |= 1
+	}
+	if vec0.Y >= vec1.Y {
+		mask |= 2
+	}
+	return mask
+}
+
+// IV2SelectPerElem takes element i from vec1 where bit i of mask is set,
+// and from vec0 otherwise. mask is as returned by IV2CmpEq and friends.
+func IV2SelectPerElem(result, vec0, vec1 *IVector2, mask int) {
+	if mask&1 != 0 {
+		result.X = vec1.X
+	} else {
+		result.X = vec0.X
+	}
+	if mask&2 != 0 {
+		result.Y = vec1.Y
+	} else {
+		result.Y = vec0.Y
+	}
+}
+
+func IV2Select(result, vec0, vec1 *IVector2, select1 int) {
+	if select1 != 0 {
+		*result = *vec1
+	} else {
+		*result = *vec0
+	}
+}
+
+func (v *IVector2) String() string {
+	return fmt.Sprintf("( %d %d )\n", v.X, v.Y)
+}
+
+/*******/
+
+func IV3Copy(result, vec *IVector3) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+}
+
+func IV3MakeFromElems(result *IVector3, x, y, z int32) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+}
+
+func IV3MakeFromScalar(result *IVector3, scalar int32) {
+	result.X = scalar
+	result.Y = scalar
+	result.Z = scalar
+}
+
+func (v *IVector3) SetElem(index int, value int32) {
+	switch index {
+	case 0:
+		v.X = value
+	case 1:
+		v.Y = value
+	case 2:
+		v.Z = value
+	}
+}
+
+func (v *IVector3) GetElem(index int) int32 {
+	switch index {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	case 2:
+		return v.Z
+	}
+	return 0
+}
+
+func IV3Add(result, vec0, vec1 *IVector3) {
+	result.X = vec0.X + vec1.X
+	result.Y = vec0.Y + vec1.Y
+	result.Z = vec0.Z + vec1.Z
+}
+
+func IV3Sub(result, vec0, vec1 *IVector3) {
+	result.X = vec0.X - vec1.X
+	result.Y = vec0.Y - vec1.Y
+	result.Z = vec0.Z - vec1.Z
+}
+
+func IV3MulPerElem(result, vec0, vec1 *IVector3) {
+	result.X = vec0.X * vec1.X
+	result.Y = vec0.Y * vec1.Y
+	result.Z = vec0.Z * vec1.Z
+}
+
+func IV3ScalarMul(result, vec *IVector3, scalar int32) {
+	result.X = vec.X * scalar
+	result.Y = vec.Y * scalar
+	result.Z = vec.Z * scalar
+}
+
+func IV3Neg(result, vec *IVector3) {
+	result.X = -vec.X
+	result.Y = -vec.Y
+	result.Z = -vec.Z
+}
+
+// IV3AbsPerElem leaves any element equal to math.MinInt32 unchanged.
+func IV3AbsPerElem(result, vec *IVector3) {
+	result.X = iabs(vec.X)
+	result.Y = iabs(vec.Y)
+	result.Z = iabs(vec.Z)
+}
+
+func IV3MaxPerElem(result, vec0, vec1 *IVector3) {
+	result.X = imax(vec0.X, vec1.X)
+	result.Y = imax(vec0.Y, vec1.Y)
+	result.Z = imax(vec0.Z, vec1.Z)
+}
+
+func IV3MinPerElem(result, vec0, vec1 *IVector3) {
+	result.X = imin(vec0.X, vec1.X)
+	result.Y = imin(vec0.Y, vec1.Y)
+	result.Z = imin(vec0.Z, vec1.Z)
+}
+
+func (v IVector3) MaxElem() int32 {
+	return imax(imax(v.X, v.Y), v.Z)
+}
+
+func (v IVector3) MinElem() int32 {
+	return imin(imin(v.X, v.Y), v.Z)
+}
+
+func (v IVector3) Sum() int32 {
+	return v.X + v.Y + v.Z
+}
+
+func IV3Dot(vec0, vec1 *IVector3) int32 {
+	return vec0.X*vec1.X + vec0.Y*vec1.Y + vec0.Z*vec1.Z
+}
+
+// IV3CmpEq and the other comparisons return a mask with bit i set where
+// element i of vec0 and vec1 satisfies the relation: bit 0 for X, bit 1 for
+// Y and bit 2 for Z.
+func IV3CmpEq(vec0, vec1 *IVector3) int {
+	mask := 0
+	if vec0.X == vec1.X {
+		mask |= 1
+	}
+	if vec0.Y == vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z == vec1.Z {
+		mask |= 4
+	}
+	return mask
+}
+
+func IV3CmpLt(vec0, vec1 *IVector3) int {
+	mask := 0
+	if vec0.X < vec1.X {
+		mask |= 1
+	}
+	if vec0.Y < vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z < vec1.Z {
+		mask |= 4
+	}
+	return mask
+}
+
+func IV3CmpLe(vec0, vec1 *IVector3) int {
+	mask := 0
+	if vec0.X <= vec1.X {
+		mask |= 1
+	}
+	if vec0.Y <= vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z <= vec1.Z {
+		mask |= 4
+	}
+	return mask
+}
+
+func IV3CmpGt(vec0, vec1 *IVector3) int {
+	mask := 0
+	if vec0.X > vec1.X {
+		mask |= 1
+	}
+	if vec0.Y > vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z > vec1.Z {
+		mask |= 4
+	}
+	return mask
+}
+
+func IV3CmpGe(vec0, vec1 *IVector3) int {
+	mask := 0
+	if vec0.X >= vec1.X {
+		mask |= 1
+	}
+	if vec0.Y >= vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z >= vec1.Z {
+		mask |= 4
+	}
+	return mask
+}
+
+// IV3SelectPerElem takes element i from vec1 where bit i of mask is set,
+// and from vec0 otherwise. mask is as returned by IV3CmpEq and friends.
+func IV3SelectPerElem(result, vec0, vec1 *IVector3, mask int) {
+	if mask&1 != 0 {
+		result.X = vec1.X
+	} else {
+		result.X = vec0.X
+	}
+	if mask&2 != 0 {
+		result.Y = vec1.Y
+	} else {
+		result.Y = vec0.Y
+	}
+	if mask&4 != 0 {
+		result.Z = vec1.Z
+	} else {
+		result.Z = vec0.Z
+	}
+}
+
+func IV3Select(result, vec0, vec1 *IVector3, select1 int) {
+	if select1 != 0 {
+		*result = *vec1
+	} else {
+		*result = *vec0
+	}
+}
+
+func (v *IVector3) String() string {
+	return fmt.Sprintf("( %d %d %d )\n", v.X, v.Y, v.Z)
+}
+
+/*******/
+
+func IV4Copy(result, vec *IVector4) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+	result.W = vec.W
+}
+
+func IV4MakeFromElems(result *IVector4, x, y, z, w int32) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+	result.W = w
+}
+
+func IV4MakeFromScalar(result *IVector4, scalar int32) {
+	result.X = scalar
+	result.Y = scalar
+	result.Z = scalar
+	result.W = scalar
+}
+
+func (v *IVector4) SetElem(index int, value int32) {
+	switch index {
+	case 0:
+		v.X = value
+	case 1:
+		v.Y = value
+	case 2:
+		v.Z = value
+	case 3:
+		v.W = value
+	}
+}
+
+func (v *IVector4) GetElem(index int) int32 {
+	switch index {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	case 2:
+		return v.Z
+	case 3:
+		return v.W
+	}
+	return 0
+}
+
+func IV4Add(result, vec0, vec1 *IVector4) {
+	result.X = vec0.X + vec1.X
+	result.Y = vec0.Y + vec1.Y
+	result.Z = vec0.Z + vec1.Z
+	result.W = vec0.W + vec1.W
+}
+
+func IV4Sub(result, vec0, vec1 *IVector4) {
+	result.X = vec0.X - vec1.X
+	result.Y = vec0.Y - vec1.Y
+	result.Z = vec0.Z - vec1.Z
+	result.W = vec0.W - vec1.W
+}
+
+func IV4MulPerElem(result, vec0, vec1 *IVector4) {
+	result.X = vec0.X * vec1.X
+	result.Y = vec0.Y * vec1.Y
+	result.Z = vec0.Z * vec1.Z
+	result.W = vec0.W * vec1.W
+}
+
+func IV4ScalarMul(result, vec *IVector4, scalar int32) {
+	result.X = vec.X * scalar
+	result.Y = vec.Y * scalar
+	result.Z = vec.Z * scalar
+	result.W = vec.W * scalar
+}
+
+func IV4Neg(result, vec *IVector4) {
+	result.X = -vec.X
+	result.Y = -vec.Y
+	result.Z = -vec.Z
+	result.W = -vec.W
+}
+
+// IV4AbsPerElem leaves any element equal to math.MinInt32 unchanged.
+func IV4AbsPerElem(result, vec *IVector4) {
+	result.X = iabs(vec.X)
+	result.Y = iabs(vec.Y)
+	result.Z = iabs(vec.Z)
+	result.W = iabs(vec.W)
+}
+
+func IV4MaxPerElem(result, vec0, vec1 *IVector4) {
+	result.X = imax(vec0.X, vec1.X)
+	result.Y = imax(vec0.Y, vec1.Y)
+	result.Z = imax(vec0.Z, vec1.Z)
+	result.W = imax(vec0.W, vec1.W)
+}
+
+func IV4MinPerElem(result, vec0, vec1 *IVector4) {
+	result.X = imin(vec0.X, vec1.X)
+	result.Y = imin(vec0.Y, vec1.Y)
+	result.Z = imin(vec0.Z, vec1.Z)
+	result.W = imin(vec0.W, vec1.W)
+}
+
+func (v IVector4) MaxElem() int32 {
+	return imax(imax(imax(v.X, v.Y), v.Z), v.W)
+}
+
+func (v IVector4) MinElem() int32 {
+	return imin(imin(imin(v.X, v.Y), v.Z), v.W)
+}
+
+func (v IVector4) Sum() int32 {
+	return v.X + v.Y + v.Z + v.W
+}
+
+func IV4Dot(vec0, vec1 *IVector4) int32 {
+	return vec0.X*vec1.X + vec0.Y*vec1.Y + vec0.Z*vec1.Z + vec0.W*vec1.W
+}
+
+// IV4CmpEq and the other comparisons return a mask with bit i set where
+// element i of vec0 and vec1 satisfies the relation: bit 0 for X, bit 1 for
+// Y, bit 2 for Z and bit 3 for W.
+func IV4CmpEq(vec0, vec1 *IVector4) int {
+	mask := 0
+	if vec0.X == vec1.X {
+		mask |= 1
+	}
+	if vec0.Y == vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z == vec1.Z {
+		mask |= 4
+	}
+	if vec0.W == vec1.W {
+		mask |= 8
+	}
+	return mask
+}
+
+func IV4CmpLt(vec0, vec1 *IVector4) int {
+	mask := 0
+	if vec0.X < vec1.X {
+		mask |= 1
+	}
+	if vec0.Y < vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z < vec1.Z {
+		mask |= 4
+	}
+	if vec0.W < vec1.W {
+		mask |= 8
+	}
+	return mask
+}
+
+func IV4CmpLe(vec0, vec1 *IVector4) int {
+	mask := 0
+	if vec0.X <= vec1.X {
+		mask |= 1
+	}
+	if vec0.Y <= vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z <= vec1.Z {
+		mask |= 4
+	}
+	if vec0.W <= vec1.W {
+		mask |= 8
+	}
+	return mask
+}
+
+func IV4CmpGt(vec0, vec1 *IVector4) int {
+	mask := 0
+	if vec0.X > vec1.X {
+		mask |= 1
+	}
+	if vec0.Y > vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z > vec1.Z {
+		mask |= 4
+	}
+	if vec0.W > vec1.W {
+		mask |= 8
+	}
+	return mask
+}
+
+func IV4CmpGe(vec0, vec1 *IVector4) int {
+	mask := 0
+	if vec0.X >= vec1.X {
+		mask |= 1
+	}
+	if vec0.Y >= vec1.Y {
+		mask |= 2
+	}
+	if vec0.Z >= vec1.Z {
+		mask |= 4
+	}
+	if vec0.W >= vec1.W {
+		mask |= 8
+	}
+	return mask
+}
+
+// IV4SelectPerElem takes element i from vec1 where bit i of mask is set,
+// and from vec0 otherwise. mask is as returned by IV4CmpEq and friends.
+func IV4SelectPerElem(result, vec0, vec1 *IVector4, mask int) {
+	if mask&1 != 0 {
+		result.X = vec1.X
+	} else {
+		result.X = vec0.X
+	}
+	if mask&2 != 0 {
+		result.Y = vec1.Y
+	} else {
+		result.Y = vec0.Y
+	}
+	if mask&4 != 0 {
+		result.Z = vec1.Z
+	} else {
+		result.Z = vec0.Z
+	}
+	if mask&8 != 0 {
+		result.W = vec1.W
+	} else {
+		result.W = vec0.W
+	}
+}
+
+func IV4Select(result, vec0, vec1 *IVector4, select1 int) {
+	if select1 != 0 {
+		*result = *vec1
+	} else {
+		*result = *vec0
+	}
+}
+
+func (v *IVector4) String() string {
+	return fmt.Sprintf("( %d %d %d %d )\n", v.X, v.Y, v.Z, v.W)
+}
+
+/*******/
+
+func IV2MakeFromV2Floor(result *IVector2, vec *Vector2) {
+	iv2MakeFromV2Floor(result, vec)
+}
+
+func IV2MakeFromV2Round(result *IVector2, vec *Vector2) {
+	iv2MakeFromV2Round(result, vec)
+}
+
+func IV2MakeFromV2Trunc(result *IVector2, vec *Vector2) {
+	iv2MakeFromV2Trunc(result, vec)
+}
+
+func IV2MakeFromP2Floor(result *IVector2, pnt *Point2) {
+	iv2MakeFromP2Floor(result, pnt)
+}
+
+func IV2MakeFromP2Round(result *IVector2, pnt *Point2) {
+	iv2MakeFromP2Round(result, pnt)
+}
+
+func IV2MakeFromP2Trunc(result *IVector2, pnt *Point2) {
+	iv2MakeFromP2Trunc(result, pnt)
+}
+
+func V2MakeFromIV2(result *Vector2, vec *IVector2) {
+	v2MakeFromIV2(result, vec)
+}
+
+func P2MakeFromIV2(result *Point2, vec *IVector2) {
+	p2MakeFromIV2(result, vec)
+}
+
+func IV3MakeFromV3Floor(result *IVector3, vec *Vector3) {
+	iv3MakeFromV3Floor(result, vec)
+}
+
+func IV3MakeFromV3Round(result *IVector3, vec *Vector3) {
+	iv3MakeFromV3Round(result, vec)
+}
+
+func IV3MakeFromV3Trunc(result *IVector3, vec *Vector3) {
+	iv3MakeFromV3Trunc(result, vec)
+}
+
+// IV3MakeFromP3Floor gives the integer coordinates of the unit cell containing
+// pnt, rounding towards negative infinity so that, e.g., -0.5 maps to -1.
+// Scale pnt by the reciprocal of the cell size first for larger cells.
+func IV3MakeFromP3Floor(result *IVector3, pnt *Point3) {
+	iv3MakeFromP3Floor(result, pnt)
+}
+
+func IV3MakeFromP3Round(result *IVector3, pnt *Point3) {
+	iv3MakeFromP3Round(result, pnt)
+}
+
+func IV3MakeFromP3Trunc(result *IVector3, pnt *Point3) {
+	iv3MakeFromP3Trunc(result, pnt)
+}
+
+func V3MakeFromIV3(result *Vector3, vec *IVector3) {
+	v3MakeFromIV3(result, vec)
+}
+
+func P3MakeFromIV3(result *Point3, vec *IVector3) {
+	p3MakeFromIV3(result, vec)
+}
+
+func IV4MakeFromV4Floor(result *IVector4, vec *Vector4) {
+	iv4MakeFromV4Floor(result, vec)
+}
+
+func IV4MakeFromV4Round(result *IVector4, vec *Vector4) {
+	iv4MakeFromV4Round(result, vec)
+}
+
+func IV4MakeFromV4Trunc(result *IVector4, vec *Vector4) {
+	iv4MakeFromV4Trunc(result, vec)
+}
+
+func V4MakeFromIV4(result *Vector4, vec *IVector4) {
+	v4MakeFromIV4(result, vec)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func iv2MakeFromV2Floor[T Float](result *IVector2, vec *Vector2Of[T]) {
+	result.X = int32(floor(vec.X))
+	result.Y = int32(floor(vec.Y))
+}
+
+func iv2MakeFromV2Round[T Float](result *IVector2, vec *Vector2Of[T]) {
+	result.X = int32(round(vec.X))
+	result.Y = int32(round(vec.Y))
+}
+
+func iv2MakeFromV2Trunc[T Float](result *IVector2, vec *Vector2Of[T]) {
+	result.X = int32(trunc(vec.X))
+	result.Y = int32(trunc(vec.Y))
+}
+
+func iv2MakeFromP2Floor[T Float](result *IVector2, pnt *Point2Of[T]) {
+	result.X = int32(floor(pnt.X))
+	result.Y = int32(floor(pnt.Y))
+}
+
+func iv2MakeFromP2Round[T Float](result *IVector2, pnt *Point2Of[T]) {
+	result.X = int32(round(pnt.X))
+	result.Y = int32(round(pnt.Y))
+}
+
+func iv2MakeFromP2Trunc[T Float](result *IVector2, pnt *Point2Of[T]) {
+	result.X = int32(trunc(pnt.X))
+	result.Y = int32(trunc(pnt.Y))
+}
+
+func v2MakeFromIV2[T Float](result *Vector2Of[T], vec *IVector2) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+}
+
+func p2MakeFromIV2[T Float](result *Point2Of[T], vec *IVector2) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+}
+
+/*******/
+
+func iv3MakeFromV3Floor[T Float](result *IVector3, vec *Vector3Of[T]) {
+	result.X = int32(floor(vec.X))
+	result.Y = int32(floor(vec.Y))
+	result.Z = int32(floor(vec.Z))
+}
+
+func iv3MakeFromV3Round[T Float](result *IVector3, vec *Vector3Of[T]) {
+	result.X = int32(round(vec.X))
+	result.Y = int32(round(vec.Y))
+	result.Z = int32(round(vec.Z))
+}
+
+func iv3MakeFromV3Trunc[T Float](result *IVector3, vec *Vector3Of[T]) {
+	result.X = int32(trunc(vec.X))
+	result.Y = int32(trunc(vec.Y))
+	result.Z = int32(trunc(vec.Z))
+}
+
+func iv3MakeFromP3Floor[T Float](result *IVector3, pnt *Point3Of[T]) {
+	result.X = int32(floor(pnt.X))
+	result.Y = int32(floor(pnt.Y))
+	result.Z = int32(floor(pnt.Z))
+}
+
+func iv3MakeFromP3Round[T Float](result *IVector3, pnt *Point3Of[T]) {
+	result.X = int32(round(pnt.X))
+	result.Y = int32(round(pnt.Y))
+	result.Z = int32(round(pnt.Z))
+}
+
+func iv3MakeFromP3Trunc[T Float](result *IVector3, pnt *Point3Of[T]) {
+	result.X = int32(trunc(pnt.X))
+	result.Y = int32(trunc(pnt.Y))
+	result.Z = int32(trunc(pnt.Z))
+}
+
+func v3MakeFromIV3[T Float](result *Vector3Of[T], vec *IVector3) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+	result.Z = T(vec.Z)
+}
+
+func p3MakeFromIV3[T Float](result *Point3Of[T], vec *IVector3) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+	result.Z = T(vec.Z)
+}
+
+/*******/
+
+func iv4MakeFromV4Floor[T Float](result *IVector4, vec *Vector4Of[T]) {
+	result.X = int32(floor(vec.X))
+	result.Y = int32(floor(vec.Y))
+	result.Z = int32(floor(vec.Z))
+	result.W = int32(floor(vec.W))
+}
+
+func iv4MakeFromV4Round[T Float](result *IVector4, vec *Vector4Of[T]) {
+	result.X = int32(round(vec.X))
+	result.Y = int32(round(vec.Y))
+	result.Z = int32(round(vec.Z))
+	result.W = int32(round(vec.W))
+}
+
+func iv4MakeFromV4Trunc[T Float](result *IVector4, vec *Vector4Of[T]) {
+	result.X = int32(trunc(vec.X))
+	result.Y = int32(trunc(vec.Y))
+	result.Z = int32(trunc(vec.Z))
+	result.W = int32(trunc(vec.W))
+}
+
+func v4MakeFromIV4[T Float](result *Vector4Of[T], vec *IVector4) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+	result.Z = T(vec.Z)
+	result.W = T(vec.W)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func IV2MakeFromV2dFloor(result *IVector2, vec *Vector2d) {
+	iv2MakeFromV2Floor(result, vec)
+}
+
+func IV2MakeFromV2dRound(result *IVector2, vec *Vector2d) {
+	iv2MakeFromV2Round(result, vec)
+}
+
+func IV2MakeFromV2dTrunc(result *IVector2, vec *Vector2d) {
+	iv2MakeFromV2Trunc(result, vec)
+}
+
+func IV2MakeFromP2dFloor(result *IVector2, pnt *Point2d) {
+	iv2MakeFromP2Floor(result, pnt)
+}
+
+func IV2MakeFromP2dRound(result *IVector2, pnt *Point2d) {
+	iv2MakeFromP2Round(result, pnt)
+}
+
+func IV2MakeFromP2dTrunc(result *IVector2, pnt *Point2d) {
+	iv2MakeFromP2Trunc(result, pnt)
+}
+
+func V2dMakeFromIV2(result *Vector2d, vec *IVector2) {
+	v2MakeFromIV2(result, vec)
+}
+
+func P2dMakeFromIV2(result *Point2d, vec *IVector2) {
+	p2MakeFromIV2(result, vec)
+}
+
+func IV3MakeFromV3dFloor(result *IVector3, vec *Vector3d) {
+	iv3MakeFromV3Floor(result, vec)
+}
+
+func IV3MakeFromV3dRound(result *IVector3, vec *Vector3d) {
+	iv3MakeFromV3Round(result, vec)
+}
+
+func IV3MakeFromV3dTrunc(result *IVector3, vec *Vector3d) {
+	iv3MakeFromV3Trunc(result, vec)
+}
+
+func IV3MakeFromP3dFloor(result *IVector3, pnt *Point3d) {
+	iv3MakeFromP3Floor(result, pnt)
+}
+
+func IV3MakeFromP3dRound(result *IVector3, pnt *Point3d) {
+	iv3MakeFromP3Round(result, pnt)
+}
+
+func IV3MakeFromP3dTrunc(result *IVector3, pnt *Point3d) {
+	iv3MakeFromP3Trunc(result, pnt)
+}
+
+func V3dMakeFromIV3(result *Vector3d, vec *IVector3) {
+	v3MakeFromIV3(result, vec)
+}
+
+func P3dMakeFromIV3(result *Point3d, vec *IVector3) {
+	p3MakeFromIV3(result, vec)
+}
+
+func IV4MakeFromV4dFloor(result *IVector4, vec *Vector4d) {
+	iv4MakeFromV4Floor(result, vec)
+}
+
+func IV4MakeFromV4dRound(result *IVector4, vec *Vector4d) {
+	iv4MakeFromV4Round(result, vec)
+}
+
+func IV4MakeFromV4dTrunc(result *IVector4, vec *Vector4d) {
+	iv4MakeFromV4Trunc(result, vec)
+}
+
+func V4dMakeFromIV4(result *Vector4d, vec *IVector4) {
+	v4MakeFromIV4(result, vec)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"testing"
+)
+
+var ivecRoundingCases = []struct {
+	in                  float32
+	floor, round, trunc int32
+}{
+	{0.0, 0, 0, 0},
+	{0.25, 0, 0, 0},
+	{0.5, 0, 1, 0},
+	{1.5, 1, 2, 1},
+	{2.5, 2, 3, 2},
+	{2.75, 2, 3, 2},
+	{-0.25, -1, 0, 0},
+	{-0.5, -1, -1, 0},
+	{-1.0, -1, -1, -1},
+	{-1.5, -2, -2, -1},
+	{-2.5, -3, -3, -2},
+	{-2.75, -3, -3, -2},
+	{-1e-30, -1, 0, 0},
+}
+
+func TestIV3MakeFromP3Rounding(t *testing.T) {
+	var pnt Point3
+	var got, floor, round, trunc IVector3
+	for _, c := range ivecRoundingCases {
+		// The case goes in each element in turn, next to fixed values in
+		// the others, so that a swapped or miswired element shows up.
+		for i := 0; i < 3; i++ {
+			P3MakeFromElems(&pnt, 7.5, -7.5, 7.25)
+			pnt.SetElem(i, c.in)
+			IV3MakeFromElems(&floor, 7, -8, 7)
+			IV3MakeFromElems(&round, 8, -8, 7)
+			IV3MakeFromElems(&trunc, 7, -7, 7)
+			floor.SetElem(i, c.floor)
+			round.SetElem(i, c.round)
+			trunc.SetElem(i, c.trunc)
+			if IV3MakeFromP3Floor(&got, &pnt); got != floor {
+				t.Errorf("IV3MakeFromP3Floor(%v) = %v, want %v", pnt, got, floor)
+			}
+			if IV3MakeFromP3Round(&got, &pnt); got != round {
+				t.Errorf("IV3MakeFromP3Round(%v) = %v, want %v", pnt, got, round)
+			}
+			if IV3MakeFromP3Trunc(&got, &pnt); got != trunc {
+				t.Errorf("IV3MakeFromP3Trunc(%v) = %v, want %v", pnt, got, trunc)
+			}
+		}
+	}
+}
+
+func TestIVecRoundingVariants(t *testing.T) {
+	// Every width and precision shares the rounding of the scalar helpers,
+	// so one case through each entry point is enough to catch a miswired
+	// wrapper.
+	for _, c := range ivecRoundingCases {
+		in := float64(c.in)
+		want2 := [3]IVector2{{c.floor, c.floor}, {c.round, c.round}, {c.trunc, c.trunc}}
+		want4 := [3]IVector4{
+			{c.floor, c.floor, c.floor, c.floor},
+			{c.round, c.round, c.round, c.round},
+			{c.trunc, c.trunc, c.trunc, c.trunc},
+		}
+		var got2 [3]IVector2
+		var got4 [3]IVector4
+
+		vec2 := Vector2{X: c.in, Y: c.in}
+		IV2MakeFromV2Floor(&got2[0], &vec2)
+		IV2MakeFromV2Round(&got2[1], &vec2)
+		IV2MakeFromV2Trunc(&got2[2], &vec2)
+		if got2 != want2 {
+			t.Errorf("IV2MakeFromV2 of %v = %v, want %v", c.in, got2, want2)
+		}
+		pnt2d := Point2d{X: in, Y: in}
+		IV2MakeFromP2dFloor(&got2[0], &pnt2d)
+		IV2MakeFromP2dRound(&got2[1], &pnt2d)
+		IV2MakeFromP2dTrunc(&got2[2], &pnt2d)
+		if got2 != want2 {
+			t.Errorf("IV2MakeFromP2d of %v = %v, want %v", in, got2, want2)
+		}
+
+		var vec4 Vector4
+		V4MakeFromScalar(&vec4, c.in)
+		IV4MakeFromV4Floor(&got4[0], &vec4)
+		IV4MakeFromV4Round(&got4[1], &vec4)
+		IV4MakeFromV4Trunc(&got4[2], &vec4)
+		if got4 != want4 {
+			t.Errorf("IV4MakeFromV4 of %v = %v, want %v", c.in, got4, want4)
+		}
+		var vec4d Vector4d
+		V4dMakeFromScalar(&vec4d, in)
+		IV4MakeFromV4dFloor(&got4[0], &vec4d)
+		IV4MakeFromV4dRound(&got4[1], &vec4d)
+		IV4MakeFromV4dTrunc(&got4[2], &vec4d)
+		if got4 != want4 {
+			t.Errorf("IV4MakeFromV4d of %v = %v, want %v", in, got4, want4)
+		}
+	}
+}
+
+func TestIVecFloorCell(t *testing.T) {
+	// Points just either side of a cell boundary land in adjacent cells,
+	// including across zero where truncation would merge two cells.
+	var pnt, back Point3
+	var cell0, cell1 IVector3
+	for _, x := range []float32{-3.0, -1.0, 0.0, 1.0, 4.0} {
+		P3MakeFromElems(&pnt, x, 0.5, -0.5)
+		IV3MakeFromP3Floor(&cell1, &pnt)
+		pnt.X = math.Nextafter32(x, float32(math.Inf(-1)))
+		IV3MakeFromP3Floor(&cell0, &pnt)
+		if cell1.X-cell0.X != 1 || cell0.Y != 0 || cell0.Z != -1 {
+			t.Errorf("cells either side of x = %v are %v and %v", x, cell0.String(), cell1.String())
+		}
+		P3MakeFromIV3(&back, &cell1)
+		if back.X != x {
+			t.Errorf("P3MakeFromIV3(%v).X = %v, want %v", cell1.String(), back.X, x)
+		}
+	}
+}
+
+func TestIVecArithmetic(t *testing.T) {
+	var vec0, vec1, result IVector3
+	IV3MakeFromElems(&vec0, 3, -4, 5)
+	IV3MakeFromElems(&vec1, -1, 2, 5)
+	IV3Add(&result, &vec0, &vec1)
+	if result != (IVector3{2, -2, 10}) {
+		t.Errorf("IV3Add = %v", result.String())
+	}
+	IV3Sub(&result, &vec0, &vec1)
+	if result != (IVector3{4, -6, 0}) {
+		t.Errorf("IV3Sub = %v", result.String())
+	}
+	IV3MulPerElem(&result, &vec0, &vec1)
+	if result != (IVector3{-3, -8, 25}) {
+		t.Errorf("IV3MulPerElem = %v", result.String())
+	}
+	if got := IV3Dot(&vec0, &vec1); got != 14 {
+		t.Errorf("IV3Dot = %v, want 14", got)
+	}
+	IV3MaxPerElem(&result, &vec0, &vec1)
+	if result != (IVector3{3, 2, 5}) || result.MaxElem() != 5 || vec0.MinElem() != -4 || vec0.Sum() != 4 {
+		t.Errorf("IV3MaxPerElem = %v", result.String())
+	}
+
+	IV3MakeFromElems(&vec0, math.MinInt32, -7, 7)
+	IV3AbsPerElem(&result, &vec0)
+	if result != (IVector3{math.MinInt32, 7, 7}) {
+		t.Errorf("IV3AbsPerElem(%v) = %v", vec0.String(), result.String())
+	}
+}
+
+func TestIVecCompareSelect(t *testing.T) {
+	var vec0, vec1, result IVector4
+	IV4MakeFromElems(&vec0, 1, 2, 3, 4)
+	IV4MakeFromElems(&vec1, 1, 5, -3, 4)
+	if got := IV4CmpEq(&vec0, &vec1); got != 0x9 {
+		t.Errorf("IV4CmpEq = %#x, want 0x9", got)
+	}
+	if got := IV4CmpLt(&vec0, &vec1); got != 0x2 {
+		t.Errorf("IV4CmpLt = %#x, want 0x2", got)
+	}
+	if got := IV4CmpLe(&vec0, &vec1); got != 0xb {
+		t.Errorf("IV4CmpLe = %#x, want 0xb", got)
+	}
+	if got := IV4CmpGt(&vec0, &vec1); got != 0x4 {
+		t.Errorf("IV4CmpGt = %#x, want 0x4", got)
+	}
+	if got := IV4CmpGe(&vec0, &vec1); got != 0xd {
+		t.Errorf("IV4CmpGe = %#x, want 0xd", got)
+	}
+	IV4SelectPerElem(&result, &vec0, &vec1, IV4CmpLt(&vec0, &vec1))
+	if result != (IVector4{1, 5, 3, 4}) {
+		t.Errorf("IV4SelectPerElem with the CmpLt mask = %v, want the elementwise max", result.String())
+	}
+
+	var v2a, v2b, r2 IVector2
+	IV2MakeFromElems(&v2a, -1, 6)
+	IV2MakeFromElems(&v2b, 2, 6)
+	IV2SelectPerElem(&r2, &v2a, &v2b, IV2CmpGe(&v2a, &v2b))
+	if r2 != (IVector2{-1, 6}) || IV2CmpGe(&v2a, &v2b) != 0x2 {
+		t.Errorf("IV2SelectPerElem = %v", r2.String())
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Matrix2 = Matrix2Of[float32]
+
+type Transform2 = Transform2Of[float32]
+
+func M2Copy(result, mat *Matrix2) {
+	m2Copy(result, mat)
+}
+
+func M2MakeFromScalar(result *Matrix2, scalar float32) {
+	m2MakeFromScalar(result, scalar)
+}
+
+func M2MakeFromCols(result *Matrix2, col0, col1 *Vector2) {
+	m2MakeFromCols(result, col0, col1)
+}
+
+// M2MakeFromM3 takes the upper-left 2x2 of mat.
+func M2MakeFromM3(result *Matrix2, mat *Matrix3) {
+	m2MakeFromM3(result, mat)
+}
+
+// M3MakeFromM2 embeds mat in the XY plane, leaving Z unchanged.
+func M3MakeFromM2(result *Matrix3, mat *Matrix2) {
+	m3MakeFromM2(result, mat)
+}
+
+func M2GetCol0(result *Vector2, mat *Matrix2) {
+	m2GetCol0(result, mat)
+}
+
+func M2GetCol1(result *Vector2, mat *Matrix2) {
+	m2GetCol1(result, mat)
+}
+
+func M2GetCol(result *Vector2, mat *Matrix2, col int) {
+	m2GetCol(result, mat, col)
+}
+
+func M2GetRow(result *Vector2, mat *Matrix2, row int) {
+	m2GetRow(result, mat, row)
+}
+
+func M2Transpose(result, mat *Matrix2) {
+	m2Transpose(result, mat)
+}
+
+func M2Inverse(result, mat *Matrix2) {
+	m2Inverse(result, mat)
+}
+
+// M2IsInvertible reports whether the determinant of mat is larger in
+// magnitude than epsilon times the square of its longest column, as with
+// M3IsInvertible.
+func M2IsInvertible(mat *Matrix2, epsilon float32) bool {
+	return m2IsInvertible(mat, epsilon)
+}
+
+func M2TryInverse(result, mat *Matrix2, epsilon float32) bool {
+	return m2TryInverse(result, mat, epsilon)
+}
+
+func M2Add(result, mat0, mat1 *Matrix2) {
+	m2Add(result, mat0, mat1)
+}
+
+func M2Sub(result, mat0, mat1 *Matrix2) {
+	m2Sub(result, mat0, mat1)
+}
+
+func M2Neg(result, mat *Matrix2) {
+	m2Neg(result, mat)
+}
+
+func M2AbsPerElem(result, mat *Matrix2) {
+	m2AbsPerElem(result, mat)
+}
+
+func M2ScalarMul(result, mat *Matrix2, scalar float32) {
+	m2ScalarMul(result, mat, scalar)
+}
+
+func M2MulV2(result *Vector2, mat *Matrix2, vec *Vector2) {
+	m2MulV2(result, mat, vec)
+}
+
+func M2Mul(result, mat0, mat1 *Matrix2) {
+	m2Mul(result, mat0, mat1)
+}
+
+func M2MulPerElem(result, mat0, mat1 *Matrix2) {
+	m2MulPerElem(result, mat0, mat1)
+}
+
+func M2MakeIdentity(result *Matrix2) {
+	m2MakeIdentity(result)
+}
+
+// M2MakeRotation rotates counterclockwise by radians, i.e. from X towards Y.
+func M2MakeRotation(result *Matrix2, radians float32) {
+	m2MakeRotation(result, radians)
+}
+
+func M2MakeScale(result *Matrix2, scaleVec *Vector2) {
+	m2MakeScale(result, scaleVec)
+}
+
+func M2AppendScale(result, mat *Matrix2, scaleVec *Vector2) {
+	m2AppendScale(result, mat, scaleVec)
+}
+
+func M2PrependScale(result *Matrix2, scaleVec *Vector2, mat *Matrix2) {
+	m2PrependScale(result, scaleVec, mat)
+}
+
+func M2Select(result, mat0, mat1 *Matrix2, select1 int) {
+	m2Select(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func T2Copy(result, tfrm *Transform2) {
+	t2Copy(result, tfrm)
+}
+
+func T2MakeFromScalar(result *Transform2, scalar float32) {
+	t2MakeFromScalar(result, scalar)
+}
+
+func T2MakeFromCols(result *Transform2, col0, col1, col2 *Vector2) {
+	t2MakeFromCols(result, col0, col1, col2)
+}
+
+func T2MakeFromM2V2(result *Transform2, tfrm *Matrix2, translateVec *Vector2) {
+	t2MakeFromM2V2(result, tfrm, translateVec)
+}
+
+// T2MakeFromM3 drops the bottom row of mat, a 2D transform in homogeneous form.
+func T2MakeFromM3(result *Transform2, mat *Matrix3) {
+	t2MakeFromM3(result, mat)
+}
+
+// M3MakeFromT2 makes the homogeneous form of tfrm, which transforms (x, y, 1)
+// as tfrm transforms the point (x, y).
+func M3MakeFromT2(result *Matrix3, tfrm *Transform2) {
+	m3MakeFromT2(result, tfrm)
+}
+
+// T2MakeFromT3 takes the part of tfrm that acts on the XY plane, ignoring
+// anything involving Z.
+func T2MakeFromT3(result *Transform2, tfrm *Transform3) {
+	t2MakeFromT3(result, tfrm)
+}
+
+// T3MakeFromT2 embeds tfrm in the XY plane, leaving Z unchanged.
+func T3MakeFromT2(result *Transform3, tfrm *Transform2) {
+	t3MakeFromT2(result, tfrm)
+}
+
+func T2GetCol0(result *Vector2, tfrm *Transform2) {
+	t2GetCol0(result, tfrm)
+}
+
+func T2GetCol1(result *Vector2, tfrm *Transform2) {
+	t2GetCol1(result, tfrm)
+}
+
+func T2GetCol2(result *Vector2, tfrm *Transform2) {
+	t2GetCol2(result, tfrm)
+}
+
+func T2GetCol(result *Vector2, tfrm *Transform2, col int) {
+	t2GetCol(result, tfrm, col)
+}
+
+func T2GetRow(result *Vector3, tfrm *Transform2, row int) {
+	t2GetRow(result, tfrm, row)
+}
+
+func T2Inverse(result, tfrm *Transform2) {
+	t2Inverse(result, tfrm)
+}
+
+func T2TryInverse(result, tfrm *Transform2, epsilon float32) bool {
+	return t2TryInverse(result, tfrm, epsilon)
+}
+
+func T2OrthoInverse(result, tfrm *Transform2) {
+	t2OrthoInverse(result, tfrm)
+}
+
+func T2AbsPerElem(result, tfrm *Transform2) {
+	t2AbsPerElem(result, tfrm)
+}
+
+func T2MulV2(result *Vector2, tfrm *Transform2, vec *Vector2) {
+	t2MulV2(result, tfrm, vec)
+}
+
+func T2MulP2(result *Point2, tfrm *Transform2, pnt *Point2) {
+	t2MulP2(result, tfrm, pnt)
+}
+
+func T2Mul(result, tfrm0, tfrm1 *Transform2) {
+	t2Mul(result, tfrm0, tfrm1)
+}
+
+func T2MulPerElem(result, tfrm0, tfrm1 *Transform2) {
+	t2MulPerElem(result, tfrm0, tfrm1)
+}
+
+func T2MakeIdentity(result *Transform2) {
+	t2MakeIdentity(result)
+}
+
+func T2GetUpper2x2(result *Matrix2, tfrm *Transform2) {
+	t2GetUpper2x2(result, tfrm)
+}
+
+func T2GetTranslation(result *Vector2, tfrm *Transform2) {
+	t2GetTranslation(result, tfrm)
+}
+
+func T2MakeRotation(result *Transform2, radians float32) {
+	t2MakeRotation(result, radians)
+}
+
+func T2MakeScale(result *Transform2, scaleVec *Vector2) {
+	t2MakeScale(result, scaleVec)
+}
+
+func T2AppendScale(result, tfrm *Transform2, scaleVec *Vector2) {
+	t2AppendScale(result, tfrm, scaleVec)
+}
+
+func T2PrependScale(result *Transform2, scaleVec *Vector2, tfrm *Transform2) {
+	t2PrependScale(result, scaleVec, tfrm)
+}
+
+func T2MakeTranslation(result *Transform2, translateVec *Vector2) {
+	t2MakeTranslation(result, translateVec)
+}
+
+func T2Select(result, tfrm0, tfrm1 *Transform2, select1 int) {
+	t2Select(result, tfrm0, tfrm1, select1)
+}
@@ -0,0 +1,667 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// Matrix2Of and Transform2Of are the 2D counterparts of Matrix3Of and
+// Transform3Of. Transform2Of is a 2x3 affine transform whose last column is
+// the translation.
+type Matrix2Of[T Float] struct {
+	col0, col1 Vector2Of[T]
+}
+
+type Transform2Of[T Float] struct {
+	col0, col1, col2 Vector2Of[T]
+}
+
+func m2Copy[T Float](result, mat *Matrix2Of[T]) {
+	v2Copy(&result.col0, &mat.col0)
+	v2Copy(&result.col1, &mat.col1)
+}
+
+func m2MakeFromScalar[T Float](result *Matrix2Of[T], scalar T) {
+	v2MakeFromScalar(&result.col0, scalar)
+	v2MakeFromScalar(&result.col1, scalar)
+}
+
+func m2MakeFromCols[T Float](result *Matrix2Of[T], col0, col1 *Vector2Of[T]) {
+	v2Copy(&result.col0, col0)
+	v2Copy(&result.col1, col1)
+}
+
+// m2MakeFromM3 takes the upper-left 2x2 of mat.
+func m2MakeFromM3[T Float](result *Matrix2Of[T], mat *Matrix3Of[T]) {
+	v2MakeFromV3(&result.col0, &mat.col0)
+	v2MakeFromV3(&result.col1, &mat.col1)
+}
+
+// m3MakeFromM2 embeds mat in the XY plane, leaving Z unchanged.
+func m3MakeFromM2[T Float](result *Matrix3Of[T], mat *Matrix2Of[T]) {
+	v3MakeFromV2Scalar(&result.col0, &mat.col0, 0.0)
+	v3MakeFromV2Scalar(&result.col1, &mat.col1, 0.0)
+	v3MakeZAxis(&result.col2)
+}
+
+func (m *Matrix2Of[T]) SetCol0(col0 *Vector2Of[T]) {
+	v2Copy(&m.col0, col0)
+}
+
+func (m *Matrix2Of[T]) SetCol1(col1 *Vector2Of[T]) {
+	v2Copy(&m.col1, col1)
+}
+
+func (m *Matrix2Of[T]) SetCol(col int, vec *Vector2Of[T]) {
+	switch col {
+	case 0:
+		v2Copy(&m.col0, vec)
+	case 1:
+		v2Copy(&m.col1, vec)
+	}
+}
+
+func (m *Matrix2Of[T]) SetRow(row int, vec *Vector2Of[T]) {
+	m.col0.SetElem(row, vec.GetElem(0))
+	m.col1.SetElem(row, vec.GetElem(1))
+}
+
+func (m *Matrix2Of[T]) SetElem(col, row int, val T) {
+	var tmpV2_0 Vector2Of[T]
+	m2GetCol(&tmpV2_0, m, col)
+	tmpV2_0.SetElem(row, val)
+	m.SetCol(col, &tmpV2_0)
+}
+
+func (m *Matrix2Of[T]) GetElem(col, row int) T {
+	var tmpV2_0 Vector2Of[T]
+	m2GetCol(&tmpV2_0, m, col)
+	return tmpV2_0.GetElem(row)
+}
+
+func m2GetCol0[T Float](result *Vector2Of[T], mat *Matrix2Of[T]) {
+	v2Copy(result, &mat.col0)
+}
+
+func m2GetCol1[T Float](result *Vector2Of[T], mat *Matrix2Of[T]) {
+	v2Copy(result, &mat.col1)
+}
+
+func m2GetCol[T Float](result *Vector2Of[T], mat *Matrix2Of[T], col int) {
+	switch col {
+	case 0:
+		v2Copy(result, &mat.col0)
+	case 1:
+		v2Copy(result, &mat.col1)
+	}
+}
+
+func m2GetRow[T Float](result *Vector2Of[T], mat *Matrix2Of[T], row int) {
+	v2MakeFromElems(result, mat.col0.GetElem(row), mat.col1.GetElem(row))
+}
+
+func m2Transpose[T Float](result, mat *Matrix2Of[T]) {
+	var tmpResult Matrix2Of[T]
+	v2MakeFromElems(&tmpResult.col0, mat.col0.X, mat.col1.X)
+	v2MakeFromElems(&tmpResult.col1, mat.col0.Y, mat.col1.Y)
+	m2Copy(result, &tmpResult)
+}
+
+func m2Inverse[T Float](result, mat *Matrix2Of[T]) {
+	detinv := 1.0 / mat.Determinant()
+	a, b, c, d := mat.col0.X, mat.col0.Y, mat.col1.X, mat.col1.Y
+	v2MakeFromElems(&result.col0, d*detinv, -b*detinv)
+	v2MakeFromElems(&result.col1, -c*detinv, a*detinv)
+}
+
+// m2IsInvertible is the 2x2 version of m3IsInvertible: the determinant is
+// compared against epsilon times the square of the longest column.
+func m2IsInvertible[T Float](mat *Matrix2Of[T], epsilon T) bool {
+	det := mat.Determinant()
+	scale := max(mat.col0.Length(), mat.col1.Length())
+	return abs(det) > epsilon*scale*scale
+}
+
+func m2TryInverse[T Float](result, mat *Matrix2Of[T], epsilon T) bool {
+	if !m2IsInvertible(mat, epsilon) {
+		return false
+	}
+	m2Inverse(result, mat)
+	return true
+}
+
+func (m Matrix2Of[T]) Determinant() T {
+	return v2PerpDot(&m.col0, &m.col1)
+}
+
+func m2Add[T Float](result, mat0, mat1 *Matrix2Of[T]) {
+	v2Add(&result.col0, &mat0.col0, &mat1.col0)
+	v2Add(&result.col1, &mat0.col1, &mat1.col1)
+}
+
+func m2Sub[T Float](result, mat0, mat1 *Matrix2Of[T]) {
+	v2Sub(&result.col0, &mat0.col0, &mat1.col0)
+	v2Sub(&result.col1, &mat0.col1, &mat1.col1)
+}
+
+func m2Neg[T Float](result, mat *Matrix2Of[T]) {
+	v2Neg(&result.col0, &mat.col0)
+	v2Neg(&result.col1, &mat.col1)
+}
+
+func m2AbsPerElem[T Float](result, mat *Matrix2Of[T]) {
+	v2AbsPerElem(&result.col0, &mat.col0)
+	v2AbsPerElem(&result.col1, &mat.col1)
+}
+
+func m2ScalarMul[T Float](result, mat *Matrix2Of[T], scalar T) {
+	v2ScalarMul(&result.col0, &mat.col0, scalar)
+	v2ScalarMul(&result.col1, &mat.col1, scalar)
+}
+
+func m2MulV2[T Float](result *Vector2Of[T], mat *Matrix2Of[T], vec *Vector2Of[T]) {
+	tmpX := (mat.col0.X * vec.X) + (mat.col1.X * vec.Y)
+	tmpY := (mat.col0.Y * vec.X) + (mat.col1.Y * vec.Y)
+	v2MakeFromElems(result, tmpX, tmpY)
+}
+
+func m2Mul[T Float](result, mat0, mat1 *Matrix2Of[T]) {
+	var tmpResult Matrix2Of[T]
+	m2MulV2(&tmpResult.col0, mat0, &mat1.col0)
+	m2MulV2(&tmpResult.col1, mat0, &mat1.col1)
+	m2Copy(result, &tmpResult)
+}
+
+func m2MulPerElem[T Float](result, mat0, mat1 *Matrix2Of[T]) {
+	v2MulPerElem(&result.col0, &mat0.col0, &mat1.col0)
+	v2MulPerElem(&result.col1, &mat0.col1, &mat1.col1)
+}
+
+func m2MakeIdentity[T Float](result *Matrix2Of[T]) {
+	v2MakeXAxis(&result.col0)
+	v2MakeYAxis(&result.col1)
+}
+
+// m2MakeRotation rotates counterclockwise, i.e. from X towards Y.
+func m2MakeRotation[T Float](result *Matrix2Of[T], radians T) {
+	s, c := sincos(radians)
+	v2MakeFromElems(&result.col0, c, s)
+	v2MakeFromElems(&result.col1, -s, c)
+}
+
+func m2MakeScale[T Float](result *Matrix2Of[T], scaleVec *Vector2Of[T]) {
+	v2MakeFromElems(&result.col0, scaleVec.X, 0.0)
+	v2MakeFromElems(&result.col1, 0.0, scaleVec.Y)
+}
+
+func m2AppendScale[T Float](result, mat *Matrix2Of[T], scaleVec *Vector2Of[T]) {
+	v2ScalarMul(&result.col0, &mat.col0, scaleVec.X)
+	v2ScalarMul(&result.col1, &mat.col1, scaleVec.Y)
+}
+
+func m2PrependScale[T Float](result *Matrix2Of[T], scaleVec *Vector2Of[T], mat *Matrix2Of[T]) {
+	v2MulPerElem(&result.col0, &mat.col0, scaleVec)
+	v2MulPerElem(&result.col1, &mat.col1, scaleVec)
+}
+
+func m2Select[T Float](result, mat0, mat1 *Matrix2Of[T], select1 int) {
+	v2Select(&result.col0, &mat0.col0, &mat1.col0, select1)
+	v2Select(&result.col1, &mat0.col1, &mat1.col1, select1)
+}
+
+func (m *Matrix2Of[T]) String() string {
+	var tmp Matrix2Of[T]
+	m2Transpose(&tmp, m)
+	return tmp.col0.String() + tmp.col1.String()
+}
+
+func (m Matrix2Of[T]) Add(mat1 Matrix2Of[T]) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2Add(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix2Of[T]) Sub(mat1 Matrix2Of[T]) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2Sub(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix2Of[T]) Neg() Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2Neg(&result, &m)
+	return result
+}
+
+func (m Matrix2Of[T]) ScalarMul(scalar T) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2ScalarMul(&result, &m, scalar)
+	return result
+}
+
+func (m Matrix2Of[T]) Mul(mat1 Matrix2Of[T]) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2Mul(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix2Of[T]) MulV2(vec Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	m2MulV2(&result, &m, &vec)
+	return result
+}
+
+func (m Matrix2Of[T]) MulPerElem(mat1 Matrix2Of[T]) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2MulPerElem(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix2Of[T]) AbsPerElem() Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2AbsPerElem(&result, &m)
+	return result
+}
+
+func (m Matrix2Of[T]) Transpose() Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2Transpose(&result, &m)
+	return result
+}
+
+func (m Matrix2Of[T]) Inverse() Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2Inverse(&result, &m)
+	return result
+}
+
+func (m Matrix2Of[T]) TryInverse(epsilon T) (Matrix2Of[T], bool) {
+	var result Matrix2Of[T]
+	ok := m2TryInverse(&result, &m, epsilon)
+	return result, ok
+}
+
+func (m Matrix2Of[T]) AppendScale(scaleVec Vector2Of[T]) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2AppendScale(&result, &m, &scaleVec)
+	return result
+}
+
+func (m Matrix2Of[T]) PrependScale(scaleVec Vector2Of[T]) Matrix2Of[T] {
+	var result Matrix2Of[T]
+	m2PrependScale(&result, &scaleVec, &m)
+	return result
+}
+
+func (m Matrix2Of[T]) Col(col int) Vector2Of[T] {
+	var result Vector2Of[T]
+	m2GetCol(&result, &m, col)
+	return result
+}
+
+func (m Matrix2Of[T]) Row(row int) Vector2Of[T] {
+	var result Vector2Of[T]
+	m2GetRow(&result, &m, row)
+	return result
+}
+
+/*******/
+
+func t2Copy[T Float](result, tfrm *Transform2Of[T]) {
+	v2Copy(&result.col0, &tfrm.col0)
+	v2Copy(&result.col1, &tfrm.col1)
+	v2Copy(&result.col2, &tfrm.col2)
+}
+
+func t2MakeFromScalar[T Float](result *Transform2Of[T], scalar T) {
+	v2MakeFromScalar(&result.col0, scalar)
+	v2MakeFromScalar(&result.col1, scalar)
+	v2MakeFromScalar(&result.col2, scalar)
+}
+
+func t2MakeFromCols[T Float](result *Transform2Of[T], col0, col1, col2 *Vector2Of[T]) {
+	v2Copy(&result.col0, col0)
+	v2Copy(&result.col1, col1)
+	v2Copy(&result.col2, col2)
+}
+
+func t2MakeFromM2V2[T Float](result *Transform2Of[T], tfrm *Matrix2Of[T], translateVec *Vector2Of[T]) {
+	result.SetUpper2x2(tfrm)
+	result.SetTranslation(translateVec)
+}
+
+// t2MakeFromM3 drops the bottom row of a homogeneous 2D transform.
+func t2MakeFromM3[T Float](result *Transform2Of[T], mat *Matrix3Of[T]) {
+	v2MakeFromV3(&result.col0, &mat.col0)
+	v2MakeFromV3(&result.col1, &mat.col1)
+	v2MakeFromV3(&result.col2, &mat.col2)
+}
+
+// m3MakeFromT2 makes the homogeneous form of tfrm, which transforms
+// (x, y, 1) as tfrm transforms the point (x, y).
+func m3MakeFromT2[T Float](result *Matrix3Of[T], tfrm *Transform2Of[T]) {
+	v3MakeFromV2Scalar(&result.col0, &tfrm.col0, 0.0)
+	v3MakeFromV2Scalar(&result.col1, &tfrm.col1, 0.0)
+	v3MakeFromV2Scalar(&result.col2, &tfrm.col2, 1.0)
+}
+
+// t2MakeFromT3 takes the part of tfrm acting on the XY plane, ignoring
+// anything involving Z.
+func t2MakeFromT3[T Float](result *Transform2Of[T], tfrm *Transform3Of[T]) {
+	v2MakeFromV3(&result.col0, &tfrm.col0)
+	v2MakeFromV3(&result.col1, &tfrm.col1)
+	v2MakeFromV3(&result.col2, &tfrm.col3)
+}
+
+// t3MakeFromT2 embeds tfrm in the XY plane, leaving Z unchanged.
+func t3MakeFromT2[T Float](result *Transform3Of[T], tfrm *Transform2Of[T]) {
+	v3MakeFromV2Scalar(&result.col0, &tfrm.col0, 0.0)
+	v3MakeFromV2Scalar(&result.col1, &tfrm.col1, 0.0)
+	v3MakeZAxis(&result.col2)
+	v3MakeFromV2Scalar(&result.col3, &tfrm.col2, 0.0)
+}
+
+func (t *Transform2Of[T]) SetCol0(col0 *Vector2Of[T]) {
+	v2Copy(&t.col0, col0)
+}
+
+func (t *Transform2Of[T]) SetCol1(col1 *Vector2Of[T]) {
+	v2Copy(&t.col1, col1)
+}
+
+func (t *Transform2Of[T]) SetCol2(col2 *Vector2Of[T]) {
+	v2Copy(&t.col2, col2)
+}
+
+func (t *Transform2Of[T]) SetCol(col int, vec *Vector2Of[T]) {
+	switch col {
+	case 0:
+		v2Copy(&t.col0, vec)
+	case 1:
+		v2Copy(&t.col1, vec)
+	case 2:
+		v2Copy(&t.col2, vec)
+	}
+}
+
+func (t *Transform2Of[T]) SetRow(row int, vec *Vector3Of[T]) {
+	t.col0.SetElem(row, vec.GetElem(0))
+	t.col1.SetElem(row, vec.GetElem(1))
+	t.col2.SetElem(row, vec.GetElem(2))
+}
+
+func (t *Transform2Of[T]) SetElem(col, row int, val T) {
+	var tmpV2_0 Vector2Of[T]
+	t2GetCol(&tmpV2_0, t, col)
+	tmpV2_0.SetElem(row, val)
+	t.SetCol(col, &tmpV2_0)
+}
+
+func (t *Transform2Of[T]) GetElem(col, row int) T {
+	var tmpV2_0 Vector2Of[T]
+	t2GetCol(&tmpV2_0, t, col)
+	return tmpV2_0.GetElem(row)
+}
+
+func t2GetCol0[T Float](result *Vector2Of[T], tfrm *Transform2Of[T]) {
+	v2Copy(result, &tfrm.col0)
+}
+
+func t2GetCol1[T Float](result *Vector2Of[T], tfrm *Transform2Of[T]) {
+	v2Copy(result, &tfrm.col1)
+}
+
+func t2GetCol2[T Float](result *Vector2Of[T], tfrm *Transform2Of[T]) {
+	v2Copy(result, &tfrm.col2)
+}
+
+func t2GetCol[T Float](result *Vector2Of[T], tfrm *Transform2Of[T], col int) {
+	switch col {
+	case 0:
+		v2Copy(result, &tfrm.col0)
+	case 1:
+		v2Copy(result, &tfrm.col1)
+	case 2:
+		v2Copy(result, &tfrm.col2)
+	}
+}
+
+func t2GetRow[T Float](result *Vector3Of[T], tfrm *Transform2Of[T], row int) {
+	v3MakeFromElems(result, tfrm.col0.GetElem(row), tfrm.col1.GetElem(row), tfrm.col2.GetElem(row))
+}
+
+func t2Inverse[T Float](result, tfrm *Transform2Of[T]) {
+	var inv Matrix2Of[T]
+	var tmpV2_0 Vector2Of[T]
+	m2MakeFromCols(&inv, &tfrm.col0, &tfrm.col1)
+	m2Inverse(&inv, &inv)
+	m2MulV2(&tmpV2_0, &inv, &tfrm.col2)
+	v2Neg(&result.col2, &tmpV2_0)
+	v2Copy(&result.col0, &inv.col0)
+	v2Copy(&result.col1, &inv.col1)
+}
+
+func t2TryInverse[T Float](result, tfrm *Transform2Of[T], epsilon T) bool {
+	var tmpM2_0 Matrix2Of[T]
+	t2GetUpper2x2(&tmpM2_0, tfrm)
+	if !m2IsInvertible(&tmpM2_0, epsilon) {
+		return false
+	}
+	t2Inverse(result, tfrm)
+	return true
+}
+
+func t2OrthoInverse[T Float](result, tfrm *Transform2Of[T]) {
+	var inv0, inv1, tmpV2_0 Vector2Of[T]
+	v2MakeFromElems(&inv0, tfrm.col0.X, tfrm.col1.X)
+	v2MakeFromElems(&inv1, tfrm.col0.Y, tfrm.col1.Y)
+	tmpX := (inv0.X * tfrm.col2.X) + (inv1.X * tfrm.col2.Y)
+	tmpY := (inv0.Y * tfrm.col2.X) + (inv1.Y * tfrm.col2.Y)
+	v2MakeFromElems(&tmpV2_0, -tmpX, -tmpY)
+	v2Copy(&result.col0, &inv0)
+	v2Copy(&result.col1, &inv1)
+	v2Copy(&result.col2, &tmpV2_0)
+}
+
+func t2AbsPerElem[T Float](result, tfrm *Transform2Of[T]) {
+	v2AbsPerElem(&result.col0, &tfrm.col0)
+	v2AbsPerElem(&result.col1, &tfrm.col1)
+	v2AbsPerElem(&result.col2, &tfrm.col2)
+}
+
+func t2MulV2[T Float](result *Vector2Of[T], tfrm *Transform2Of[T], vec *Vector2Of[T]) {
+	tmpX := (tfrm.col0.X * vec.X) + (tfrm.col1.X * vec.Y)
+	tmpY := (tfrm.col0.Y * vec.X) + (tfrm.col1.Y * vec.Y)
+	v2MakeFromElems(result, tmpX, tmpY)
+}
+
+func t2MulP2[T Float](result *Point2Of[T], tfrm *Transform2Of[T], pnt *Point2Of[T]) {
+	tmpX := ((tfrm.col0.X * pnt.X) + (tfrm.col1.X * pnt.Y)) + tfrm.col2.X
+	tmpY := ((tfrm.col0.Y * pnt.X) + (tfrm.col1.Y * pnt.Y)) + tfrm.col2.Y
+	p2MakeFromElems(result, tmpX, tmpY)
+}
+
+func t2Mul[T Float](result, tfrm0, tfrm1 *Transform2Of[T]) {
+	var tmpResult Transform2Of[T]
+	var tmpP2_0, tmpP2_1 Point2Of[T]
+	t2MulV2(&tmpResult.col0, tfrm0, &tfrm1.col0)
+	t2MulV2(&tmpResult.col1, tfrm0, &tfrm1.col1)
+	p2MakeFromV2(&tmpP2_0, &tfrm1.col2)
+	t2MulP2(&tmpP2_1, tfrm0, &tmpP2_0)
+	v2MakeFromP2(&tmpResult.col2, &tmpP2_1)
+	t2Copy(result, &tmpResult)
+}
+
+func t2MulPerElem[T Float](result, tfrm0, tfrm1 *Transform2Of[T]) {
+	v2MulPerElem(&result.col0, &tfrm0.col0, &tfrm1.col0)
+	v2MulPerElem(&result.col1, &tfrm0.col1, &tfrm1.col1)
+	v2MulPerElem(&result.col2, &tfrm0.col2, &tfrm1.col2)
+}
+
+func t2MakeIdentity[T Float](result *Transform2Of[T]) {
+	v2MakeXAxis(&result.col0)
+	v2MakeYAxis(&result.col1)
+	v2MakeFromScalar(&result.col2, 0.0)
+}
+
+func (t *Transform2Of[T]) SetUpper2x2(tfrm *Matrix2Of[T]) {
+	v2Copy(&t.col0, &tfrm.col0)
+	v2Copy(&t.col1, &tfrm.col1)
+}
+
+func t2GetUpper2x2[T Float](result *Matrix2Of[T], tfrm *Transform2Of[T]) {
+	m2MakeFromCols(result, &tfrm.col0, &tfrm.col1)
+}
+
+func (t *Transform2Of[T]) SetTranslation(translateVec *Vector2Of[T]) {
+	v2Copy(&t.col2, translateVec)
+}
+
+func t2GetTranslation[T Float](result *Vector2Of[T], tfrm *Transform2Of[T]) {
+	v2Copy(result, &tfrm.col2)
+}
+
+func t2MakeRotation[T Float](result *Transform2Of[T], radians T) {
+	s, c := sincos(radians)
+	v2MakeFromElems(&result.col0, c, s)
+	v2MakeFromElems(&result.col1, -s, c)
+	v2MakeFromScalar(&result.col2, 0.0)
+}
+
+func t2MakeScale[T Float](result *Transform2Of[T], scaleVec *Vector2Of[T]) {
+	v2MakeFromElems(&result.col0, scaleVec.X, 0.0)
+	v2MakeFromElems(&result.col1, 0.0, scaleVec.Y)
+	v2MakeFromScalar(&result.col2, 0.0)
+}
+
+func t2AppendScale[T Float](result, tfrm *Transform2Of[T], scaleVec *Vector2Of[T]) {
+	v2ScalarMul(&result.col0, &tfrm.col0, scaleVec.X)
+	v2ScalarMul(&result.col1, &tfrm.col1, scaleVec.Y)
+	v2Copy(&result.col2, &tfrm.col2)
+}
+
+func t2PrependScale[T Float](result *Transform2Of[T], scaleVec *Vector2Of[T], tfrm *Transform2Of[T]) {
+	v2MulPerElem(&result.col0, &tfrm.col0, scaleVec)
+	v2MulPerElem(&result.col1, &tfrm.col1, scaleVec)
+	v2MulPerElem(&result.col2, &tfrm.col2, scaleVec)
+}
+
+func t2MakeTranslation[T Float](result *Transform2Of[T], translateVec *Vector2Of[T]) {
+	v2MakeXAxis(&result.col0)
+	v2MakeYAxis(&result.col1)
+	v2Copy(&result.col2, translateVec)
+}
+
+func t2Select[T Float](result, tfrm0, tfrm1 *Transform2Of[T], select1 int) {
+	v2Select(&result.col0, &tfrm0.col0, &tfrm1.col0, select1)
+	v2Select(&result.col1, &tfrm0.col1, &tfrm1.col1, select1)
+	v2Select(&result.col2, &tfrm0.col2, &tfrm1.col2, select1)
+}
+
+func (t *Transform2Of[T]) String() string {
+	var tmpV3_0, tmpV3_1 Vector3Of[T]
+	t2GetRow(&tmpV3_0, t, 0)
+	t2GetRow(&tmpV3_1, t, 1)
+	return tmpV3_0.String() + tmpV3_1.String()
+}
+
+func (t Transform2Of[T]) Mul(tfrm1 Transform2Of[T]) Transform2Of[T] {
+	var result Transform2Of[T]
+	t2Mul(&result, &t, &tfrm1)
+	return result
+}
+
+func (t Transform2Of[T]) MulV2(vec Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	t2MulV2(&result, &t, &vec)
+	return result
+}
+
+func (t Transform2Of[T]) MulP2(pnt Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	t2MulP2(&result, &t, &pnt)
+	return result
+}
+
+func (t Transform2Of[T]) MulPerElem(tfrm1 Transform2Of[T]) Transform2Of[T] {
+	var result Transform2Of[T]
+	t2MulPerElem(&result, &t, &tfrm1)
+	return result
+}
+
+func (t Transform2Of[T]) AbsPerElem() Transform2Of[T] {
+	var result Transform2Of[T]
+	t2AbsPerElem(&result, &t)
+	return result
+}
+
+func (t Transform2Of[T]) Inverse() Transform2Of[T] {
+	var result Transform2Of[T]
+	t2Inverse(&result, &t)
+	return result
+}
+
+func (t Transform2Of[T]) TryInverse(epsilon T) (Transform2Of[T], bool) {
+	var result Transform2Of[T]
+	ok := t2TryInverse(&result, &t, epsilon)
+	return result, ok
+}
+
+func (t Transform2Of[T]) OrthoInverse() Transform2Of[T] {
+	var result Transform2Of[T]
+	t2OrthoInverse(&result, &t)
+	return result
+}
+
+func (t Transform2Of[T]) AppendScale(scaleVec Vector2Of[T]) Transform2Of[T] {
+	var result Transform2Of[T]
+	t2AppendScale(&result, &t, &scaleVec)
+	return result
+}
+
+func (t Transform2Of[T]) PrependScale(scaleVec Vector2Of[T]) Transform2Of[T] {
+	var result Transform2Of[T]
+	t2PrependScale(&result, &scaleVec, &t)
+	return result
+}
+
+func (t Transform2Of[T]) Col(col int) Vector2Of[T] {
+	var result Vector2Of[T]
+	t2GetCol(&result, &t, col)
+	return result
+}
+
+func (t Transform2Of[T]) Row(row int) Vector3Of[T] {
+	var result Vector3Of[T]
+	t2GetRow(&result, &t, row)
+	return result
+}
+
+func (t Transform2Of[T]) Upper2x2() Matrix2Of[T] {
+	var result Matrix2Of[T]
+	t2GetUpper2x2(&result, &t)
+	return result
+}
+
+func (t Transform2Of[T]) Translation() Vector2Of[T] {
+	var result Vector2Of[T]
+	t2GetTranslation(&result, &t)
+	return result
+}
+
+func (t Transform2Of[T]) Matrix3() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3MakeFromT2(&result, &t)
+	return result
+}
+
+/*******/
+
+func m2Convert[T, U Float](result *Matrix2Of[T], mat *Matrix2Of[U]) {
+	v2Convert(&result.col0, &mat.col0)
+	v2Convert(&result.col1, &mat.col1)
+}
+
+func t2Convert[T, U Float](result *Transform2Of[T], tfrm *Transform2Of[U]) {
+	v2Convert(&result.col0, &tfrm.col0)
+	v2Convert(&result.col1, &tfrm.col1)
+	v2Convert(&result.col2, &tfrm.col2)
+}
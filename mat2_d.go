@@ -0,0 +1,256 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Matrix2d = Matrix2Of[float64]
+
+type Transform2d = Transform2Of[float64]
+
+func M2dCopy(result, mat *Matrix2d) {
+	m2Copy(result, mat)
+}
+
+func M2dMakeFromScalar(result *Matrix2d, scalar float64) {
+	m2MakeFromScalar(result, scalar)
+}
+
+func M2dMakeFromCols(result *Matrix2d, col0, col1 *Vector2d) {
+	m2MakeFromCols(result, col0, col1)
+}
+
+func M2dMakeFromM3d(result *Matrix2d, mat *Matrix3d) {
+	m2MakeFromM3(result, mat)
+}
+
+func M3dMakeFromM2d(result *Matrix3d, mat *Matrix2d) {
+	m3MakeFromM2(result, mat)
+}
+
+func M2dGetCol0(result *Vector2d, mat *Matrix2d) {
+	m2GetCol0(result, mat)
+}
+
+func M2dGetCol1(result *Vector2d, mat *Matrix2d) {
+	m2GetCol1(result, mat)
+}
+
+func M2dGetCol(result *Vector2d, mat *Matrix2d, col int) {
+	m2GetCol(result, mat, col)
+}
+
+func M2dGetRow(result *Vector2d, mat *Matrix2d, row int) {
+	m2GetRow(result, mat, row)
+}
+
+func M2dTranspose(result, mat *Matrix2d) {
+	m2Transpose(result, mat)
+}
+
+func M2dInverse(result, mat *Matrix2d) {
+	m2Inverse(result, mat)
+}
+
+func M2dIsInvertible(mat *Matrix2d, epsilon float64) bool {
+	return m2IsInvertible(mat, epsilon)
+}
+
+func M2dTryInverse(result, mat *Matrix2d, epsilon float64) bool {
+	return m2TryInverse(result, mat, epsilon)
+}
+
+func M2dAdd(result, mat0, mat1 *Matrix2d) {
+	m2Add(result, mat0, mat1)
+}
+
+func M2dSub(result, mat0, mat1 *Matrix2d) {
+	m2Sub(result, mat0, mat1)
+}
+
+func M2dNeg(result, mat *Matrix2d) {
+	m2Neg(result, mat)
+}
+
+func M2dAbsPerElem(result, mat *Matrix2d) {
+	m2AbsPerElem(result, mat)
+}
+
+func M2dScalarMul(result, mat *Matrix2d, scalar float64) {
+	m2ScalarMul(result, mat, scalar)
+}
+
+func M2dMulV2d(result *Vector2d, mat *Matrix2d, vec *Vector2d) {
+	m2MulV2(result, mat, vec)
+}
+
+func M2dMul(result, mat0, mat1 *Matrix2d) {
+	m2Mul(result, mat0, mat1)
+}
+
+func M2dMulPerElem(result, mat0, mat1 *Matrix2d) {
+	m2MulPerElem(result, mat0, mat1)
+}
+
+func M2dMakeIdentity(result *Matrix2d) {
+	m2MakeIdentity(result)
+}
+
+func M2dMakeRotation(result *Matrix2d, radians float64) {
+	m2MakeRotation(result, radians)
+}
+
+func M2dMakeScale(result *Matrix2d, scaleVec *Vector2d) {
+	m2MakeScale(result, scaleVec)
+}
+
+func M2dAppendScale(result, mat *Matrix2d, scaleVec *Vector2d) {
+	m2AppendScale(result, mat, scaleVec)
+}
+
+func M2dPrependScale(result *Matrix2d, scaleVec *Vector2d, mat *Matrix2d) {
+	m2PrependScale(result, scaleVec, mat)
+}
+
+func M2dSelect(result, mat0, mat1 *Matrix2d, select1 int) {
+	m2Select(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func T2dCopy(result, tfrm *Transform2d) {
+	t2Copy(result, tfrm)
+}
+
+func T2dMakeFromScalar(result *Transform2d, scalar float64) {
+	t2MakeFromScalar(result, scalar)
+}
+
+func T2dMakeFromCols(result *Transform2d, col0, col1, col2 *Vector2d) {
+	t2MakeFromCols(result, col0, col1, col2)
+}
+
+func T2dMakeFromM2dV2d(result *Transform2d, tfrm *Matrix2d, translateVec *Vector2d) {
+	t2MakeFromM2V2(result, tfrm, translateVec)
+}
+
+func T2dMakeFromM3d(result *Transform2d, mat *Matrix3d) {
+	t2MakeFromM3(result, mat)
+}
+
+func M3dMakeFromT2d(result *Matrix3d, tfrm *Transform2d) {
+	m3MakeFromT2(result, tfrm)
+}
+
+func T2dMakeFromT3d(result *Transform2d, tfrm *Transform3d) {
+	t2MakeFromT3(result, tfrm)
+}
+
+func T3dMakeFromT2d(result *Transform3d, tfrm *Transform2d) {
+	t3MakeFromT2(result, tfrm)
+}
+
+func T2dGetCol0(result *Vector2d, tfrm *Transform2d) {
+	t2GetCol0(result, tfrm)
+}
+
+func T2dGetCol1(result *Vector2d, tfrm *Transform2d) {
+	t2GetCol1(result, tfrm)
+}
+
+func T2dGetCol2(result *Vector2d, tfrm *Transform2d) {
+	t2GetCol2(result, tfrm)
+}
+
+func T2dGetCol(result *Vector2d, tfrm *Transform2d, col int) {
+	t2GetCol(result, tfrm, col)
+}
+
+func T2dGetRow(result *Vector3d, tfrm *Transform2d, row int) {
+	t2GetRow(result, tfrm, row)
+}
+
+func T2dInverse(result, tfrm *Transform2d) {
+	t2Inverse(result, tfrm)
+}
+
+func T2dTryInverse(result, tfrm *Transform2d, epsilon float64) bool {
+	return t2TryInverse(result, tfrm, epsilon)
+}
+
+func T2dOrthoInverse(result, tfrm *Transform2d) {
+	t2OrthoInverse(result, tfrm)
+}
+
+func T2dAbsPerElem(result, tfrm *Transform2d) {
+	t2AbsPerElem(result, tfrm)
+}
+
+func T2dMulV2d(result *Vector2d, tfrm *Transform2d, vec *Vector2d) {
+	t2MulV2(result, tfrm, vec)
+}
+
+func T2dMulP2d(result *Point2d, tfrm *Transform2d, pnt *Point2d) {
+	t2MulP2(result, tfrm, pnt)
+}
+
+func T2dMul(result, tfrm0, tfrm1 *Transform2d) {
+	t2Mul(result, tfrm0, tfrm1)
+}
+
+func T2dMulPerElem(result, tfrm0, tfrm1 *Transform2d) {
+	t2MulPerElem(result, tfrm0, tfrm1)
+}
+
+func T2dMakeIdentity(result *Transform2d) {
+	t2MakeIdentity(result)
+}
+
+func T2dGetUpper2x2(result *Matrix2d, tfrm *Transform2d) {
+	t2GetUpper2x2(result, tfrm)
+}
+
+func T2dGetTranslation(result *Vector2d, tfrm *Transform2d) {
+	t2GetTranslation(result, tfrm)
+}
+
+func T2dMakeRotation(result *Transform2d, radians float64) {
+	t2MakeRotation(result, radians)
+}
+
+func T2dMakeScale(result *Transform2d, scaleVec *Vector2d) {
+	t2MakeScale(result, scaleVec)
+}
+
+func T2dAppendScale(result, tfrm *Transform2d, scaleVec *Vector2d) {
+	t2AppendScale(result, tfrm, scaleVec)
+}
+
+func T2dPrependScale(result *Transform2d, scaleVec *Vector2d, tfrm *Transform2d) {
+	t2PrependScale(result, scaleVec, tfrm)
+}
+
+func T2dMakeTranslation(result *Transform2d, translateVec *Vector2d) {
+	t2MakeTranslation(result, translateVec)
+}
+
+func T2dSelect(result, tfrm0, tfrm1 *Transform2d, select1 int) {
+	t2Select(result, tfrm0, tfrm1, select1)
+}
+
+/*******/
+
+func M2dMakeFromM2(result *Matrix2d, mat *Matrix2) {
+	m2Convert(result, mat)
+}
+
+func M2MakeFromM2d(result *Matrix2, mat *Matrix2d) {
+	m2Convert(result, mat)
+}
+
+func T2dMakeFromT2(result *Transform2d, tfrm *Transform2) {
+	t2Convert(result, tfrm)
+}
+
+func T2MakeFromT2d(result *Transform2, tfrm *Transform2d) {
+	t2Convert(result, tfrm)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestM2MakeRotation(t *testing.T) {
+	var xAxis, yAxis, got Vector2
+	var rot, inv, prod, ident Matrix2
+	var rot3 Matrix3
+	V2MakeXAxis(&xAxis)
+	V2MakeYAxis(&yAxis)
+	M2MakeIdentity(&ident)
+
+	M2MakeRotation(&rot, g_PI_OVER_2)
+	M2MulV2(&got, &rot, &xAxis)
+	if !v2Near(&got, &yAxis, 1e-7) {
+		t.Errorf("a quarter turn takes X to %v, want %v", got, yAxis)
+	}
+	for _, radians := range []float32{-2.5, 0.3, 1.2, 3.1} {
+		M2MakeRotation(&rot, radians)
+		M3MakeRotationZ(&rot3, radians)
+		M2MakeFromM3(&inv, &rot3)
+		if !m2Near(&rot, &inv, 1e-7) {
+			t.Errorf("M2MakeRotation(%v) = %v, M3MakeRotationZ gives %v", radians, rot.String(), inv.String())
+		}
+		if got := rot.Determinant(); !near(got, 1.0, 1e-6) {
+			t.Errorf("M2MakeRotation(%v).Determinant() = %v, want 1", radians, got)
+		}
+		M2Inverse(&inv, &rot)
+		M2Mul(&prod, &rot, &inv)
+		if !m2Near(&prod, &ident, 1e-6) {
+			t.Errorf("rot * M2Inverse(rot) = %v, want identity", prod.String())
+		}
+		M2Transpose(&prod, &rot)
+		if !m2Near(&prod, &inv, 1e-6) {
+			t.Errorf("M2Transpose(rot) = %v, want the inverse %v", prod.String(), inv.String())
+		}
+	}
+}
+
+func TestM2TryInverse(t *testing.T) {
+	var scale Vector2
+	var mat, inv, ident Matrix2
+	M2MakeIdentity(&ident)
+	V2MakeFromElems(&scale, 1e4, 1e-5)
+	M2MakeScale(&mat, &scale)
+	inv = ident
+	if M2TryInverse(&inv, &mat, 1e-6) {
+		t.Errorf("M2TryInverse accepted a scale by %v", scale)
+	}
+	if inv != ident {
+		t.Errorf("M2TryInverse changed result on failure: %v", inv.String())
+	}
+	V2MakeFromElems(&scale, 1e-4, 2e-4)
+	M2MakeScale(&mat, &scale)
+	if !M2TryInverse(&inv, &mat, 1e-6) {
+		t.Errorf("M2TryInverse rejected a small scale by %v", scale)
+	}
+}
+
+func TestT2Homogeneous(t *testing.T) {
+	var translation Vector2
+	var pnt, got Point2
+	var tfrm, back Transform2
+	var mat Matrix3
+	var homog, pnt3 Vector3
+	V2MakeFromElems(&translation, 3.0, -4.0)
+	P2MakeFromElems(&pnt, 0.5, 2.0)
+	T2MakeRotation(&tfrm, 0.7)
+	T2AppendScale(&tfrm, &tfrm, &Vector2{X: 2.0, Y: 0.5})
+	tfrm.SetTranslation(&translation)
+
+	M3MakeFromT2(&mat, &tfrm)
+	T2MulP2(&got, &tfrm, &pnt)
+	V3MakeFromElems(&homog, pnt.X, pnt.Y, 1.0)
+	M3MulV3(&pnt3, &mat, &homog)
+	want := Point2{X: pnt3.X, Y: pnt3.Y}
+	if !p2Near(&got, &want, 1e-6) || pnt3.Z != 1.0 {
+		t.Errorf("T2MulP2 = %v, the homogeneous matrix gives %v", got, pnt3)
+	}
+	T2MakeFromM3(&back, &mat)
+	if back != tfrm {
+		t.Errorf("T2MakeFromM3(M3MakeFromT2(tfrm)) = %v, want %v", back.String(), tfrm.String())
+	}
+}
+
+func TestT2MatchesT3(t *testing.T) {
+	var translation2 Vector2
+	var translation3 Vector3
+	var pnt2, got Point2
+	var pnt3, want3 Point3
+	var tfrm2, back Transform2
+	var tfrm3 Transform3
+	V2MakeFromElems(&translation2, 3.0, -4.0)
+	P2MakeFromElems(&pnt2, 0.5, 2.0)
+	T2MakeRotation(&tfrm2, -1.3)
+	tfrm2.SetTranslation(&translation2)
+
+	T3MakeRotationZ(&tfrm3, -1.3)
+	V3MakeFromV2Scalar(&translation3, &translation2, 0.0)
+	tfrm3.SetTranslation(&translation3)
+	T2MakeFromT3(&back, &tfrm3)
+	if !t2Near(&back, &tfrm2, 1e-7) {
+		t.Errorf("T2MakeFromT3 = %v, want %v", back.String(), tfrm2.String())
+	}
+
+	T3MakeFromT2(&tfrm3, &tfrm2)
+	P3MakeFromP2Scalar(&pnt3, &pnt2, 5.0)
+	T3MulP3(&want3, &tfrm3, &pnt3)
+	T2MulP2(&got, &tfrm2, &pnt2)
+	if !near(want3.X, got.X, 1e-6) || !near(want3.Y, got.Y, 1e-6) || want3.Z != 5.0 {
+		t.Errorf("T3MakeFromT2(tfrm) * %v = %v, T2MulP2 gives %v", pnt3, want3, got)
+	}
+}
+
+func TestT2Inverse(t *testing.T) {
+	var translation Vector2
+	var tfrm, inv, prod, ident Transform2
+	T2MakeIdentity(&ident)
+	V2MakeFromElems(&translation, 10.0, -20.0)
+	T2MakeRotation(&tfrm, 2.2)
+	tfrm.SetTranslation(&translation)
+
+	T2OrthoInverse(&inv, &tfrm)
+	T2Mul(&prod, &tfrm, &inv)
+	if !t2Near(&prod, &ident, 1e-5) {
+		t.Errorf("tfrm * T2OrthoInverse(tfrm) = %v, want identity", prod.String())
+	}
+	T2AppendScale(&tfrm, &tfrm, &Vector2{X: 3.0, Y: 0.25})
+	T2Inverse(&inv, &tfrm)
+	T2Mul(&prod, &inv, &tfrm)
+	if !t2Near(&prod, &ident, 1e-5) {
+		t.Errorf("T2Inverse(tfrm) * tfrm = %v, want identity", prod.String())
+	}
+
+	T2AppendScale(&tfrm, &tfrm, &Vector2{X: 1.0, Y: 0.0})
+	inv = ident
+	if T2TryInverse(&inv, &tfrm, 1e-6) {
+		t.Errorf("T2TryInverse accepted %v", tfrm.String())
+	}
+	if inv != ident {
+		t.Errorf("T2TryInverse changed result on failure: %v", inv.String())
+	}
+}
+
+func TestM2ValueMethods(t *testing.T) {
+	var scale Vector2
+	var mat0, mat1, want Matrix2
+	var vec, wantV Vector2
+	V2MakeFromElems(&scale, 2.0, -0.5)
+	V2MakeFromElems(&vec, 1.0, 3.0)
+	M2MakeRotation(&mat0, 0.4)
+	M2MakeScale(&mat1, &scale)
+
+	got := mat0.Mul(mat1).Inverse()
+	M2Mul(&want, &mat0, &mat1)
+	M2Inverse(&want, &want)
+	if !m2Near(&got, &want, 1e-6) {
+		t.Errorf("mat0.Mul(mat1).Inverse() = %v, want %v", got.String(), want.String())
+	}
+	gotV := mat0.AppendScale(scale).MulV2(vec)
+	M2AppendScale(&want, &mat0, &scale)
+	M2MulV2(&wantV, &want, &vec)
+	if gotV != wantV {
+		t.Errorf("mat0.AppendScale(scale).MulV2(vec) = %v, want %v", gotV, wantV)
+	}
+	if _, ok := mat1.PrependScale(Vector2{X: 0.0, Y: 1.0}).TryInverse(1e-6); ok {
+		t.Errorf("TryInverse succeeded on a singular matrix")
+	}
+}
+
+func TestT2ValueMethods(t *testing.T) {
+	var translation Vector2
+	var pnt Point2
+	var tfrm Transform2
+	V2MakeFromElems(&translation, 1.0, -2.0)
+	P2MakeFromElems(&pnt, 4.0, 5.0)
+	T2MakeRotation(&tfrm, 1.1)
+	tfrm.SetTranslation(&translation)
+
+	got := tfrm.Inverse().MulP2(tfrm.MulP2(pnt))
+	if !p2Near(&got, &pnt, 1e-5) {
+		t.Errorf("tfrm.Inverse().MulP2(tfrm.MulP2(pnt)) = %v, want %v", got, pnt)
+	}
+	if got := tfrm.Translation(); got != translation {
+		t.Errorf("tfrm.Translation() = %v, want %v", got, translation)
+	}
+	mat := tfrm.Matrix3()
+	var wantMat Matrix3
+	M3MakeFromT2(&wantMat, &tfrm)
+	if mat != wantMat {
+		t.Errorf("tfrm.Matrix3() = %v, want %v", mat.String(), wantMat.String())
+	}
+}
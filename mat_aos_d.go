@@ -0,0 +1,501 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func M3dCopy(result *Matrix3d, mat *Matrix3d) {
+	m3Copy(result, mat)
+}
+
+func M3dMakeFromScalar(result *Matrix3d, scalar float64) {
+	m3MakeFromScalar(result, scalar)
+}
+
+func M3dMakeFromQd(result *Matrix3d, unitQuat *Quatd) {
+	m3MakeFromQ(result, unitQuat)
+}
+
+func M3dMakeFromCols(result *Matrix3d, col0, col1, col2 *Vector3d) {
+	m3MakeFromCols(result, col0, col1, col2)
+}
+
+func M3dGetCol0(result *Vector3d, mat *Matrix3d) {
+	m3GetCol0(result, mat)
+}
+
+func M3dGetCol1(result *Vector3d, mat *Matrix3d) {
+	m3GetCol1(result, mat)
+}
+
+func M3dGetCol2(result *Vector3d, mat *Matrix3d) {
+	m3GetCol2(result, mat)
+}
+
+func M3dGetCol(result *Vector3d, mat *Matrix3d, col int) {
+	m3GetCol(result, mat, col)
+}
+
+func M3dGetRow(result *Vector3d, mat *Matrix3d, row int) {
+	m3GetRow(result, mat, row)
+}
+
+func M3dTranspose(result, mat *Matrix3d) {
+	m3Transpose(result, mat)
+}
+
+func M3dInverse(result, mat *Matrix3d) {
+	m3Inverse(result, mat)
+}
+
+func M3dTryInverse(result, mat *Matrix3d, epsilon float64) bool {
+	return m3TryInverse(result, mat, epsilon)
+}
+
+func M3dAdd(result, mat0, mat1 *Matrix3d) {
+	m3Add(result, mat0, mat1)
+}
+
+func M3dSub(result, mat0, mat1 *Matrix3d) {
+	m3Sub(result, mat0, mat1)
+}
+
+func M3dNeg(result, mat *Matrix3d) {
+	m3Neg(result, mat)
+}
+
+func M3dAbsPerElem(result, mat *Matrix3d) {
+	m3AbsPerElem(result, mat)
+}
+
+func M3dScalarMul(result, mat *Matrix3d, scalar float64) {
+	m3ScalarMul(result, mat, scalar)
+}
+
+func M3dMulV3d(result *Vector3d, mat *Matrix3d, vec *Vector3d) {
+	m3MulV3(result, mat, vec)
+}
+
+func M3dMul(result, mat0, mat1 *Matrix3d) {
+	m3Mul(result, mat0, mat1)
+}
+
+func M3dMulPerElem(result, mat0, mat1 *Matrix3d) {
+	m3MulPerElem(result, mat0, mat1)
+}
+
+func M3dMakeIdentity(result *Matrix3d) {
+	m3MakeIdentity(result)
+}
+
+func M3dMakeRotationX(result *Matrix3d, radians float64) {
+	m3MakeRotationX(result, radians)
+}
+
+func M3dMakeRotationY(result *Matrix3d, radians float64) {
+	m3MakeRotationY(result, radians)
+}
+
+func M3dMakeRotationZ(result *Matrix3d, radians float64) {
+	m3MakeRotationZ(result, radians)
+}
+
+func M3dMakeRotationZYX(result *Matrix3d, radiansXYZ *Vector3d) {
+	m3MakeRotationZYX(result, radiansXYZ)
+}
+
+func M3dMakeRotationAxis(result *Matrix3d, radians float64, unitVec *Vector3d) {
+	m3MakeRotationAxis(result, radians, unitVec)
+}
+
+func M3dMakeRotationQd(result *Matrix3d, unitQuat *Quatd) {
+	m3MakeRotationQ(result, unitQuat)
+}
+
+func M3dMakeScale(result *Matrix3d, scaleVec *Vector3d) {
+	m3MakeScale(result, scaleVec)
+}
+
+func M3dAppendScale(result, mat *Matrix3d, scaleVec *Vector3d) {
+	m3AppendScale(result, mat, scaleVec)
+}
+
+func M3dPrependScale(result *Matrix3d, scaleVec *Vector3d, mat *Matrix3d) {
+	m3PrependScale(result, scaleVec, mat)
+}
+
+func M3dSelect(result, mat0, mat1 *Matrix3d, select1 int) {
+	m3Select(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func M4dCopy(result, mat *Matrix4d) {
+	m4Copy(result, mat)
+}
+
+func M4dMakeFromScalar(result *Matrix4d, scalar float64) {
+	m4MakeFromScalar(result, scalar)
+}
+
+func M4dMakeFromT3d(result *Matrix4d, mat *Transform3d) {
+	m4MakeFromT3(result, mat)
+}
+
+func M4dMakeFromCols(result *Matrix4d, col0, col1, col2, col3 *Vector4d) {
+	m4MakeFromCols(result, col0, col1, col2, col3)
+}
+
+func M4dMakeFromM3dV3d(result *Matrix4d, mat *Matrix3d, translateVec *Vector3d) {
+	m4MakeFromM3V3(result, mat, translateVec)
+}
+
+func M4dMakeFromQdV3d(result *Matrix4d, unitQuat *Quatd, translateVec *Vector3d) {
+	m4MakeFromQV3(result, unitQuat, translateVec)
+}
+
+func M4dGetCol0(result *Vector4d, mat *Matrix4d) {
+	m4GetCol0(result, mat)
+}
+
+func M4dGetCol1(result *Vector4d, mat *Matrix4d) {
+	m4GetCol1(result, mat)
+}
+
+func M4dGetCol2(result *Vector4d, mat *Matrix4d) {
+	m4GetCol2(result, mat)
+}
+
+func M4dGetCol3(result *Vector4d, mat *Matrix4d) {
+	m4GetCol3(result, mat)
+}
+
+func M4dGetCol(result *Vector4d, mat *Matrix4d, col int) {
+	m4GetCol(result, mat, col)
+}
+
+func M4dGetRow(result *Vector4d, mat *Matrix4d, row int) {
+	m4GetRow(result, mat, row)
+}
+
+func M4dTranspose(result, mat *Matrix4d) {
+	m4Transpose(result, mat)
+}
+
+func M4dInverse(result, mat *Matrix4d) {
+	m4Inverse(result, mat)
+}
+
+func M4dTryInverse(result, mat *Matrix4d, epsilon float64) bool {
+	return m4TryInverse(result, mat, epsilon)
+}
+
+func M4dAffineInverse(result, mat *Matrix4d) {
+	m4AffineInverse(result, mat)
+}
+
+func M4dOrthoInverse(result, mat *Matrix4d) {
+	m4OrthoInverse(result, mat)
+}
+
+func M4dAdd(result, mat0, mat1 *Matrix4d) {
+	m4Add(result, mat0, mat1)
+}
+
+func M4dSub(result, mat0, mat1 *Matrix4d) {
+	m4Sub(result, mat0, mat1)
+}
+
+func M4dNeg(result, mat *Matrix4d) {
+	m4Neg(result, mat)
+}
+
+func M4dAbsPerElem(result, mat *Matrix4d) {
+	m4AbsPerElem(result, mat)
+}
+
+func M4dScalarMul(result, mat *Matrix4d, scalar float64) {
+	m4ScalarMul(result, mat, scalar)
+}
+
+func M4dMulV4d(result *Vector4d, mat *Matrix4d, vec *Vector4d) {
+	m4MulV4(result, mat, vec)
+}
+
+func M4dMulV3d(result *Vector4d, mat *Matrix4d, vec *Vector3d) {
+	m4MulV3(result, mat, vec)
+}
+
+func M4dMulP3d(result *Vector4d, mat *Matrix4d, pnt *Point3d) {
+	m4MulP3(result, mat, pnt)
+}
+
+func M4dMul(result, mat0, mat1 *Matrix4d) {
+	m4Mul(result, mat0, mat1)
+}
+
+func M4dMulT3d(result, mat *Matrix4d, tfrm1 *Transform3d) {
+	m4MulT3(result, mat, tfrm1)
+}
+
+func M4dMulPerElem(result, mat0, mat1 *Matrix4d) {
+	m4MulPerElem(result, mat0, mat1)
+}
+
+func M4dMakeIdentity(result *Matrix4d) {
+	m4MakeIdentity(result)
+}
+
+func M4dGetUpper3x3(result *Matrix3d, mat *Matrix4d) {
+	m4GetUpper3x3(result, mat)
+}
+
+func M4dGetTranslation(result *Vector3d, mat *Matrix4d) {
+	m4GetTranslation(result, mat)
+}
+
+func M4dMakeRotationX(result *Matrix4d, radians float64) {
+	m4MakeRotationX(result, radians)
+}
+
+func M4dMakeRotationY(result *Matrix4d, radians float64) {
+	m4MakeRotationY(result, radians)
+}
+
+func M4dMakeRotationZ(result *Matrix4d, radians float64) {
+	m4MakeRotationZ(result, radians)
+}
+
+func M4dMakeRotationZYX(result *Matrix4d, radiansXYZ *Vector3d) {
+	m4MakeRotationZYX(result, radiansXYZ)
+}
+
+func M4dMakeRotationAxis(result *Matrix4d, radians float64, unitVec *Vector3d) {
+	m4MakeRotationAxis(result, radians, unitVec)
+}
+
+func M4dMakeRotationQd(result *Matrix4d, unitQuat *Quatd) {
+	m4MakeRotationQ(result, unitQuat)
+}
+
+func M4dMakeScale(result *Matrix4d, scaleVec *Vector3d) {
+	m4MakeScale(result, scaleVec)
+}
+
+func M4dAppendScale(result, mat *Matrix4d, scaleVec *Vector3d) {
+	m4AppendScale(result, mat, scaleVec)
+}
+
+func M4dPrependScale(result *Matrix4d, scaleVec *Vector3d, mat *Matrix4d) {
+	m4PrependScale(result, scaleVec, mat)
+}
+
+func M4dMakeTranslation(result *Matrix4d, translateVec *Vector3d) {
+	m4MakeTranslation(result, translateVec)
+}
+
+func M4dMakeLookAt(result *Matrix4d, eyePos, lookAtPos *Point3d, upVec *Vector3d) {
+	m4MakeLookAt(result, eyePos, lookAtPos, upVec)
+}
+
+func M4dMakePerspective(result *Matrix4d, fovyRadians, aspect, zNear, zFar float64) {
+	m4MakePerspective(result, fovyRadians, aspect, zNear, zFar)
+}
+
+func M4dMakeFrustum(result *Matrix4d, left, right, bottom, top, zNear, zFar float64) {
+	m4MakeFrustum(result, left, right, bottom, top, zNear, zFar)
+}
+
+func M4dMakeOrthographic(result *Matrix4d, left, right, bottom, top, zNear, zFar float64) {
+	m4MakeOrthographic(result, left, right, bottom, top, zNear, zFar)
+}
+
+func M4dSelect(result, mat0, mat1 *Matrix4d, select1 int) {
+	m4Select(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func T3dCopy(result, tfrm *Transform3d) {
+	t3Copy(result, tfrm)
+}
+
+func T3dMakeFromScalar(result *Transform3d, scalar float64) {
+	t3MakeFromScalar(result, scalar)
+}
+
+func T3dMakeFromCols(result *Transform3d, col0, col1, col2, col3 *Vector3d) {
+	t3MakeFromCols(result, col0, col1, col2, col3)
+}
+
+func T3dMakeFromM3dV3d(result *Transform3d, tfrm *Matrix3d, translateVec *Vector3d) {
+	t3MakeFromM3V3(result, tfrm, translateVec)
+}
+
+func T3dMakeFromQdV3d(result *Transform3d, unitQuat *Quatd, translateVec *Vector3d) {
+	t3MakeFromQV3(result, unitQuat, translateVec)
+}
+
+func T3dGetCol0(result *Vector3d, tfrm *Transform3d) {
+	t3GetCol0(result, tfrm)
+}
+
+func T3dGetCol1(result *Vector3d, tfrm *Transform3d) {
+	t3GetCol1(result, tfrm)
+}
+
+func T3dGetCol2(result *Vector3d, tfrm *Transform3d) {
+	t3GetCol2(result, tfrm)
+}
+
+func T3dGetCol3(result *Vector3d, tfrm *Transform3d) {
+	t3GetCol3(result, tfrm)
+}
+
+func T3dGetCol(result *Vector3d, tfrm *Transform3d, col int) {
+	t3GetCol(result, tfrm, col)
+}
+
+func T3dGetRow(result *Vector4d, tfrm *Transform3d, row int) {
+	t3GetRow(result, tfrm, row)
+}
+
+func T3dInverse(result, tfrm *Transform3d) {
+	t3Inverse(result, tfrm)
+}
+
+func T3dTryInverse(result, tfrm *Transform3d, epsilon float64) bool {
+	return t3TryInverse(result, tfrm, epsilon)
+}
+
+func T3dOrthoInverse(result, tfrm *Transform3d) {
+	t3OrthoInverse(result, tfrm)
+}
+
+func T3dAbsPerElem(result, tfrm *Transform3d) {
+	t3AbsPerElem(result, tfrm)
+}
+
+func T3dMulV3d(result *Vector3d, tfrm *Transform3d, vec *Vector3d) {
+	t3MulV3(result, tfrm, vec)
+}
+
+func T3dMulP3d(result *Point3d, tfrm *Transform3d, pnt *Point3d) {
+	t3MulP3(result, tfrm, pnt)
+}
+
+func T3dMul(result, tfrm0, tfrm1 *Transform3d) {
+	t3Mul(result, tfrm0, tfrm1)
+}
+
+func T3dMulPerElem(result, tfrm0, tfrm1 *Transform3d) {
+	t3MulPerElem(result, tfrm0, tfrm1)
+}
+
+func T3dMakeIdentity(result *Transform3d) {
+	t3MakeIdentity(result)
+}
+
+func T3dGetUpper3x3(result *Matrix3d, tfrm *Transform3d) {
+	t3GetUpper3x3(result, tfrm)
+}
+
+func T3dGetTranslation(result *Vector3d, tfrm *Transform3d) {
+	t3GetTranslation(result, tfrm)
+}
+
+func T3dMakeRotationX(result *Transform3d, radians float64) {
+	t3MakeRotationX(result, radians)
+}
+
+func T3dMakeRotationY(result *Transform3d, radians float64) {
+	t3MakeRotationY(result, radians)
+}
+
+func T3dMakeRotationZ(result *Transform3d, radians float64) {
+	t3MakeRotationZ(result, radians)
+}
+
+func T3dMakeRotationZYX(result *Transform3d, radiansXYZ *Vector3d) {
+	t3MakeRotationZYX(result, radiansXYZ)
+}
+
+func T3dMakeRotationAxis(result *Transform3d, radians float64, unitVec *Vector3d) {
+	t3MakeRotationAxis(result, radians, unitVec)
+}
+
+func T3dMakeRotationQd(result *Transform3d, unitQuat *Quatd) {
+	t3MakeRotationQ(result, unitQuat)
+}
+
+func T3dMakeScale(result *Transform3d, scaleVec *Vector3d) {
+	t3MakeScale(result, scaleVec)
+}
+
+func T3dAppendScale(result, tfrm *Transform3d, scaleVec *Vector3d) {
+	t3AppendScale(result, tfrm, scaleVec)
+}
+
+func T3dPrependScale(result *Transform3d, scaleVec *Vector3d, tfrm *Transform3d) {
+	t3PrependScale(result, scaleVec, tfrm)
+}
+
+func T3dMakeTranslation(result *Transform3d, translateVec *Vector3d) {
+	t3MakeTranslation(result, translateVec)
+}
+
+func T3dSelect(result, tfrm0, tfrm1 *Transform3d, select1 int) {
+	t3Select(result, tfrm0, tfrm1, select1)
+}
+
+/*******/
+
+func QdMakeFromM3d(result *Quatd, tfrm *Matrix3d) {
+	qMakeFromM3(result, tfrm)
+}
+
+func V3dOuter(result *Matrix3d, tfrm0, tfrm1 *Vector3d) {
+	v3Outer(result, tfrm0, tfrm1)
+}
+
+func V4dOuter(result *Matrix4d, tfrm0, tfrm1 *Vector4d) {
+	v4Outer(result, tfrm0, tfrm1)
+}
+
+func V3dRowMul(result *Vector3d, vec *Vector3d, mat *Matrix3d) {
+	v3RowMul(result, vec, mat)
+}
+
+func V3dCrossMatrix(result *Matrix3d, vec *Vector3d) {
+	v3CrossMatrix(result, vec)
+}
+
+func V3dCrossMatrixMul(result *Matrix3d, vec *Vector3d, mat *Matrix3d) {
+	v3CrossMatrixMul(result, vec, mat)
+}
+
+/*******/
+
+func M3dMakeFromM3(result *Matrix3d, mat *Matrix3) {
+	m3Convert(result, mat)
+}
+
+func M3MakeFromM3d(result *Matrix3, mat *Matrix3d) {
+	m3Convert(result, mat)
+}
+
+func M4dMakeFromM4(result *Matrix4d, mat *Matrix4) {
+	m4Convert(result, mat)
+}
+
+func M4MakeFromM4d(result *Matrix4, mat *Matrix4d) {
+	m4Convert(result, mat)
+}
+
+func T3dMakeFromT3(result *Transform3d, tfrm *Transform3) {
+	t3Convert(result, tfrm)
+}
+
+func T3MakeFromT3d(result *Transform3, tfrm *Transform3d) {
+	t3Convert(result, tfrm)
+}
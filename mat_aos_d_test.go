@@ -0,0 +1,90 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestM3dMakeFromQd(t *testing.T) {
+	var axis Vector3d
+	var quat Quatd
+	var rot, mat Matrix3d
+	var mat4, want4 Matrix4d
+	var translation Vector3d
+	V3dMakeFromElems(&axis, 2.0, 3.0, -6.0)
+	V3dNormalize(&axis, &axis)
+	V3dMakeFromElems(&translation, -1.0, 0.5, 2.0)
+	for _, radians := range []float64{0.0, 0.7, -1.9, 3.0} {
+		QdMakeRotationAxis(&quat, radians, &axis)
+		M3dMakeFromQd(&mat, &quat)
+		M3dMakeRotationAxis(&rot, radians, &axis)
+		if !m3Near(&mat, &rot, 1e-15) {
+			t.Errorf("M3dMakeFromQd(%v rad) = %v, want %v", radians, mat.String(), rot.String())
+		}
+		M4dMakeFromQdV3d(&mat4, &quat, &translation)
+		M4dMakeFromM3dV3d(&want4, &rot, &translation)
+		if !m4Near(&mat4, &want4, 1e-15) {
+			t.Errorf("M4dMakeFromQdV3d(%v rad) = %v, want %v", radians, mat4.String(), want4.String())
+		}
+	}
+}
+
+func TestM4dInverseAccuracy(t *testing.T) {
+	// A badly scaled matrix whose product with its inverse is far from the
+	// identity in float32, but not in float64.
+	var mat, inv, prod, ident Matrix4d
+	var single, singleInv Matrix4
+	V4dMakeFromElems(&mat.col0, 1e4, 2.0, 3.0, 0.0)
+	V4dMakeFromElems(&mat.col1, 4.0, 1e-3, 6.0, 0.0)
+	V4dMakeFromElems(&mat.col2, 7.0, 8.0, 9.0, 0.0)
+	V4dMakeFromElems(&mat.col3, 1.0, 2.0, 3.0, 1.0)
+	M4dMakeIdentity(&ident)
+	M4dInverse(&inv, &mat)
+	M4dMul(&prod, &mat, &inv)
+	if !m4Near(&prod, &ident, 1e-11) {
+		t.Errorf("M4dMul(mat, M4dInverse(mat)) = %v, want identity", prod.String())
+	}
+
+	M4MakeFromM4d(&single, &mat)
+	M4Inverse(&singleInv, &single)
+	M4dMakeFromM4(&inv, &singleInv)
+	M4dMul(&prod, &mat, &inv)
+	if m4Near(&prod, &ident, 1e-9) {
+		t.Errorf("float32 inverse unexpectedly as accurate as float64: %v", prod.String())
+	}
+}
+
+func TestPrecisionConversion(t *testing.T) {
+	var vec Vector3
+	var vecd Vector3d
+	var quat, back Quat
+	var quatd Quatd
+	var mat, matBack Matrix4
+	var matd Matrix4d
+
+	// float32 to float64 and back is exact.
+	V3MakeFromElems(&vec, 0.1, -1e-30, 3e30)
+	V3dMakeFromV3(&vecd, &vec)
+	if vecd.X != float64(vec.X) || vecd.Y != float64(vec.Y) || vecd.Z != float64(vec.Z) {
+		t.Errorf("V3dMakeFromV3(%v) = %v", vec, vecd)
+	}
+	QMakeFromElems(&quat, 0.1, 0.2, 0.3, 0.9)
+	QdMakeFromQ(&quatd, &quat)
+	QMakeFromQd(&back, &quatd)
+	if back != quat {
+		t.Errorf("QMakeFromQd(QdMakeFromQ(%v)) = %v", quat, back)
+	}
+	M4MakeTranslation(&mat, &vec)
+	M4dMakeFromM4(&matd, &mat)
+	M4MakeFromM4d(&matBack, &matd)
+	if matBack != mat {
+		t.Errorf("M4MakeFromM4d(M4dMakeFromM4(%v)) = %v", mat.String(), matBack.String())
+	}
+
+	// float64 to float32 rounds to nearest.
+	V3dMakeFromElems(&vecd, 0.1, 1.0+1e-9, -2.0/3.0)
+	V3MakeFromV3d(&vec, &vecd)
+	if vec.X != 0.1 || vec.Y != 1.0 || vec.Z != float32(-2.0/3.0) {
+		t.Errorf("V3MakeFromV3d(%v) = %v", vecd, vec)
+	}
+}
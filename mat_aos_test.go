@@ -0,0 +1,120 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestM3MakeFromQ(t *testing.T) {
+	var axis, vec, want, got Vector3
+	var quat Quat
+	var rot, mat Matrix3
+	V3MakeFromElems(&axis, 1.0, -2.0, 3.0)
+	V3Normalize(&axis, &axis)
+	V3MakeFromElems(&vec, 0.5, 4.0, -1.5)
+	for _, radians := range []float32{0.0, 0.3, 1.2, -2.5, 3.1} {
+		QMakeRotationAxis(&quat, radians, &axis)
+		M3MakeFromQ(&mat, &quat)
+		M3MakeRotationAxis(&rot, radians, &axis)
+		if !m3Near(&mat, &rot, 1e-6) {
+			t.Errorf("M3MakeFromQ(%v rad) = %v, want %v", radians, mat.String(), rot.String())
+		}
+		QRotate(&want, &quat, &vec)
+		M3MulV3(&got, &mat, &vec)
+		if !v3Near(&got, &want, 1e-5) {
+			t.Errorf("M3MakeFromQ(%v rad) * vec = %v, QRotate gives %v", radians, got, want)
+		}
+	}
+}
+
+func TestM4MakeFromQV3(t *testing.T) {
+	var axis, translation Vector3
+	var quat Quat
+	var rot Matrix3
+	var mat, want Matrix4
+	V3MakeFromElems(&axis, 0.0, 0.6, 0.8)
+	V3MakeFromElems(&translation, 7.0, -8.0, 9.0)
+	QMakeRotationAxis(&quat, 0.9, &axis)
+	M4MakeFromQV3(&mat, &quat, &translation)
+	M3MakeRotationAxis(&rot, 0.9, &axis)
+	M4MakeFromM3V3(&want, &rot, &translation)
+	if !m4Near(&mat, &want, 1e-6) {
+		t.Errorf("M4MakeFromQV3 = %v, want %v", mat.String(), want.String())
+	}
+}
+
+func TestM3TryInverse(t *testing.T) {
+	var scale Vector3
+	var mat, inv, prod, ident Matrix3
+	M3MakeIdentity(&ident)
+	for _, s := range []float32{1e-4, 1.0, 1e4} {
+		V3MakeFromElems(&scale, s, 2.0*s, 0.5*s)
+		M3MakeScale(&mat, &scale)
+		if !M3TryInverse(&inv, &mat, 1e-6) {
+			t.Errorf("M3TryInverse rejected a scale by %v", scale)
+			continue
+		}
+		M3Mul(&prod, &mat, &inv)
+		if !m3Near(&prod, &ident, 1e-6) {
+			t.Errorf("mat * M3TryInverse(mat) = %v, want identity", prod.String())
+		}
+	}
+
+	// One axis collapsed relative to the others.
+	V3MakeFromElems(&scale, 1e4, 1e4, 1e-5)
+	M3MakeScale(&mat, &scale)
+	inv = ident
+	if M3TryInverse(&inv, &mat, 1e-6) {
+		t.Errorf("M3TryInverse accepted a scale by %v", scale)
+	}
+	if inv != ident {
+		t.Errorf("M3TryInverse changed result on failure: %v", inv.String())
+	}
+}
+
+func TestM4TryInverse(t *testing.T) {
+	var translation, scale Vector3
+	var mat, inv, prod, ident Matrix4
+	var tfrm, tfrmInv Transform3
+	M4MakeIdentity(&ident)
+	for _, x := range []float32{0.0, 100.0, 1000.0, 1e6} {
+		V3MakeFromElems(&translation, x, -x, 0.5*x)
+		M4MakeTranslation(&mat, &translation)
+		T3MakeTranslation(&tfrm, &translation)
+		if !T3TryInverse(&tfrmInv, &tfrm, 1e-6) {
+			t.Errorf("T3TryInverse rejected a translation by %v", translation)
+		}
+		if !M4TryInverse(&inv, &mat, 1e-6) {
+			t.Errorf("M4TryInverse rejected a translation by %v", translation)
+			continue
+		}
+		M4Mul(&prod, &mat, &inv)
+		if !m4Near(&prod, &ident, 1e-6) {
+			t.Errorf("mat * M4TryInverse(mat) = %v, want identity", prod.String())
+		}
+	}
+
+	// The whole homogeneous matrix scaled down is still invertible.
+	M4MakeRotationY(&mat, 0.3)
+	M4ScalarMul(&mat, &mat, 1e-3)
+	if !M4TryInverse(&inv, &mat, 1e-6) {
+		t.Errorf("M4TryInverse rejected %v", mat.String())
+	}
+	M4MakePerspective(&mat, 1.0, 1.5, 0.01, 1000.0)
+	if !M4TryInverse(&inv, &mat, 1e-6) {
+		t.Errorf("M4TryInverse rejected the perspective projection %v", mat.String())
+	}
+
+	// A flattened axis is rejected, however large the translation.
+	V3MakeFromElems(&scale, 1.0, 1e-7, 1.0)
+	M4MakeScale(&mat, &scale)
+	V3MakeFromElems(&translation, 1000.0, 0.0, 0.0)
+	mat.SetTranslation(&translation)
+	inv = ident
+	if M4TryInverse(&inv, &mat, 1e-6) {
+		t.Errorf("M4TryInverse accepted %v", mat.String())
+	}
+	if inv != ident {
+		t.Errorf("M4TryInverse changed result on failure: %v", inv.String())
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// The methods are generic, so they cannot call the float32 kernels in
+// asm_amd64.s directly. These helpers do when T is float32, so that the
+// methods give the same results as the functions they mirror, such as M4Mul.
+
+func m4MulAsm[T Float](result, mat0, mat1 *Matrix4Of[T]) {
+	if result32, ok := any(result).(*Matrix4); ok {
+		m4MulF32(result32, any(mat0).(*Matrix4), any(mat1).(*Matrix4))
+		return
+	}
+	m4Mul(result, mat0, mat1)
+}
+
+func m4MulV4Asm[T Float](result *Vector4Of[T], mat *Matrix4Of[T], vec *Vector4Of[T]) {
+	if result32, ok := any(result).(*Vector4); ok {
+		m4MulV4F32(result32, any(mat).(*Matrix4), any(vec).(*Vector4))
+		return
+	}
+	m4MulV4(result, mat, vec)
+}
+
+func m4InverseAsm[T Float](result, mat *Matrix4Of[T]) {
+	if result32, ok := any(result).(*Matrix4); ok {
+		m4InverseF32(result32, any(mat).(*Matrix4))
+		return
+	}
+	m4Inverse(result, mat)
+}
+
+func t3MulAsm[T Float](result, tfrm0, tfrm1 *Transform3Of[T]) {
+	if result32, ok := any(result).(*Transform3); ok {
+		t3MulF32(result32, any(tfrm0).(*Transform3), any(tfrm1).(*Transform3))
+		return
+	}
+	t3Mul(result, tfrm0, tfrm1)
+}
+
+/*******/
+
+func (m Matrix3Of[T]) Add(mat1 Matrix3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3Add(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix3Of[T]) Sub(mat1 Matrix3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3Sub(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix3Of[T]) Neg() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3Neg(&result, &m)
+	return result
+}
+
+func (m Matrix3Of[T]) ScalarMul(scalar T) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3ScalarMul(&result, &m, scalar)
+	return result
+}
+
+func (m Matrix3Of[T]) Mul(mat1 Matrix3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3Mul(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix3Of[T]) MulV3(vec Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	m3MulV3(&result, &m, &vec)
+	return result
+}
+
+func (m Matrix3Of[T]) MulPerElem(mat1 Matrix3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3MulPerElem(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix3Of[T]) AbsPerElem() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3AbsPerElem(&result, &m)
+	return result
+}
+
+func (m Matrix3Of[T]) Transpose() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3Transpose(&result, &m)
+	return result
+}
+
+func (m Matrix3Of[T]) Inverse() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3Inverse(&result, &m)
+	return result
+}
+
+func (m Matrix3Of[T]) TryInverse(epsilon T) (Matrix3Of[T], bool) {
+	var result Matrix3Of[T]
+	ok := m3TryInverse(&result, &m, epsilon)
+	return result, ok
+}
+
+func (m Matrix3Of[T]) AppendScale(scaleVec Vector3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3AppendScale(&result, &m, &scaleVec)
+	return result
+}
+
+func (m Matrix3Of[T]) PrependScale(scaleVec Vector3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3PrependScale(&result, &scaleVec, &m)
+	return result
+}
+
+func (m Matrix3Of[T]) Col(col int) Vector3Of[T] {
+	var result Vector3Of[T]
+	m3GetCol(&result, &m, col)
+	return result
+}
+
+func (m Matrix3Of[T]) Row(row int) Vector3Of[T] {
+	var result Vector3Of[T]
+	m3GetRow(&result, &m, row)
+	return result
+}
+
+func (m Matrix3Of[T]) Quat() QuatOf[T] {
+	var result QuatOf[T]
+	qMakeFromM3(&result, &m)
+	return result
+}
+
+/*******/
+
+func (m Matrix4Of[T]) Add(mat1 Matrix4Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4Add(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix4Of[T]) Sub(mat1 Matrix4Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4Sub(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix4Of[T]) Neg() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4Neg(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) ScalarMul(scalar T) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4ScalarMul(&result, &m, scalar)
+	return result
+}
+
+func (m Matrix4Of[T]) Mul(mat1 Matrix4Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4MulAsm(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix4Of[T]) MulT3(tfrm1 Transform3Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4MulT3(&result, &m, &tfrm1)
+	return result
+}
+
+func (m Matrix4Of[T]) MulV4(vec Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	m4MulV4Asm(&result, &m, &vec)
+	return result
+}
+
+func (m Matrix4Of[T]) MulV3(vec Vector3Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	m4MulV3(&result, &m, &vec)
+	return result
+}
+
+func (m Matrix4Of[T]) MulP3(pnt Point3Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	m4MulP3(&result, &m, &pnt)
+	return result
+}
+
+func (m Matrix4Of[T]) MulPerElem(mat1 Matrix4Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4MulPerElem(&result, &m, &mat1)
+	return result
+}
+
+func (m Matrix4Of[T]) AbsPerElem() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4AbsPerElem(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) Transpose() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4Transpose(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) Inverse() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4InverseAsm(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) TryInverse(epsilon T) (Matrix4Of[T], bool) {
+	var result Matrix4Of[T]
+	if !m4IsInvertible(&m, epsilon) {
+		return result, false
+	}
+	m4InverseAsm(&result, &m)
+	return result, true
+}
+
+func (m Matrix4Of[T]) AffineInverse() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4AffineInverse(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) OrthoInverse() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4OrthoInverse(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) AppendScale(scaleVec Vector3Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4AppendScale(&result, &m, &scaleVec)
+	return result
+}
+
+func (m Matrix4Of[T]) PrependScale(scaleVec Vector3Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4PrependScale(&result, &scaleVec, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) Col(col int) Vector4Of[T] {
+	var result Vector4Of[T]
+	m4GetCol(&result, &m, col)
+	return result
+}
+
+func (m Matrix4Of[T]) Row(row int) Vector4Of[T] {
+	var result Vector4Of[T]
+	m4GetRow(&result, &m, row)
+	return result
+}
+
+func (m Matrix4Of[T]) Upper3x3() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m4GetUpper3x3(&result, &m)
+	return result
+}
+
+func (m Matrix4Of[T]) Translation() Vector3Of[T] {
+	var result Vector3Of[T]
+	m4GetTranslation(&result, &m)
+	return result
+}
+
+/*******/
+
+func (t Transform3Of[T]) Mul(tfrm1 Transform3Of[T]) Transform3Of[T] {
+	var result Transform3Of[T]
+	t3MulAsm(&result, &t, &tfrm1)
+	return result
+}
+
+func (t Transform3Of[T]) MulV3(vec Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	t3MulV3(&result, &t, &vec)
+	return result
+}
+
+func (t Transform3Of[T]) MulP3(pnt Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	t3MulP3(&result, &t, &pnt)
+	return result
+}
+
+func (t Transform3Of[T]) MulPerElem(tfrm1 Transform3Of[T]) Transform3Of[T] {
+	var result Transform3Of[T]
+	t3MulPerElem(&result, &t, &tfrm1)
+	return result
+}
+
+func (t Transform3Of[T]) AbsPerElem() Transform3Of[T] {
+	var result Transform3Of[T]
+	t3AbsPerElem(&result, &t)
+	return result
+}
+
+func (t Transform3Of[T]) Inverse() Transform3Of[T] {
+	var result Transform3Of[T]
+	t3Inverse(&result, &t)
+	return result
+}
+
+func (t Transform3Of[T]) TryInverse(epsilon T) (Transform3Of[T], bool) {
+	var result Transform3Of[T]
+	ok := t3TryInverse(&result, &t, epsilon)
+	return result, ok
+}
+
+func (t Transform3Of[T]) OrthoInverse() Transform3Of[T] {
+	var result Transform3Of[T]
+	t3OrthoInverse(&result, &t)
+	return result
+}
+
+func (t Transform3Of[T]) AppendScale(scaleVec Vector3Of[T]) Transform3Of[T] {
+	var result Transform3Of[T]
+	t3AppendScale(&result, &t, &scaleVec)
+	return result
+}
+
+func (t Transform3Of[T]) PrependScale(scaleVec Vector3Of[T]) Transform3Of[T] {
+	var result Transform3Of[T]
+	t3PrependScale(&result, &scaleVec, &t)
+	return result
+}
+
+func (t Transform3Of[T]) Col(col int) Vector3Of[T] {
+	var result Vector3Of[T]
+	t3GetCol(&result, &t, col)
+	return result
+}
+
+func (t Transform3Of[T]) Row(row int) Vector4Of[T] {
+	var result Vector4Of[T]
+	t3GetRow(&result, &t, row)
+	return result
+}
+
+func (t Transform3Of[T]) Upper3x3() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	t3GetUpper3x3(&result, &t)
+	return result
+}
+
+func (t Transform3Of[T]) Translation() Vector3Of[T] {
+	var result Vector3Of[T]
+	t3GetTranslation(&result, &t)
+	return result
+}
+
+func (t Transform3Of[T]) Matrix4() Matrix4Of[T] {
+	var result Matrix4Of[T]
+	m4MakeFromT3(&result, &t)
+	return result
+}
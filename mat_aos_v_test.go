@@ -0,0 +1,160 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestM4ValueMethods(t *testing.T) {
+	var axis, translation Vector3
+	var pnt Point3
+	var mat0, mat1, want Matrix4
+	var wantV4 Vector4
+	V3MakeFromElems(&axis, 0.6, 0.0, 0.8)
+	V3MakeFromElems(&translation, 1.0, -2.0, 3.0)
+	P3MakeFromElems(&pnt, 4.0, 5.0, 6.0)
+	M4MakeRotationAxis(&mat0, 0.4, &axis)
+	mat0.SetTranslation(&translation)
+	M4MakeScale(&mat1, &axis)
+
+	got := mat0.Mul(mat1)
+	M4Mul(&want, &mat0, &mat1)
+	if !m4Near(&got, &want, 1e-6) {
+		t.Errorf("mat0.Mul(mat1) = %v, want %v", got.String(), want.String())
+	}
+	got = mat0.Inverse()
+	M4Inverse(&want, &mat0)
+	if !m4Near(&got, &want, 1e-6) {
+		t.Errorf("mat0.Inverse() = %v, want %v", got.String(), want.String())
+	}
+	gotV4 := mat0.MulP3(pnt)
+	M4MulP3(&wantV4, &mat0, &pnt)
+	if !v4Near(&gotV4, &wantV4, 1e-6) {
+		t.Errorf("mat0.MulP3(pnt) = %v, want %v", gotV4, wantV4)
+	}
+	if got := mat0.Translation(); got != translation {
+		t.Errorf("mat0.Translation() = %v, want %v", got, translation)
+	}
+}
+
+func TestT3ValueMethods(t *testing.T) {
+	var translation Vector3
+	var pnt Point3
+	var tfrm Transform3
+	V3MakeFromElems(&translation, 1.0, -2.0, 3.0)
+	P3MakeFromElems(&pnt, 4.0, 5.0, 6.0)
+	T3MakeRotationZ(&tfrm, 1.1)
+	tfrm.SetTranslation(&translation)
+
+	got := tfrm.Inverse().MulP3(tfrm.MulP3(pnt))
+	if !p3Near(&got, &pnt, 1e-5) {
+		t.Errorf("tfrm.Inverse().MulP3(tfrm.MulP3(pnt)) = %v, want %v", got, pnt)
+	}
+	if _, ok := tfrm.AppendScale(Vector3{X: 1.0, Y: 0.0, Z: 1.0}).TryInverse(1e-6); ok {
+		t.Errorf("TryInverse succeeded on a singular transform")
+	}
+}
+
+// The methods use the same kernels as the functions they mirror, so the
+// results match exactly, including when the Go code and the assembly would
+// round differently, as with GOAMD64=v3.
+func TestValueMethodsMatchFunctions(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	elem := func() float32 { return rng.Float32()*4.0 - 2.0 }
+	for i := 0; i < 100; i++ {
+		var mat0, mat1, wantM4 Matrix4
+		var tfrm0, tfrm1, wantT3 Transform3
+		var quat0, quat1, wantQ Quat
+		var vec, wantV4 Vector4
+		for col := 0; col < 4; col++ {
+			for row := 0; row < 4; row++ {
+				mat0.SetElem(col, row, elem())
+				mat1.SetElem(col, row, elem())
+			}
+			for row := 0; row < 3; row++ {
+				tfrm0.SetElem(col, row, elem())
+				tfrm1.SetElem(col, row, elem())
+			}
+		}
+		V4MakeFromElems(&vec, elem(), elem(), elem(), elem())
+		QMakeFromElems(&quat0, elem(), elem(), elem(), elem())
+		QMakeFromElems(&quat1, elem(), elem(), elem(), elem())
+
+		if M4Mul(&wantM4, &mat0, &mat1); mat0.Mul(mat1) != wantM4 {
+			t.Errorf("mat0.Mul(mat1) differs from M4Mul for %v, %v", mat0.String(), mat1.String())
+		}
+		if M4MulV4(&wantV4, &mat0, &vec); mat0.MulV4(vec) != wantV4 {
+			t.Errorf("mat0.MulV4(vec) differs from M4MulV4 for %v, %v", mat0.String(), vec)
+		}
+		var tryInv Matrix4
+		M4Inverse(&wantM4, &mat0)
+		if mat0.Inverse() != wantM4 {
+			t.Errorf("mat0.Inverse() differs from M4Inverse for %v", mat0.String())
+		}
+		if M4TryInverse(&tryInv, &mat0, 1e-6) && tryInv != wantM4 {
+			t.Errorf("M4TryInverse differs from M4Inverse for %v", mat0.String())
+		}
+		if got, ok := mat0.TryInverse(1e-6); ok && got != wantM4 {
+			t.Errorf("mat0.TryInverse differs from M4Inverse for %v", mat0.String())
+		}
+		if T3Mul(&wantT3, &tfrm0, &tfrm1); tfrm0.Mul(tfrm1) != wantT3 {
+			t.Errorf("tfrm0.Mul(tfrm1) differs from T3Mul for %v, %v", tfrm0.String(), tfrm1.String())
+		}
+		if QMul(&wantQ, &quat0, &quat1); quat0.Mul(quat1) != wantQ {
+			t.Errorf("quat0.Mul(quat1) differs from QMul for %v, %v", quat0, quat1)
+		}
+	}
+}
+
+var benchM4 Matrix4
+
+func BenchmarkM4ValueMul(b *testing.B) {
+	var mat0, mat1 Matrix4
+	M4MakeRotationX(&mat0, 0.1)
+	M4MakeRotationY(&mat1, 0.2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		mat0 = mat0.Mul(mat1)
+	}
+	benchM4 = mat0
+}
+
+func BenchmarkM4PointerMul(b *testing.B) {
+	var mat0, mat1 Matrix4
+	M4MakeRotationX(&mat0, 0.1)
+	M4MakeRotationY(&mat1, 0.2)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		M4Mul(&mat0, &mat0, &mat1)
+	}
+	benchM4 = mat0
+}
+
+var benchP3 Point3
+
+func BenchmarkT3ValueMulP3(b *testing.B) {
+	var tfrm Transform3
+	var pnt Point3
+	T3MakeRotationZ(&tfrm, 0.1)
+	P3MakeFromElems(&pnt, 1.0, 2.0, 3.0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		pnt = tfrm.MulP3(pnt)
+	}
+	benchP3 = pnt
+}
+
+func BenchmarkT3PointerMulP3(b *testing.B) {
+	var tfrm Transform3
+	var pnt Point3
+	T3MakeRotationZ(&tfrm, 0.1)
+	P3MakeFromElems(&pnt, 1.0, 2.0, 3.0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		T3MulP3(&pnt, &tfrm, &pnt)
+	}
+	benchP3 = pnt
+}
@@ -0,0 +1,1302 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+const g_PI_OVER_2 = 1.570796327
+
+func m3Copy[T Float](result *Matrix3Of[T], mat *Matrix3Of[T]) {
+	v3Copy(&result.col0, &mat.col0)
+	v3Copy(&result.col1, &mat.col1)
+	v3Copy(&result.col2, &mat.col2)
+}
+
+func m3MakeFromScalar[T Float](result *Matrix3Of[T], scalar T) {
+	v3MakeFromScalar(&result.col0, scalar)
+	v3MakeFromScalar(&result.col1, scalar)
+	v3MakeFromScalar(&result.col2, scalar)
+}
+
+func m3MakeFromQ[T Float](result *Matrix3Of[T], unitQuat *QuatOf[T]) {
+	qx := unitQuat.X
+	qy := unitQuat.Y
+	qz := unitQuat.Z
+	qw := unitQuat.W
+	qx2 := qx + qx
+	qy2 := qy + qy
+	qz2 := qz + qz
+	qxqx2 := qx * qx2
+	qxqy2 := qx * qy2
+	qxqz2 := qx * qz2
+	qxqw2 := qw * qx2
+	qyqy2 := qy * qy2
+	qyqz2 := qy * qz2
+	qyqw2 := qw * qy2
+	qzqz2 := qz * qz2
+	qzqw2 := qw * qz2
+	v3MakeFromElems(&result.col0, ((1.0 - qyqy2) - qzqz2), (qxqy2 + qzqw2), (qxqz2 - qyqw2))
+	v3MakeFromElems(&result.col1, (qxqy2 - qzqw2), ((1.0 - qxqx2) - qzqz2), (qyqz2 + qxqw2))
+	v3MakeFromElems(&result.col2, (qxqz2 + qyqw2), (qyqz2 - qxqw2), ((1.0 - qxqx2) - qyqy2))
+}
+
+func m3MakeFromCols[T Float](result *Matrix3Of[T], col0, col1, col2 *Vector3Of[T]) {
+	v3Copy(&result.col0, col0)
+	v3Copy(&result.col1, col1)
+	v3Copy(&result.col2, col2)
+}
+
+func (m *Matrix3Of[T]) SetCol0(col0 *Vector3Of[T]) {
+	v3Copy(&m.col0, col0)
+}
+
+func (m *Matrix3Of[T]) SetCol1(col1 *Vector3Of[T]) {
+	v3Copy(&m.col1, col1)
+}
+
+func (m *Matrix3Of[T]) SetCol2(col2 *Vector3Of[T]) {
+	v3Copy(&m.col2, col2)
+}
+
+func (m *Matrix3Of[T]) SetCol(col int, vec *Vector3Of[T]) {
+	switch col {
+	case 0:
+		v3Copy(&m.col0, vec)
+	case 1:
+		v3Copy(&m.col1, vec)
+	case 2:
+		v3Copy(&m.col2, vec)
+	}
+}
+
+func (m *Matrix3Of[T]) SetRow(row int, vec *Vector3Of[T]) {
+	m.col0.SetElem(row, vec.GetElem(0))
+	m.col1.SetElem(row, vec.GetElem(1))
+	m.col2.SetElem(row, vec.GetElem(2))
+}
+
+func (m *Matrix3Of[T]) SetElem(col, row int, val T) {
+	var tmpV3_0 Vector3Of[T]
+	m3GetCol(&tmpV3_0, m, col)
+	tmpV3_0.SetElem(row, val)
+	m.SetCol(col, &tmpV3_0)
+}
+
+func (m *Matrix3Of[T]) GetElem(col, row int) T {
+	var tmpV3_0 Vector3Of[T]
+	m3GetCol(&tmpV3_0, m, col)
+	return tmpV3_0.GetElem(row)
+}
+
+func m3GetCol0[T Float](result *Vector3Of[T], mat *Matrix3Of[T]) {
+	v3Copy(result, &mat.col0)
+}
+
+func m3GetCol1[T Float](result *Vector3Of[T], mat *Matrix3Of[T]) {
+	v3Copy(result, &mat.col1)
+}
+
+func m3GetCol2[T Float](result *Vector3Of[T], mat *Matrix3Of[T]) {
+	v3Copy(result, &mat.col2)
+}
+
+func m3GetCol[T Float](result *Vector3Of[T], mat *Matrix3Of[T], col int) {
+	switch col {
+	case 0:
+		v3Copy(result, &mat.col0)
+	case 1:
+		v3Copy(result, &mat.col1)
+	case 2:
+		v3Copy(result, &mat.col2)
+	}
+}
+
+func m3GetRow[T Float](result *Vector3Of[T], mat *Matrix3Of[T], row int) {
+	x := mat.col0.GetElem(row)
+	y := mat.col1.GetElem(row)
+	z := mat.col2.GetElem(row)
+	v3MakeFromElems(result, x, y, z)
+}
+
+func m3Transpose[T Float](result, mat *Matrix3Of[T]) {
+	var tmpResult Matrix3Of[T]
+	v3MakeFromElems(&tmpResult.col0, mat.col0.X, mat.col1.X, mat.col2.X)
+	v3MakeFromElems(&tmpResult.col1, mat.col0.Y, mat.col1.Y, mat.col2.Y)
+	v3MakeFromElems(&tmpResult.col2, mat.col0.Z, mat.col1.Z, mat.col2.Z)
+	m3Copy(result, &tmpResult)
+}
+
+func m3Inverse[T Float](result, mat *Matrix3Of[T]) {
+	var tmp0, tmp1, tmp2 Vector3Of[T]
+	v3Cross(&tmp0, &mat.col1, &mat.col2)
+	v3Cross(&tmp1, &mat.col2, &mat.col0)
+	v3Cross(&tmp2, &mat.col0, &mat.col1)
+	detinv := 1.0 / v3Dot(&mat.col2, &tmp2)
+	v3MakeFromElems(&result.col0, tmp0.X*detinv, tmp1.X*detinv, tmp2.X*detinv)
+	v3MakeFromElems(&result.col1, tmp0.Y*detinv, tmp1.Y*detinv, tmp2.Y*detinv)
+	v3MakeFromElems(&result.col2, tmp0.Z*detinv, tmp1.Z*detinv, tmp2.Z*detinv)
+}
+
+// m3IsInvertible reports whether the determinant of mat is larger in
+// magnitude than epsilon times the cube of its longest column, which is the
+// determinant of a matrix of the same scale with orthogonal columns. This
+// makes epsilon independent of the overall scale of the matrix, while still
+// rejecting matrices that collapse one axis relative to the others.
+func m3IsInvertible[T Float](mat *Matrix3Of[T], epsilon T) bool {
+	det := mat.Determinant()
+	scale := max(max(mat.col0.Length(), mat.col1.Length()), mat.col2.Length())
+	return abs(det) > epsilon*scale*scale*scale
+}
+
+func m3TryInverse[T Float](result, mat *Matrix3Of[T], epsilon T) bool {
+	if !m3IsInvertible(mat, epsilon) {
+		return false
+	}
+	m3Inverse(result, mat)
+	return true
+}
+
+func (m Matrix3Of[T]) Determinant() T {
+	var tmpV3_0 Vector3Of[T]
+	v3Cross(&tmpV3_0, &m.col0, &m.col1)
+	return v3Dot(&m.col2, &tmpV3_0)
+}
+
+func m3Add[T Float](result, mat0, mat1 *Matrix3Of[T]) {
+	v3Add(&result.col0, &mat0.col0, &mat1.col0)
+	v3Add(&result.col1, &mat0.col1, &mat1.col1)
+	v3Add(&result.col2, &mat0.col2, &mat1.col2)
+}
+
+func m3Sub[T Float](result, mat0, mat1 *Matrix3Of[T]) {
+	v3Sub(&result.col0, &mat0.col0, &mat1.col0)
+	v3Sub(&result.col1, &mat0.col1, &mat1.col1)
+	v3Sub(&result.col2, &mat0.col2, &mat1.col2)
+}
+
+func m3Neg[T Float](result, mat *Matrix3Of[T]) {
+	v3Neg(&result.col0, &mat.col0)
+	v3Neg(&result.col1, &mat.col1)
+	v3Neg(&result.col2, &mat.col2)
+}
+
+func m3AbsPerElem[T Float](result, mat *Matrix3Of[T]) {
+	v3AbsPerElem(&result.col0, &mat.col0)
+	v3AbsPerElem(&result.col1, &mat.col1)
+	v3AbsPerElem(&result.col2, &mat.col2)
+}
+
+func m3ScalarMul[T Float](result, mat *Matrix3Of[T], scalar T) {
+	v3ScalarMul(&result.col0, &mat.col0, scalar)
+	v3ScalarMul(&result.col1, &mat.col1, scalar)
+	v3ScalarMul(&result.col2, &mat.col2, scalar)
+}
+
+func m3MulV3[T Float](result *Vector3Of[T], mat *Matrix3Of[T], vec *Vector3Of[T]) {
+	tmpX := ((mat.col0.X * vec.X) + (mat.col1.X * vec.Y)) + (mat.col2.X * vec.Z)
+	tmpY := ((mat.col0.Y * vec.X) + (mat.col1.Y * vec.Y)) + (mat.col2.Y * vec.Z)
+	tmpZ := ((mat.col0.Z * vec.X) + (mat.col1.Z * vec.Y)) + (mat.col2.Z * vec.Z)
+	v3MakeFromElems(result, tmpX, tmpY, tmpZ)
+}
+
+func m3Mul[T Float](result, mat0, mat1 *Matrix3Of[T]) {
+	var tmpResult Matrix3Of[T]
+	m3MulV3(&tmpResult.col0, mat0, &mat1.col0)
+	m3MulV3(&tmpResult.col1, mat0, &mat1.col1)
+	m3MulV3(&tmpResult.col2, mat0, &mat1.col2)
+	m3Copy(result, &tmpResult)
+}
+
+func m3MulPerElem[T Float](result, mat0, mat1 *Matrix3Of[T]) {
+	v3MulPerElem(&result.col0, &mat0.col0, &mat1.col0)
+	v3MulPerElem(&result.col1, &mat0.col1, &mat1.col1)
+	v3MulPerElem(&result.col2, &mat0.col2, &mat1.col2)
+}
+
+func m3MakeIdentity[T Float](result *Matrix3Of[T]) {
+	v3MakeXAxis(&result.col0)
+	v3MakeYAxis(&result.col1)
+	v3MakeZAxis(&result.col2)
+}
+
+func m3MakeRotationX[T Float](result *Matrix3Of[T], radians T) {
+	s, c := sincos(radians)
+	v3MakeXAxis(&result.col0)
+	v3MakeFromElems(&result.col1, 0.0, c, s)
+	v3MakeFromElems(&result.col2, 0.0, -s, c)
+}
+
+func m3MakeRotationY[T Float](result *Matrix3Of[T], radians T) {
+	s, c := sincos(radians)
+	v3MakeFromElems(&result.col0, c, 0.0, -s)
+	v3MakeYAxis(&result.col1)
+	v3MakeFromElems(&result.col2, s, 0.0, c)
+}
+
+func m3MakeRotationZ[T Float](result *Matrix3Of[T], radians T) {
+	s, c := sincos(radians)
+	v3MakeFromElems(&result.col0, c, s, 0.0)
+	v3MakeFromElems(&result.col1, -s, c, 0.0)
+	v3MakeZAxis(&result.col2)
+}
+
+func m3MakeRotationZYX[T Float](result *Matrix3Of[T], radiansXYZ *Vector3Of[T]) {
+	sX, cX := sincos(radiansXYZ.X)
+	sY, cY := sincos(radiansXYZ.Y)
+	sZ, cZ := sincos(radiansXYZ.Z)
+	tmp0 := cZ * sY
+	tmp1 := sZ * sY
+	v3MakeFromElems(&result.col0, (cZ * cY), (sZ * cY), -sY)
+	v3MakeFromElems(&result.col1, ((tmp0 * sX) - (sZ * cX)), ((tmp1 * sX) + (cZ * cX)), (cY * sX))
+	v3MakeFromElems(&result.col2, ((tmp0 * cX) + (sZ * sX)), ((tmp1 * cX) - (cZ * sX)), (cY * cX))
+}
+
+func m3MakeRotationAxis[T Float](result *Matrix3Of[T], radians T, unitVec *Vector3Of[T]) {
+	s, c := sincos(radians)
+	x := unitVec.X
+	y := unitVec.Y
+	z := unitVec.Z
+	xy := x * y
+	yz := y * z
+	zx := z * x
+	oneMinusC := 1.0 - c
+	v3MakeFromElems(&result.col0, (((x * x) * oneMinusC) + c), ((xy * oneMinusC) + (z * s)), ((zx * oneMinusC) - (y * s)))
+	v3MakeFromElems(&result.col1, ((xy * oneMinusC) - (z * s)), (((y * y) * oneMinusC) + c), ((yz * oneMinusC) + (x * s)))
+	v3MakeFromElems(&result.col2, ((zx * oneMinusC) + (y * s)), ((yz * oneMinusC) - (x * s)), (((z * z) * oneMinusC) + c))
+}
+
+func m3MakeRotationQ[T Float](result *Matrix3Of[T], unitQuat *QuatOf[T]) {
+	m3MakeFromQ(result, unitQuat)
+}
+
+func m3MakeScale[T Float](result *Matrix3Of[T], scaleVec *Vector3Of[T]) {
+	v3MakeFromElems(&result.col0, scaleVec.X, 0.0, 0.0)
+	v3MakeFromElems(&result.col1, 0.0, scaleVec.Y, 0.0)
+	v3MakeFromElems(&result.col2, 0.0, 0.0, scaleVec.Z)
+}
+
+func m3AppendScale[T Float](result, mat *Matrix3Of[T], scaleVec *Vector3Of[T]) {
+	v3ScalarMul(&result.col0, &mat.col0, scaleVec.X)
+	v3ScalarMul(&result.col1, &mat.col1, scaleVec.Y)
+	v3ScalarMul(&result.col2, &mat.col2, scaleVec.Z)
+}
+
+func m3PrependScale[T Float](result *Matrix3Of[T], scaleVec *Vector3Of[T], mat *Matrix3Of[T]) {
+	v3MulPerElem(&result.col0, &mat.col0, scaleVec)
+	v3MulPerElem(&result.col1, &mat.col1, scaleVec)
+	v3MulPerElem(&result.col2, &mat.col2, scaleVec)
+}
+
+func m3Select[T Float](result, mat0, mat1 *Matrix3Of[T], select1 int) {
+	v3Select(&result.col0, &mat0.col0, &mat1.col0, select1)
+	v3Select(&result.col1, &mat0.col1, &mat1.col1, select1)
+	v3Select(&result.col2, &mat0.col2, &mat1.col2, select1)
+}
+
+func (m *Matrix3Of[T]) String() string {
+	var tmp Matrix3Of[T]
+	m3Transpose(&tmp, m)
+	return tmp.col0.String() + tmp.col1.String() + tmp.col2.String()
+}
+
+/*******/
+
+func m4Copy[T Float](result, mat *Matrix4Of[T]) {
+	v4Copy(&result.col0, &mat.col0)
+	v4Copy(&result.col1, &mat.col1)
+	v4Copy(&result.col2, &mat.col2)
+	v4Copy(&result.col3, &mat.col3)
+}
+
+func m4MakeFromScalar[T Float](result *Matrix4Of[T], scalar T) {
+	v4MakeFromScalar(&result.col0, scalar)
+	v4MakeFromScalar(&result.col1, scalar)
+	v4MakeFromScalar(&result.col2, scalar)
+	v4MakeFromScalar(&result.col3, scalar)
+}
+
+func m4MakeFromT3[T Float](result *Matrix4Of[T], mat *Transform3Of[T]) {
+	v4MakeFromV3Scalar(&result.col0, &mat.col0, 0.0)
+	v4MakeFromV3Scalar(&result.col1, &mat.col1, 0.0)
+	v4MakeFromV3Scalar(&result.col2, &mat.col2, 0.0)
+	v4MakeFromV3Scalar(&result.col3, &mat.col3, 1.0)
+}
+
+func m4MakeFromCols[T Float](result *Matrix4Of[T], col0, col1, col2, col3 *Vector4Of[T]) {
+	v4Copy(&result.col0, col0)
+	v4Copy(&result.col1, col1)
+	v4Copy(&result.col2, col2)
+	v4Copy(&result.col3, col3)
+}
+
+func m4MakeFromM3V3[T Float](result *Matrix4Of[T], mat *Matrix3Of[T], translateVec *Vector3Of[T]) {
+	v4MakeFromV3Scalar(&result.col0, &mat.col0, 0.0)
+	v4MakeFromV3Scalar(&result.col1, &mat.col1, 0.0)
+	v4MakeFromV3Scalar(&result.col2, &mat.col2, 0.0)
+	v4MakeFromV3Scalar(&result.col3, translateVec, 1.0)
+}
+
+func m4MakeFromQV3[T Float](result *Matrix4Of[T], unitQuat *QuatOf[T], translateVec *Vector3Of[T]) {
+	var mat Matrix3Of[T]
+	m3MakeFromQ(&mat, unitQuat)
+	v4MakeFromV3Scalar(&result.col0, &mat.col0, 0.0)
+	v4MakeFromV3Scalar(&result.col1, &mat.col1, 0.0)
+	v4MakeFromV3Scalar(&result.col2, &mat.col2, 0.0)
+	v4MakeFromV3Scalar(&result.col3, translateVec, 1.0)
+}
+
+func (m *Matrix4Of[T]) SetCol0(col0 *Vector4Of[T]) {
+	v4Copy(&m.col0, col0)
+}
+
+func (m *Matrix4Of[T]) SetCol1(col1 *Vector4Of[T]) {
+	v4Copy(&m.col1, col1)
+}
+
+func (m *Matrix4Of[T]) SetCol2(col2 *Vector4Of[T]) {
+	v4Copy(&m.col2, col2)
+}
+
+func (m *Matrix4Of[T]) SetCol3(col3 *Vector4Of[T]) {
+	v4Copy(&m.col3, col3)
+}
+
+func (m *Matrix4Of[T]) SetCol(col int, vec *Vector4Of[T]) {
+	switch col {
+	case 0:
+		v4Copy(&m.col0, vec)
+	case 1:
+		v4Copy(&m.col1, vec)
+	case 2:
+		v4Copy(&m.col2, vec)
+	case 3:
+		v4Copy(&m.col3, vec)
+	}
+}
+
+func (m *Matrix4Of[T]) SetRow(row int, vec *Vector4Of[T]) {
+	m.col0.SetElem(row, vec.X)
+	m.col1.SetElem(row, vec.Y)
+	m.col2.SetElem(row, vec.Z)
+	m.col3.SetElem(row, vec.W)
+}
+
+func (m *Matrix4Of[T]) SetElem(col, row int, val T) {
+	var tmpV3_0 Vector4Of[T]
+	m4GetCol(&tmpV3_0, m, col)
+	tmpV3_0.SetElem(row, val)
+	m.SetCol(col, &tmpV3_0)
+}
+
+func (m *Matrix4Of[T]) GetElem(col, row int) T {
+	var tmpV4_0 Vector4Of[T]
+	m4GetCol(&tmpV4_0, m, col)
+	return tmpV4_0.GetElem(row)
+}
+
+func m4GetCol0[T Float](result *Vector4Of[T], mat *Matrix4Of[T]) {
+	v4Copy(result, &mat.col0)
+}
+
+func m4GetCol1[T Float](result *Vector4Of[T], mat *Matrix4Of[T]) {
+	v4Copy(result, &mat.col1)
+}
+
+func m4GetCol2[T Float](result *Vector4Of[T], mat *Matrix4Of[T]) {
+	v4Copy(result, &mat.col2)
+}
+
+func m4GetCol3[T Float](result *Vector4Of[T], mat *Matrix4Of[T]) {
+	v4Copy(result, &mat.col3)
+}
+
+func m4GetCol[T Float](result *Vector4Of[T], mat *Matrix4Of[T], col int) {
+	switch col {
+	case 0:
+		v4Copy(result, &mat.col0)
+	case 1:
+		v4Copy(result, &mat.col1)
+	case 2:
+		v4Copy(result, &mat.col2)
+	case 3:
+		v4Copy(result, &mat.col3)
+	}
+}
+
+func m4GetRow[T Float](result *Vector4Of[T], mat *Matrix4Of[T], row int) {
+	v4MakeFromElems(result, mat.col0.GetElem(row), mat.col1.GetElem(row), mat.col2.GetElem(row), mat.col3.GetElem(row))
+}
+
+func m4Transpose[T Float](result, mat *Matrix4Of[T]) {
+	var tmpResult Matrix4Of[T]
+	v4MakeFromElems(&tmpResult.col0, mat.col0.X, mat.col1.X, mat.col2.X, mat.col3.X)
+	v4MakeFromElems(&tmpResult.col1, mat.col0.Y, mat.col1.Y, mat.col2.Y, mat.col3.Y)
+	v4MakeFromElems(&tmpResult.col2, mat.col0.Z, mat.col1.Z, mat.col2.Z, mat.col3.Z)
+	v4MakeFromElems(&tmpResult.col3, mat.col0.W, mat.col1.W, mat.col2.W, mat.col3.W)
+	m4Copy(result, &tmpResult)
+}
+
+func m4Inverse[T Float](result, mat *Matrix4Of[T]) {
+	var res0, res1, res2, res3 Vector4Of[T]
+	mA := mat.col0.X
+	mB := mat.col0.Y
+	mC := mat.col0.Z
+	mD := mat.col0.W
+	mE := mat.col1.X
+	mF := mat.col1.Y
+	mG := mat.col1.Z
+	mH := mat.col1.W
+	mI := mat.col2.X
+	mJ := mat.col2.Y
+	mK := mat.col2.Z
+	mL := mat.col2.W
+	mM := mat.col3.X
+	mN := mat.col3.Y
+	mO := mat.col3.Z
+	mP := mat.col3.W
+	tmp0 := ((mK * mD) - (mC * mL))
+	tmp1 := ((mO * mH) - (mG * mP))
+	tmp2 := ((mB * mK) - (mJ * mC))
+	tmp3 := ((mF * mO) - (mN * mG))
+	tmp4 := ((mJ * mD) - (mB * mL))
+	tmp5 := ((mN * mH) - (mF * mP))
+	res0.SetX(((mJ * tmp1) - (mL * tmp3)) - (mK * tmp5))
+	res0.SetY(((mN * tmp0) - (mP * tmp2)) - (mO * tmp4))
+	res0.SetZ(((mD * tmp3) + (mC * tmp5)) - (mB * tmp1))
+	res0.SetW(((mH * tmp2) + (mG * tmp4)) - (mF * tmp0))
+	detInv := (1.0 / ((((mA * res0.X) + (mE * res0.Y)) + (mI * res0.Z)) + (mM * res0.W)))
+	res1.SetX(mI * tmp1)
+	res1.SetY(mM * tmp0)
+	res1.SetZ(mA * tmp1)
+	res1.SetW(mE * tmp0)
+	res3.SetX(mI * tmp3)
+	res3.SetY(mM * tmp2)
+	res3.SetZ(mA * tmp3)
+	res3.SetW(mE * tmp2)
+	res2.SetX(mI * tmp5)
+	res2.SetY(mM * tmp4)
+	res2.SetZ(mA * tmp5)
+	res2.SetW(mE * tmp4)
+	tmp0 = ((mI * mB) - (mA * mJ))
+	tmp1 = ((mM * mF) - (mE * mN))
+	tmp2 = ((mI * mD) - (mA * mL))
+	tmp3 = ((mM * mH) - (mE * mP))
+	tmp4 = ((mI * mC) - (mA * mK))
+	tmp5 = ((mM * mG) - (mE * mO))
+	res2.SetX(((mL * tmp1) - (mJ * tmp3)) + res2.X)
+	res2.SetY(((mP * tmp0) - (mN * tmp2)) + res2.Y)
+	res2.SetZ(((mB * tmp3) - (mD * tmp1)) - res2.Z)
+	res2.SetW(((mF * tmp2) - (mH * tmp0)) - res2.W)
+	res3.SetX(((mJ * tmp5) - (mK * tmp1)) + res3.X)
+	res3.SetY(((mN * tmp4) - (mO * tmp0)) + res3.Y)
+	res3.SetZ(((mC * tmp1) - (mB * tmp5)) - res3.Z)
+	res3.SetW(((mG * tmp0) - (mF * tmp4)) - res3.W)
+	res1.SetX(((mK * tmp3) - (mL * tmp5)) - res1.X)
+	res1.SetY(((mO * tmp2) - (mP * tmp4)) - res1.Y)
+	res1.SetZ(((mD * tmp5) - (mC * tmp3)) + res1.Z)
+	res1.SetW(((mH * tmp4) - (mG * tmp2)) + res1.W)
+	v4ScalarMul(&result.col0, &res0, detInv)
+	v4ScalarMul(&result.col1, &res1, detInv)
+	v4ScalarMul(&result.col2, &res2, detInv)
+	v4ScalarMul(&result.col3, &res3, detInv)
+}
+
+// m4IsInvertible compares the determinant of mat against epsilon times the
+// cube of its longest upper 3x3 column, times the length of its bottom row.
+// The translation column does not take part, so for an affine matrix this
+// is the test m3IsInvertible makes on the upper 3x3 part, and the result is
+// unchanged when the whole matrix is scaled.
+func m4IsInvertible[T Float](mat *Matrix4Of[T], epsilon T) bool {
+	var col0, col1, col2 Vector3Of[T]
+	var row3 Vector4Of[T]
+	det := mat.Determinant()
+	v4GetXYZ(&col0, &mat.col0)
+	v4GetXYZ(&col1, &mat.col1)
+	v4GetXYZ(&col2, &mat.col2)
+	m4GetRow(&row3, mat, 3)
+	scale := max(max(col0.Length(), col1.Length()), col2.Length())
+	return abs(det) > epsilon*scale*scale*scale*row3.Length()
+}
+
+func m4TryInverse[T Float](result, mat *Matrix4Of[T], epsilon T) bool {
+	if !m4IsInvertible(mat, epsilon) {
+		return false
+	}
+	m4Inverse(result, mat)
+	return true
+}
+
+func m4AffineInverse[T Float](result, mat *Matrix4Of[T]) {
+	var affineMat, tmpT3_0 Transform3Of[T]
+	var tmpV3_0, tmpV3_1, tmpV3_2, tmpV3_3 Vector3Of[T]
+	v4GetXYZ(&tmpV3_0, &mat.col0)
+	v4GetXYZ(&tmpV3_1, &mat.col1)
+	v4GetXYZ(&tmpV3_2, &mat.col2)
+	v4GetXYZ(&tmpV3_3, &mat.col3)
+	affineMat.SetCol0(&tmpV3_0)
+	affineMat.SetCol1(&tmpV3_1)
+	affineMat.SetCol2(&tmpV3_2)
+	affineMat.SetCol3(&tmpV3_3)
+	t3Inverse(&tmpT3_0, &affineMat)
+	m4MakeFromT3(result, &tmpT3_0)
+}
+
+func m4OrthoInverse[T Float](result, mat *Matrix4Of[T]) {
+	var affineMat, tmpT3_0 Transform3Of[T]
+	var tmpV3_0, tmpV3_1, tmpV3_2, tmpV3_3 Vector3Of[T]
+	v4GetXYZ(&tmpV3_0, &mat.col0)
+	v4GetXYZ(&tmpV3_1, &mat.col1)
+	v4GetXYZ(&tmpV3_2, &mat.col2)
+	v4GetXYZ(&tmpV3_3, &mat.col3)
+	affineMat.SetCol0(&tmpV3_0)
+	affineMat.SetCol1(&tmpV3_1)
+	affineMat.SetCol2(&tmpV3_2)
+	affineMat.SetCol3(&tmpV3_3)
+	t3OrthoInverse(&tmpT3_0, &affineMat)
+	m4MakeFromT3(result, &tmpT3_0)
+}
+
+func (m Matrix4Of[T]) Determinant() T {
+	mA := m.col0.X
+	mB := m.col0.Y
+	mC := m.col0.Z
+	mD := m.col0.W
+	mE := m.col1.X
+	mF := m.col1.Y
+	mG := m.col1.Z
+	mH := m.col1.W
+	mI := m.col2.X
+	mJ := m.col2.Y
+	mK := m.col2.Z
+	mL := m.col2.W
+	mM := m.col3.X
+	mN := m.col3.Y
+	mO := m.col3.Z
+	mP := m.col3.W
+	tmp0 := ((mK * mD) - (mC * mL))
+	tmp1 := ((mO * mH) - (mG * mP))
+	tmp2 := ((mB * mK) - (mJ * mC))
+	tmp3 := ((mF * mO) - (mN * mG))
+	tmp4 := ((mJ * mD) - (mB * mL))
+	tmp5 := ((mN * mH) - (mF * mP))
+	dx := (((mJ * tmp1) - (mL * tmp3)) - (mK * tmp5))
+	dy := (((mN * tmp0) - (mP * tmp2)) - (mO * tmp4))
+	dz := (((mD * tmp3) + (mC * tmp5)) - (mB * tmp1))
+	dw := (((mH * tmp2) + (mG * tmp4)) - (mF * tmp0))
+	return ((((mA * dx) + (mE * dy)) + (mI * dz)) + (mM * dw))
+}
+
+func m4Add[T Float](result, mat0, mat1 *Matrix4Of[T]) {
+	v4Add(&result.col0, &mat0.col0, &mat1.col0)
+	v4Add(&result.col1, &mat0.col1, &mat1.col1)
+	v4Add(&result.col2, &mat0.col2, &mat1.col2)
+	v4Add(&result.col3, &mat0.col3, &mat1.col3)
+}
+
+func m4Sub[T Float](result, mat0, mat1 *Matrix4Of[T]) {
+	v4Sub(&result.col0, &mat0.col0, &mat1.col0)
+	v4Sub(&result.col1, &mat0.col1, &mat1.col1)
+	v4Sub(&result.col2, &mat0.col2, &mat1.col2)
+	v4Sub(&result.col3, &mat0.col3, &mat1.col3)
+}
+
+func m4Neg[T Float](result, mat *Matrix4Of[T]) {
+	v4Neg(&result.col0, &mat.col0)
+	v4Neg(&result.col1, &mat.col1)
+	v4Neg(&result.col2, &mat.col2)
+	v4Neg(&result.col3, &mat.col3)
+}
+
+func m4AbsPerElem[T Float](result, mat *Matrix4Of[T]) {
+	v4AbsPerElem(&result.col0, &mat.col0)
+	v4AbsPerElem(&result.col1, &mat.col1)
+	v4AbsPerElem(&result.col2, &mat.col2)
+	v4AbsPerElem(&result.col3, &mat.col3)
+}
+
+func m4ScalarMul[T Float](result, mat *Matrix4Of[T], scalar T) {
+	v4ScalarMul(&result.col0, &mat.col0, scalar)
+	v4ScalarMul(&result.col1, &mat.col1, scalar)
+	v4ScalarMul(&result.col2, &mat.col2, scalar)
+	v4ScalarMul(&result.col3, &mat.col3, scalar)
+}
+
+func m4MulV4[T Float](result *Vector4Of[T], mat *Matrix4Of[T], vec *Vector4Of[T]) {
+	tmpX := (((mat.col0.X * vec.X) + (mat.col1.X * vec.Y)) + (mat.col2.X * vec.Z)) + (mat.col3.X * vec.W)
+	tmpY := (((mat.col0.Y * vec.X) + (mat.col1.Y * vec.Y)) + (mat.col2.Y * vec.Z)) + (mat.col3.Y * vec.W)
+	tmpZ := (((mat.col0.Z * vec.X) + (mat.col1.Z * vec.Y)) + (mat.col2.Z * vec.Z)) + (mat.col3.Z * vec.W)
+	tmpW := (((mat.col0.W * vec.X) + (mat.col1.W * vec.Y)) + (mat.col2.W * vec.Z)) + (mat.col3.W * vec.W)
+	v4MakeFromElems(result, tmpX, tmpY, tmpZ, tmpW)
+}
+
+func m4MulV3[T Float](result *Vector4Of[T], mat *Matrix4Of[T], vec *Vector3Of[T]) {
+	result.X = ((mat.col0.X * vec.X) + (mat.col1.X * vec.Y)) + (mat.col2.X * vec.Z)
+	result.Y = ((mat.col0.Y * vec.X) + (mat.col1.Y * vec.Y)) + (mat.col2.Y * vec.Z)
+	result.Z = ((mat.col0.Z * vec.X) + (mat.col1.Z * vec.Y)) + (mat.col2.Z * vec.Z)
+	result.W = ((mat.col0.W * vec.X) + (mat.col1.W * vec.Y)) + (mat.col2.W * vec.Z)
+}
+
+func m4MulP3[T Float](result *Vector4Of[T], mat *Matrix4Of[T], pnt *Point3Of[T]) {
+	result.X = (((mat.col0.X * pnt.X) + (mat.col1.X * pnt.Y)) + (mat.col2.X * pnt.Z)) + mat.col3.X
+	result.Y = (((mat.col0.Y * pnt.X) + (mat.col1.Y * pnt.Y)) + (mat.col2.Y * pnt.Z)) + mat.col3.Y
+	result.Z = (((mat.col0.Z * pnt.X) + (mat.col1.Z * pnt.Y)) + (mat.col2.Z * pnt.Z)) + mat.col3.Z
+	result.W = (((mat.col0.W * pnt.X) + (mat.col1.W * pnt.Y)) + (mat.col2.W * pnt.Z)) + mat.col3.W
+}
+
+func m4Mul[T Float](result, mat0, mat1 *Matrix4Of[T]) {
+	var tmpResult Matrix4Of[T]
+	m4MulV4(&tmpResult.col0, mat0, &mat1.col0)
+	m4MulV4(&tmpResult.col1, mat0, &mat1.col1)
+	m4MulV4(&tmpResult.col2, mat0, &mat1.col2)
+	m4MulV4(&tmpResult.col3, mat0, &mat1.col3)
+	m4Copy(result, &tmpResult)
+}
+
+func m4MulT3[T Float](result, mat *Matrix4Of[T], tfrm1 *Transform3Of[T]) {
+	var tmpResult Matrix4Of[T]
+	var tmpP3_0 Point3Of[T]
+	m4MulV3(&tmpResult.col0, mat, &tfrm1.col0)
+	m4MulV3(&tmpResult.col1, mat, &tfrm1.col1)
+	m4MulV3(&tmpResult.col2, mat, &tfrm1.col2)
+	p3MakeFromV3(&tmpP3_0, &tfrm1.col3)
+	m4MulP3(&tmpResult.col3, mat, &tmpP3_0)
+	m4Copy(result, &tmpResult)
+}
+
+func m4MulPerElem[T Float](result, mat0, mat1 *Matrix4Of[T]) {
+	v4MulPerElem(&result.col0, &mat0.col0, &mat1.col0)
+	v4MulPerElem(&result.col1, &mat0.col1, &mat1.col1)
+	v4MulPerElem(&result.col2, &mat0.col2, &mat1.col2)
+	v4MulPerElem(&result.col3, &mat0.col3, &mat1.col3)
+}
+
+func m4MakeIdentity[T Float](result *Matrix4Of[T]) {
+	v4MakeXAxis(&result.col0)
+	v4MakeYAxis(&result.col1)
+	v4MakeZAxis(&result.col2)
+	v4MakeWAxis(&result.col3)
+}
+
+func (m *Matrix4Of[T]) SetUpper3x3(mat3 *Matrix3Of[T]) {
+	m.col0.SetXYZ(&mat3.col0)
+	m.col1.SetXYZ(&mat3.col1)
+	m.col2.SetXYZ(&mat3.col2)
+}
+
+func m4GetUpper3x3[T Float](result *Matrix3Of[T], mat *Matrix4Of[T]) {
+	v4GetXYZ(&result.col0, &mat.col0)
+	v4GetXYZ(&result.col1, &mat.col1)
+	v4GetXYZ(&result.col2, &mat.col2)
+}
+
+func (m *Matrix4Of[T]) SetTranslation(translateVec *Vector3Of[T]) {
+	m.col3.SetXYZ(translateVec)
+}
+
+func m4GetTranslation[T Float](result *Vector3Of[T], mat *Matrix4Of[T]) {
+	v4GetXYZ(result, &mat.col3)
+}
+
+func m4MakeRotationX[T Float](result *Matrix4Of[T], radians T) {
+	s, c := sincos(radians)
+	v4MakeXAxis(&result.col0)
+	v4MakeFromElems(&result.col1, 0.0, c, s, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, -s, c, 0.0)
+	v4MakeWAxis(&result.col3)
+}
+
+func m4MakeRotationY[T Float](result *Matrix4Of[T], radians T) {
+	s, c := sincos(radians)
+	v4MakeFromElems(&result.col0, c, 0.0, -s, 0.0)
+	v4MakeYAxis(&result.col1)
+	v4MakeFromElems(&result.col2, s, 0.0, c, 0.0)
+	v4MakeWAxis(&result.col3)
+}
+
+func m4MakeRotationZ[T Float](result *Matrix4Of[T], radians T) {
+	s, c := sincos(radians)
+	v4MakeFromElems(&result.col0, c, s, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, -s, c, 0.0, 0.0)
+	v4MakeZAxis(&result.col2)
+	v4MakeWAxis(&result.col3)
+}
+
+func m4MakeRotationZYX[T Float](result *Matrix4Of[T], radiansXYZ *Vector3Of[T]) {
+	sX, cX := sincos(radiansXYZ.X)
+	sY, cY := sincos(radiansXYZ.Y)
+	sZ, cZ := sincos(radiansXYZ.Z)
+	tmp0 := (cZ * sY)
+	tmp1 := (sZ * sY)
+	v4MakeFromElems(&result.col0, (cZ * cY), (sZ * cY), -sY, 0.0)
+	v4MakeFromElems(&result.col1, ((tmp0 * sX) - (sZ * cX)), ((tmp1 * sX) + (cZ * cX)), (cY * sX), 0.0)
+	v4MakeFromElems(&result.col2, ((tmp0 * cX) + (sZ * sX)), ((tmp1 * cX) - (cZ * sX)), (cY * cX), 0.0)
+	v4MakeWAxis(&result.col3)
+}
+
+func m4MakeRotationAxis[T Float](result *Matrix4Of[T], radians T, unitVec *Vector3Of[T]) {
+	s, c := sincos(radians)
+	x := unitVec.X
+	y := unitVec.Y
+	z := unitVec.Z
+	xy := x * y
+	yz := y * z
+	zx := z * x
+	oneMinusC := 1.0 - c
+	v4MakeFromElems(&result.col0, (((x * x) * oneMinusC) + c), ((xy * oneMinusC) + (z * s)), ((zx * oneMinusC) - (y * s)), 0.0)
+	v4MakeFromElems(&result.col1, ((xy * oneMinusC) - (z * s)), (((y * y) * oneMinusC) + c), ((yz * oneMinusC) + (x * s)), 0.0)
+	v4MakeFromElems(&result.col2, ((zx * oneMinusC) + (y * s)), ((yz * oneMinusC) - (x * s)), (((z * z) * oneMinusC) + c), 0.0)
+	v4MakeWAxis(&result.col3)
+}
+
+func m4MakeRotationQ[T Float](result *Matrix4Of[T], unitQuat *QuatOf[T]) {
+	var tmpT3_0 Transform3Of[T]
+	t3MakeRotationQ(&tmpT3_0, unitQuat)
+	m4MakeFromT3(result, &tmpT3_0)
+}
+
+func m4MakeScale[T Float](result *Matrix4Of[T], scaleVec *Vector3Of[T]) {
+	v4MakeFromElems(&result.col0, scaleVec.X, 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, scaleVec.Y, 0.0, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, 0.0, scaleVec.Z, 0.0)
+	v4MakeWAxis(&result.col3)
+}
+
+func m4AppendScale[T Float](result, mat *Matrix4Of[T], scaleVec *Vector3Of[T]) {
+	v4ScalarMul(&result.col0, &mat.col0, scaleVec.X)
+	v4ScalarMul(&result.col1, &mat.col1, scaleVec.Y)
+	v4ScalarMul(&result.col2, &mat.col2, scaleVec.Z)
+	v4Copy(&result.col3, &mat.col3)
+}
+
+func m4PrependScale[T Float](result *Matrix4Of[T], scaleVec *Vector3Of[T], mat *Matrix4Of[T]) {
+	var scale4 Vector4Of[T]
+	v4MakeFromV3Scalar(&scale4, scaleVec, 1.0)
+	v4MulPerElem(&result.col0, &mat.col0, &scale4)
+	v4MulPerElem(&result.col1, &mat.col1, &scale4)
+	v4MulPerElem(&result.col2, &mat.col2, &scale4)
+	v4MulPerElem(&result.col3, &mat.col3, &scale4)
+}
+
+func m4MakeTranslation[T Float](result *Matrix4Of[T], translateVec *Vector3Of[T]) {
+	v4MakeXAxis(&result.col0)
+	v4MakeYAxis(&result.col1)
+	v4MakeZAxis(&result.col2)
+	v4MakeFromV3Scalar(&result.col3, translateVec, 1.0)
+}
+
+func m4MakeLookAt[T Float](result *Matrix4Of[T], eyePos, lookAtPos *Point3Of[T], upVec *Vector3Of[T]) {
+	var m4EyeFrame Matrix4Of[T]
+	var v3X, v3Y, v3Z, tmpV3_0, tmpV3_1 Vector3Of[T]
+	var tmpV4_0, tmpV4_1, tmpV4_2, tmpV4_3 Vector4Of[T]
+	v3Normalize(&v3Y, upVec)
+	p3Sub(&tmpV3_0, eyePos, lookAtPos)
+	v3Normalize(&v3Z, &tmpV3_0)
+	v3Cross(&tmpV3_1, &v3Y, &v3Z)
+	v3Normalize(&v3X, &tmpV3_1)
+	v3Cross(&v3Y, &v3Z, &v3X)
+	v4MakeFromV3(&tmpV4_0, &v3X)
+	v4MakeFromV3(&tmpV4_1, &v3Y)
+	v4MakeFromV3(&tmpV4_2, &v3Z)
+	v4MakeFromP3(&tmpV4_3, eyePos)
+	m4MakeFromCols(&m4EyeFrame, &tmpV4_0, &tmpV4_1, &tmpV4_2, &tmpV4_3)
+	m4OrthoInverse(result, &m4EyeFrame)
+}
+
+func m4MakePerspective[T Float](result *Matrix4Of[T], fovyRadians, aspect, zNear, zFar T) {
+	f := tan(g_PI_OVER_2 - (0.5 * fovyRadians))
+	rangeInv := 1.0 / (zNear - zFar)
+	v4MakeFromElems(&result.col0, (f / aspect), 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, f, 0.0, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, 0.0, ((zNear + zFar) * rangeInv), -1.0)
+	v4MakeFromElems(&result.col3, 0.0, 0.0, (((zNear * zFar) * rangeInv) * 2.0), 0.0)
+}
+
+func m4MakeFrustum[T Float](result *Matrix4Of[T], left, right, bottom, top, zNear, zFar T) {
+	sum_rl := (right + left)
+	sum_tb := (top + bottom)
+	sum_nf := (zNear + zFar)
+	inv_rl := (1.0 / (right - left))
+	inv_tb := (1.0 / (top - bottom))
+	inv_nf := (1.0 / (zNear - zFar))
+	n2 := (zNear + zNear)
+	v4MakeFromElems(&result.col0, (n2 * inv_rl), 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, (n2 * inv_tb), 0.0, 0.0)
+	v4MakeFromElems(&result.col2, (sum_rl * inv_rl), (sum_tb * inv_tb), (sum_nf * inv_nf), -1.0)
+	v4MakeFromElems(&result.col3, 0.0, 0.0, ((n2 * inv_nf) * zFar), 0.0)
+}
+
+func m4MakeOrthographic[T Float](result *Matrix4Of[T], left, right, bottom, top, zNear, zFar T) {
+	sum_rl := (right + left)
+	sum_tb := (top + bottom)
+	sum_nf := (zNear + zFar)
+	inv_rl := (1.0 / (right - left))
+	inv_tb := (1.0 / (top - bottom))
+	inv_nf := (1.0 / (zNear - zFar))
+	v4MakeFromElems(&result.col0, (inv_rl + inv_rl), 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, (inv_tb + inv_tb), 0.0, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, 0.0, (inv_nf + inv_nf), 0.0)
+	v4MakeFromElems(&result.col3, (-sum_rl * inv_rl), (-sum_tb * inv_tb), (sum_nf * inv_nf), 1.0)
+}
+
+func m4Select[T Float](result, mat0, mat1 *Matrix4Of[T], select1 int) {
+	v4Select(&result.col0, &mat0.col0, &mat1.col0, select1)
+	v4Select(&result.col1, &mat0.col1, &mat1.col1, select1)
+	v4Select(&result.col2, &mat0.col2, &mat1.col2, select1)
+	v4Select(&result.col3, &mat0.col3, &mat1.col3, select1)
+}
+
+func (m *Matrix4Of[T]) String() string {
+	var tmp Matrix4Of[T]
+	m4Transpose(&tmp, m)
+	return tmp.col0.String() + tmp.col1.String() + tmp.col2.String() + tmp.col3.String()
+}
+
+/*******/
+
+func t3Copy[T Float](result, tfrm *Transform3Of[T]) {
+	v3Copy(&result.col0, &tfrm.col0)
+	v3Copy(&result.col1, &tfrm.col1)
+	v3Copy(&result.col2, &tfrm.col2)
+	v3Copy(&result.col3, &tfrm.col3)
+}
+
+func t3MakeFromScalar[T Float](result *Transform3Of[T], scalar T) {
+	v3MakeFromScalar(&result.col0, scalar)
+	v3MakeFromScalar(&result.col1, scalar)
+	v3MakeFromScalar(&result.col2, scalar)
+	v3MakeFromScalar(&result.col3, scalar)
+}
+
+func t3MakeFromCols[T Float](result *Transform3Of[T], col0, col1, col2, col3 *Vector3Of[T]) {
+	v3Copy(&result.col0, col0)
+	v3Copy(&result.col1, col1)
+	v3Copy(&result.col2, col2)
+	v3Copy(&result.col3, col3)
+}
+
+func t3MakeFromM3V3[T Float](result *Transform3Of[T], tfrm *Matrix3Of[T], translateVec *Vector3Of[T]) {
+	result.SetUpper3x3(tfrm)
+	result.SetTranslation(translateVec)
+}
+
+func t3MakeFromQV3[T Float](result *Transform3Of[T], unitQuat *QuatOf[T], translateVec *Vector3Of[T]) {
+	var tmpM3_0 Matrix3Of[T]
+	m3MakeFromQ(&tmpM3_0, unitQuat)
+	result.SetUpper3x3(&tmpM3_0)
+	result.SetTranslation(translateVec)
+}
+
+func (t *Transform3Of[T]) SetCol0(col0 *Vector3Of[T]) {
+	v3Copy(&t.col0, col0)
+}
+
+func (t *Transform3Of[T]) SetCol1(col1 *Vector3Of[T]) {
+	v3Copy(&t.col1, col1)
+}
+
+func (t *Transform3Of[T]) SetCol2(col2 *Vector3Of[T]) {
+	v3Copy(&t.col2, col2)
+}
+
+func (t *Transform3Of[T]) SetCol3(col3 *Vector3Of[T]) {
+	v3Copy(&t.col3, col3)
+}
+
+func (t *Transform3Of[T]) SetCol(col int, vec *Vector3Of[T]) {
+	switch col {
+	case 0:
+		v3Copy(&t.col0, vec)
+	case 1:
+		v3Copy(&t.col1, vec)
+	case 2:
+		v3Copy(&t.col2, vec)
+	case 3:
+		v3Copy(&t.col3, vec)
+	}
+}
+
+func (t *Transform3Of[T]) SetRow(row int, vec *Vector4Of[T]) {
+	t.col0.SetElem(row, vec.GetElem(0))
+	t.col1.SetElem(row, vec.GetElem(1))
+	t.col2.SetElem(row, vec.GetElem(2))
+	t.col3.SetElem(row, vec.GetElem(3))
+}
+
+func (t *Transform3Of[T]) SetElem(col, row int, val T) {
+	var tmpV3_0 Vector3Of[T]
+	t3GetCol(&tmpV3_0, t, col)
+	tmpV3_0.SetElem(row, val)
+	t.SetCol(col, &tmpV3_0)
+}
+
+func (t *Transform3Of[T]) GetElem(col, row int) T {
+	var tmpV3_0 Vector3Of[T]
+	t3GetCol(&tmpV3_0, t, col)
+	return tmpV3_0.GetElem(row)
+}
+
+func t3GetCol0[T Float](result *Vector3Of[T], tfrm *Transform3Of[T]) {
+	v3Copy(result, &tfrm.col0)
+}
+
+func t3GetCol1[T Float](result *Vector3Of[T], tfrm *Transform3Of[T]) {
+	v3Copy(result, &tfrm.col1)
+}
+
+func t3GetCol2[T Float](result *Vector3Of[T], tfrm *Transform3Of[T]) {
+	v3Copy(result, &tfrm.col2)
+}
+
+func t3GetCol3[T Float](result *Vector3Of[T], tfrm *Transform3Of[T]) {
+	v3Copy(result, &tfrm.col3)
+}
+
+func t3GetCol[T Float](result *Vector3Of[T], tfrm *Transform3Of[T], col int) {
+	switch col {
+	case 0:
+		v3Copy(result, &tfrm.col0)
+	case 1:
+		v3Copy(result, &tfrm.col1)
+	case 2:
+		v3Copy(result, &tfrm.col2)
+	case 3:
+		v3Copy(result, &tfrm.col3)
+	}
+}
+
+func t3GetRow[T Float](result *Vector4Of[T], tfrm *Transform3Of[T], row int) {
+	v4MakeFromElems(result, tfrm.col0.GetElem(row), tfrm.col1.GetElem(row), tfrm.col2.GetElem(row), tfrm.col3.GetElem(row))
+}
+
+func t3Inverse[T Float](result, tfrm *Transform3Of[T]) {
+	var tmp0, tmp1, tmp2, inv0, inv1, inv2, tmpV3_0, tmpV3_1, tmpV3_2, tmpV3_3, tmpV3_4, tmpV3_5 Vector3Of[T]
+	v3Cross(&tmp0, &tfrm.col1, &tfrm.col2)
+	v3Cross(&tmp1, &tfrm.col2, &tfrm.col0)
+	v3Cross(&tmp2, &tfrm.col0, &tfrm.col1)
+	detinv := (1.0 / v3Dot(&tfrm.col2, &tmp2))
+	v3MakeFromElems(&inv0, (tmp0.X * detinv), (tmp1.X * detinv), (tmp2.X * detinv))
+	v3MakeFromElems(&inv1, (tmp0.Y * detinv), (tmp1.Y * detinv), (tmp2.Y * detinv))
+	v3MakeFromElems(&inv2, (tmp0.Z * detinv), (tmp1.Z * detinv), (tmp2.Z * detinv))
+	v3Copy(&result.col0, &inv0)
+	v3Copy(&result.col1, &inv1)
+	v3Copy(&result.col2, &inv2)
+	v3ScalarMul(&tmpV3_0, &inv0, tfrm.col3.X)
+	v3ScalarMul(&tmpV3_1, &inv1, tfrm.col3.Y)
+	v3ScalarMul(&tmpV3_2, &inv2, tfrm.col3.Z)
+	v3Add(&tmpV3_3, &tmpV3_1, &tmpV3_2)
+	v3Add(&tmpV3_4, &tmpV3_0, &tmpV3_3)
+	v3Neg(&tmpV3_5, &tmpV3_4)
+	v3Copy(&result.col3, &tmpV3_5)
+}
+
+func t3TryInverse[T Float](result, tfrm *Transform3Of[T], epsilon T) bool {
+	var tmpM3_0 Matrix3Of[T]
+	t3GetUpper3x3(&tmpM3_0, tfrm)
+	if !m3IsInvertible(&tmpM3_0, epsilon) {
+		return false
+	}
+	t3Inverse(result, tfrm)
+	return true
+}
+
+func t3OrthoInverse[T Float](result, tfrm *Transform3Of[T]) {
+	var inv0, inv1, inv2, tmpV3_0, tmpV3_1, tmpV3_2, tmpV3_3, tmpV3_4, tmpV3_5 Vector3Of[T]
+	v3MakeFromElems(&inv0, tfrm.col0.X, tfrm.col1.X, tfrm.col2.X)
+	v3MakeFromElems(&inv1, tfrm.col0.Y, tfrm.col1.Y, tfrm.col2.Y)
+	v3MakeFromElems(&inv2, tfrm.col0.Z, tfrm.col1.Z, tfrm.col2.Z)
+	v3Copy(&result.col0, &inv0)
+	v3Copy(&result.col1, &inv1)
+	v3Copy(&result.col2, &inv2)
+	v3ScalarMul(&tmpV3_0, &inv0, tfrm.col3.X)
+	v3ScalarMul(&tmpV3_1, &inv1, tfrm.col3.Y)
+	v3ScalarMul(&tmpV3_2, &inv2, tfrm.col3.Z)
+	v3Add(&tmpV3_3, &tmpV3_1, &tmpV3_2)
+	v3Add(&tmpV3_4, &tmpV3_0, &tmpV3_3)
+	v3Neg(&tmpV3_5, &tmpV3_4)
+	v3Copy(&result.col3, &tmpV3_5)
+}
+
+func t3AbsPerElem[T Float](result, tfrm *Transform3Of[T]) {
+	v3AbsPerElem(&result.col0, &tfrm.col0)
+	v3AbsPerElem(&result.col1, &tfrm.col1)
+	v3AbsPerElem(&result.col2, &tfrm.col2)
+	v3AbsPerElem(&result.col3, &tfrm.col3)
+}
+
+func t3MulV3[T Float](result *Vector3Of[T], tfrm *Transform3Of[T], vec *Vector3Of[T]) {
+	tmpX := ((tfrm.col0.X * vec.X) + (tfrm.col1.X * vec.Y)) + (tfrm.col2.X * vec.Z)
+	tmpY := ((tfrm.col0.Y * vec.X) + (tfrm.col1.Y * vec.Y)) + (tfrm.col2.Y * vec.Z)
+	tmpZ := ((tfrm.col0.Z * vec.X) + (tfrm.col1.Z * vec.Y)) + (tfrm.col2.Z * vec.Z)
+	v3MakeFromElems(result, tmpX, tmpY, tmpZ)
+}
+
+func t3MulP3[T Float](result *Point3Of[T], tfrm *Transform3Of[T], pnt *Point3Of[T]) {
+	tmpX := ((((tfrm.col0.X * pnt.X) + (tfrm.col1.X * pnt.Y)) + (tfrm.col2.X * pnt.Z)) + tfrm.col3.X)
+	tmpY := ((((tfrm.col0.Y * pnt.X) + (tfrm.col1.Y * pnt.Y)) + (tfrm.col2.Y * pnt.Z)) + tfrm.col3.Y)
+	tmpZ := ((((tfrm.col0.Z * pnt.X) + (tfrm.col1.Z * pnt.Y)) + (tfrm.col2.Z * pnt.Z)) + tfrm.col3.Z)
+	p3MakeFromElems(result, tmpX, tmpY, tmpZ)
+}
+
+func t3Mul[T Float](result, tfrm0, tfrm1 *Transform3Of[T]) {
+	var tmpResult Transform3Of[T]
+	var tmpP3_0, tmpP3_1 Point3Of[T]
+	t3MulV3(&tmpResult.col0, tfrm0, &tfrm1.col0)
+	t3MulV3(&tmpResult.col1, tfrm0, &tfrm1.col1)
+	t3MulV3(&tmpResult.col2, tfrm0, &tfrm1.col2)
+	p3MakeFromV3(&tmpP3_0, &tfrm1.col3)
+	t3MulP3(&tmpP3_1, tfrm0, &tmpP3_0)
+	v3MakeFromP3(&tmpResult.col3, &tmpP3_1)
+	t3Copy(result, &tmpResult)
+}
+
+func t3MulPerElem[T Float](result, tfrm0, tfrm1 *Transform3Of[T]) {
+	v3MulPerElem(&result.col0, &tfrm0.col0, &tfrm1.col0)
+	v3MulPerElem(&result.col1, &tfrm0.col1, &tfrm1.col1)
+	v3MulPerElem(&result.col2, &tfrm0.col2, &tfrm1.col2)
+	v3MulPerElem(&result.col3, &tfrm0.col3, &tfrm1.col3)
+}
+
+func t3MakeIdentity[T Float](result *Transform3Of[T]) {
+	v3MakeXAxis(&result.col0)
+	v3MakeYAxis(&result.col1)
+	v3MakeZAxis(&result.col2)
+	v3MakeFromScalar(&result.col3, 0.0)
+}
+
+func (m *Transform3Of[T]) SetUpper3x3(tfrm *Matrix3Of[T]) {
+	v3Copy(&m.col0, &tfrm.col0)
+	v3Copy(&m.col1, &tfrm.col1)
+	v3Copy(&m.col2, &tfrm.col2)
+}
+
+func t3GetUpper3x3[T Float](result *Matrix3Of[T], tfrm *Transform3Of[T]) {
+	m3MakeFromCols(result, &tfrm.col0, &tfrm.col1, &tfrm.col2)
+}
+
+func (t *Transform3Of[T]) SetTranslation(translateVec *Vector3Of[T]) {
+	v3Copy(&t.col3, translateVec)
+}
+
+func t3GetTranslation[T Float](result *Vector3Of[T], tfrm *Transform3Of[T]) {
+	v3Copy(result, &tfrm.col3)
+}
+
+func t3MakeRotationX[T Float](result *Transform3Of[T], radians T) {
+	s, c := sincos(radians)
+	v3MakeXAxis(&result.col0)
+	v3MakeFromElems(&result.col1, 0.0, c, s)
+	v3MakeFromElems(&result.col2, 0.0, -s, c)
+	v3MakeFromScalar(&result.col3, 0.0)
+}
+
+func t3MakeRotationY[T Float](result *Transform3Of[T], radians T) {
+	s, c := sincos(radians)
+	v3MakeFromElems(&result.col0, c, 0.0, -s)
+	v3MakeYAxis(&result.col1)
+	v3MakeFromElems(&result.col2, s, 0.0, c)
+	v3MakeFromScalar(&result.col3, 0.0)
+}
+
+func t3MakeRotationZ[T Float](result *Transform3Of[T], radians T) {
+	s, c := sincos(radians)
+	v3MakeFromElems(&result.col0, c, s, 0.0)
+	v3MakeFromElems(&result.col1, -s, c, 0.0)
+	v3MakeZAxis(&result.col2)
+	v3MakeFromScalar(&result.col3, 0.0)
+}
+
+func t3MakeRotationZYX[T Float](result *Transform3Of[T], radiansXYZ *Vector3Of[T]) {
+	sX, cX := sincos(radiansXYZ.X)
+	sY, cY := sincos(radiansXYZ.Y)
+	sZ, cZ := sincos(radiansXYZ.Z)
+	tmp0 := (cZ * sY)
+	tmp1 := (sZ * sY)
+	v3MakeFromElems(&result.col0, (cZ * cY), (sZ * cY), -sY)
+	v3MakeFromElems(&result.col1, ((tmp0 * sX) - (sZ * cX)), ((tmp1 * sX) + (cZ * cX)), (cY * sX))
+	v3MakeFromElems(&result.col2, ((tmp0 * cX) + (sZ * sX)), ((tmp1 * cX) - (cZ * sX)), (cY * cX))
+	v3MakeFromScalar(&result.col3, 0.0)
+}
+
+func t3MakeRotationAxis[T Float](result *Transform3Of[T], radians T, unitVec *Vector3Of[T]) {
+	var tmpM3_0 Matrix3Of[T]
+	var tmpV3_0 Vector3Of[T]
+	m3MakeRotationAxis(&tmpM3_0, radians, unitVec)
+	v3MakeFromScalar(&tmpV3_0, 0.0)
+	t3MakeFromM3V3(result, &tmpM3_0, &tmpV3_0)
+}
+
+func t3MakeRotationQ[T Float](result *Transform3Of[T], unitQuat *QuatOf[T]) {
+	var tmpM3_0 Matrix3Of[T]
+	var tmpV3_0 Vector3Of[T]
+	m3MakeFromQ(&tmpM3_0, unitQuat)
+	v3MakeFromScalar(&tmpV3_0, 0.0)
+	t3MakeFromM3V3(result, &tmpM3_0, &tmpV3_0)
+}
+
+func t3MakeScale[T Float](result *Transform3Of[T], scaleVec *Vector3Of[T]) {
+	v3MakeFromElems(&result.col0, scaleVec.X, 0.0, 0.0)
+	v3MakeFromElems(&result.col1, 0.0, scaleVec.Y, 0.0)
+	v3MakeFromElems(&result.col2, 0.0, 0.0, scaleVec.Z)
+	v3MakeFromScalar(&result.col3, 0.0)
+}
+
+func t3AppendScale[T Float](result, tfrm *Transform3Of[T], scaleVec *Vector3Of[T]) {
+	v3ScalarMul(&result.col0, &tfrm.col0, scaleVec.X)
+	v3ScalarMul(&result.col1, &tfrm.col1, scaleVec.Y)
+	v3ScalarMul(&result.col2, &tfrm.col2, scaleVec.Z)
+	v3Copy(&result.col3, &tfrm.col3)
+}
+
+func t3PrependScale[T Float](result *Transform3Of[T], scaleVec *Vector3Of[T], tfrm *Transform3Of[T]) {
+	v3MulPerElem(&result.col0, &tfrm.col0, scaleVec)
+	v3MulPerElem(&result.col1, &tfrm.col1, scaleVec)
+	v3MulPerElem(&result.col2, &tfrm.col2, scaleVec)
+	v3MulPerElem(&result.col3, &tfrm.col3, scaleVec)
+}
+
+func t3MakeTranslation[T Float](result *Transform3Of[T], translateVec *Vector3Of[T]) {
+	v3MakeXAxis(&result.col0)
+	v3MakeYAxis(&result.col1)
+	v3MakeZAxis(&result.col2)
+	v3Copy(&result.col3, translateVec)
+}
+
+func t3Select[T Float](result, tfrm0, tfrm1 *Transform3Of[T], select1 int) {
+	v3Select(&result.col0, &tfrm0.col0, &tfrm1.col0, select1)
+	v3Select(&result.col1, &tfrm0.col1, &tfrm1.col1, select1)
+	v3Select(&result.col2, &tfrm0.col2, &tfrm1.col2, select1)
+	v3Select(&result.col3, &tfrm0.col3, &tfrm1.col3, select1)
+}
+
+func (t *Transform3Of[T]) String() string {
+	var tmpV4_0, tmpV4_1, tmpV4_2 Vector4Of[T]
+	t3GetRow(&tmpV4_0, t, 0)
+	t3GetRow(&tmpV4_1, t, 1)
+	t3GetRow(&tmpV4_2, t, 2)
+	return tmpV4_0.String() + tmpV4_1.String() + tmpV4_2.String()
+}
+
+/*******/
+
+func qMakeFromM3[T Float](result *QuatOf[T], tfrm *Matrix3Of[T]) {
+	xx := tfrm.col0.X
+	yx := tfrm.col0.Y
+	zx := tfrm.col0.Z
+	xy := tfrm.col1.X
+	yy := tfrm.col1.Y
+	zy := tfrm.col1.Z
+	xz := tfrm.col2.X
+	yz := tfrm.col2.Y
+	zz := tfrm.col2.Z
+
+	trace := ((xx + yy) + zz)
+
+	negTrace := (trace < 0.0)
+	ZgtX := zz > xx
+	ZgtY := zz > yy
+	YgtX := yy > xx
+	largestXorY := (!ZgtX || !ZgtY) && negTrace
+	largestYorZ := (YgtX || ZgtX) && negTrace
+	largestZorX := (ZgtY || !YgtX) && negTrace
+
+	if largestXorY {
+		zz = -zz
+		xy = -xy
+	}
+	if largestYorZ {
+		xx = -xx
+		yz = -yz
+	}
+	if largestZorX {
+		yy = -yy
+		zx = -zx
+	}
+
+	radicand := (((xx + yy) + zz) + 1.0)
+	scale := (0.5 * (1.0 / sqrt(radicand)))
+
+	tmpx := ((zy - yz) * scale)
+	tmpy := ((xz - zx) * scale)
+	tmpz := ((yx - xy) * scale)
+	tmpw := (radicand * scale)
+	qx := tmpx
+	qy := tmpy
+	qz := tmpz
+	qw := tmpw
+
+	if largestXorY {
+		qx = tmpw
+		qy = tmpz
+		qz = tmpy
+		qw = tmpx
+	}
+	if largestYorZ {
+		tmpx = qx
+		tmpz = qz
+		qx = qy
+		qy = tmpx
+		qz = qw
+		qw = tmpz
+	}
+
+	result.X = qx
+	result.Y = qy
+	result.Z = qz
+	result.W = qw
+}
+
+func v3Outer[T Float](result *Matrix3Of[T], tfrm0, tfrm1 *Vector3Of[T]) {
+	v3ScalarMul(&result.col0, tfrm0, tfrm1.X)
+	v3ScalarMul(&result.col1, tfrm0, tfrm1.Y)
+	v3ScalarMul(&result.col2, tfrm0, tfrm1.Z)
+}
+
+func v4Outer[T Float](result *Matrix4Of[T], tfrm0, tfrm1 *Vector4Of[T]) {
+	v4ScalarMul(&result.col0, tfrm0, tfrm1.X)
+	v4ScalarMul(&result.col1, tfrm0, tfrm1.Y)
+	v4ScalarMul(&result.col2, tfrm0, tfrm1.Z)
+	v4ScalarMul(&result.col3, tfrm0, tfrm1.W)
+}
+
+func v3RowMul[T Float](result *Vector3Of[T], vec *Vector3Of[T], mat *Matrix3Of[T]) {
+	tmpX := (((vec.X * mat.col0.X) + (vec.Y * mat.col0.Y)) + (vec.Z * mat.col0.Z))
+	tmpY := (((vec.X * mat.col1.X) + (vec.Y * mat.col1.Y)) + (vec.Z * mat.col1.Z))
+	tmpZ := (((vec.X * mat.col2.X) + (vec.Y * mat.col2.Y)) + (vec.Z * mat.col2.Z))
+	v3MakeFromElems(result, tmpX, tmpY, tmpZ)
+}
+
+func v3CrossMatrix[T Float](result *Matrix3Of[T], vec *Vector3Of[T]) {
+	v3MakeFromElems(&result.col0, 0.0, vec.Z, -vec.Y)
+	v3MakeFromElems(&result.col1, -vec.Z, 0.0, vec.X)
+	v3MakeFromElems(&result.col2, vec.Y, -vec.X, 0.0)
+}
+
+func v3CrossMatrixMul[T Float](result *Matrix3Of[T], vec *Vector3Of[T], mat *Matrix3Of[T]) {
+	var tmpV3_0, tmpV3_1, tmpV3_2 Vector3Of[T]
+	v3Cross(&tmpV3_0, vec, &mat.col0)
+	v3Cross(&tmpV3_1, vec, &mat.col1)
+	v3Cross(&tmpV3_2, vec, &mat.col2)
+	m3MakeFromCols(result, &tmpV3_0, &tmpV3_1, &tmpV3_2)
+}
+
+/*******/
+
+func m3Convert[T, U Float](result *Matrix3Of[T], mat *Matrix3Of[U]) {
+	v3Convert(&result.col0, &mat.col0)
+	v3Convert(&result.col1, &mat.col1)
+	v3Convert(&result.col2, &mat.col2)
+}
+
+func m4Convert[T, U Float](result *Matrix4Of[T], mat *Matrix4Of[U]) {
+	v4Convert(&result.col0, &mat.col0)
+	v4Convert(&result.col1, &mat.col1)
+	v4Convert(&result.col2, &mat.col2)
+	v4Convert(&result.col3, &mat.col3)
+}
+
+func t3Convert[T, U Float](result *Transform3Of[T], tfrm *Transform3Of[U]) {
+	v3Convert(&result.col0, &tfrm.col0)
+	v3Convert(&result.col1, &tfrm.col1)
+	v3Convert(&result.col2, &tfrm.col2)
+	v3Convert(&result.col3, &tfrm.col3)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type OBB = OBBOf[float32]
+
+func OBBCopy(result, box *OBB) {
+	obbCopy(result, box)
+}
+
+// OBBMakeFromCenterAxesExtents builds a box from its center, a matrix whose
+// columns are its orthonormal local axes, and its half-size along each axis.
+func OBBMakeFromCenterAxesExtents(result *OBB, center *Point3, unitAxes *Matrix3, extents *Vector3) {
+	obbMakeFromCenterAxesExtents(result, center, unitAxes, extents)
+}
+
+// OBBMakeFromAABBT3 sets result to box transformed by tfrm. Any scale in tfrm
+// ends up in the extents, so tfrm must not contain shear.
+func OBBMakeFromAABBT3(result *OBB, box *AABB, tfrm *Transform3) {
+	obbMakeFromAABBT3(result, box, tfrm)
+}
+
+// OBBGetCorners stores the eight corners of box in result. Bit 0, 1 and 2 of
+// the index select the positive side of the first, second and third axis.
+func OBBGetCorners(result *[8]Point3, box *OBB) {
+	obbGetCorners(result, box)
+}
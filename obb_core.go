@@ -0,0 +1,79 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+// OBBOf is an oriented bounding box. Axes holds the box's local x, y and z
+// axes as orthonormal columns, and Extents holds its half-size along each
+// of them.
+type OBBOf[T Float] struct {
+	Center  Point3Of[T]
+	Axes    Matrix3Of[T]
+	Extents Vector3Of[T]
+}
+
+func obbCopy[T Float](result, box *OBBOf[T]) {
+	p3Copy(&result.Center, &box.Center)
+	m3Copy(&result.Axes, &box.Axes)
+	v3Copy(&result.Extents, &box.Extents)
+}
+
+func obbMakeFromCenterAxesExtents[T Float](result *OBBOf[T], center *Point3Of[T], unitAxes *Matrix3Of[T], extents *Vector3Of[T]) {
+	p3Copy(&result.Center, center)
+	m3Copy(&result.Axes, unitAxes)
+	v3Copy(&result.Extents, extents)
+}
+
+// obbMakeFromAABBT3 places box in the space of tfrm. Scale in tfrm is moved
+// into the extents, so tfrm may contain rotation, translation and scale, but
+// not shear.
+func obbMakeFromAABBT3[T Float](result *OBBOf[T], box *AABBOf[T], tfrm *Transform3Of[T]) {
+	var center Point3Of[T]
+	var extents, scale Vector3Of[T]
+	aabbGetCenter(&center, box)
+	aabbGetExtents(&extents, box)
+	t3MulP3(&result.Center, tfrm, &center)
+	v3MakeFromElems(&scale, tfrm.col0.Length(), tfrm.col1.Length(), tfrm.col2.Length())
+	v3Normalize(&result.Axes.col0, &tfrm.col0)
+	v3Normalize(&result.Axes.col1, &tfrm.col1)
+	v3Normalize(&result.Axes.col2, &tfrm.col2)
+	v3MulPerElem(&result.Extents, &extents, &scale)
+}
+
+func obbGetCorners[T Float](result *[8]Point3Of[T], box *OBBOf[T]) {
+	var ax, ay, az Vector3Of[T]
+	v3ScalarMul(&ax, &box.Axes.col0, box.Extents.X)
+	v3ScalarMul(&ay, &box.Axes.col1, box.Extents.Y)
+	v3ScalarMul(&az, &box.Axes.col2, box.Extents.Z)
+	for i := range result {
+		p3Copy(&result[i], &box.Center)
+		if i&1 != 0 {
+			p3AddV3(&result[i], &result[i], &ax)
+		} else {
+			p3SubV3(&result[i], &result[i], &ax)
+		}
+		if i&2 != 0 {
+			p3AddV3(&result[i], &result[i], &ay)
+		} else {
+			p3SubV3(&result[i], &result[i], &ay)
+		}
+		if i&4 != 0 {
+			p3AddV3(&result[i], &result[i], &az)
+		} else {
+			p3SubV3(&result[i], &result[i], &az)
+		}
+	}
+}
+
+func (b *OBBOf[T]) ContainsPoint(pnt *Point3Of[T]) bool {
+	var tmpV3_0, local Vector3Of[T]
+	p3Sub(&tmpV3_0, pnt, &b.Center)
+	v3RowMul(&local, &tmpV3_0, &b.Axes)
+	return abs(local.X) <= b.Extents.X && abs(local.Y) <= b.Extents.Y && abs(local.Z) <= b.Extents.Z
+}
+
+func (b *OBBOf[T]) String() string {
+	return fmt.Sprintf("center ( %f %f %f ) extents ( %f %f %f )\n", b.Center.X, b.Center.Y, b.Center.Z, b.Extents.X, b.Extents.Y, b.Extents.Z) + b.Axes.String()
+}
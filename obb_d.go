@@ -0,0 +1,22 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type OBBd = OBBOf[float64]
+
+func OBBdCopy(result, box *OBBd) {
+	obbCopy(result, box)
+}
+
+func OBBdMakeFromCenterAxesExtents(result *OBBd, center *Point3d, unitAxes *Matrix3d, extents *Vector3d) {
+	obbMakeFromCenterAxesExtents(result, center, unitAxes, extents)
+}
+
+func OBBdMakeFromAABBdT3d(result *OBBd, box *AABBd, tfrm *Transform3d) {
+	obbMakeFromAABBT3(result, box, tfrm)
+}
+
+func OBBdGetCorners(result *[8]Point3d, box *OBBd) {
+	obbGetCorners(result, box)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Plane = PlaneOf[float32]
+
+func PlaneCopy(result, pl *Plane) {
+	planeCopy(result, pl)
+}
+
+// PlaneMakeFromElems sets result to the plane ax + by + cz + d = 0 without
+// normalizing it; use PlaneNormalize if (a, b, c) is not unit length.
+func PlaneMakeFromElems(result *Plane, a, b, c, d float32) {
+	planeMakeFromElems(result, a, b, c, d)
+}
+
+func PlaneMakeFromV4(result *Plane, vec *Vector4) {
+	planeMakeFromV4(result, vec)
+}
+
+func PlaneGetV4(result *Vector4, pl *Plane) {
+	planeGetV4(result, pl)
+}
+
+func PlaneMakeFromPointNormal(result *Plane, pnt *Point3, unitNormal *Vector3) {
+	planeMakeFromPointNormal(result, pnt, unitNormal)
+}
+
+// PlaneMakeFromPoints sets result to the plane through the three points, with
+// its normal facing the side from which they appear counter-clockwise. It
+// returns false and leaves result unchanged if the points are collinear.
+func PlaneMakeFromPoints(result *Plane, pnt0, pnt1, pnt2 *Point3) bool {
+	return planeMakeFromPoints(result, pnt0, pnt1, pnt2)
+}
+
+func PlaneNormalize(result, pl *Plane) {
+	planeNormalize(result, pl)
+}
+
+// PlaneProjectPoint sets result to the point on pl closest to pnt.
+func PlaneProjectPoint(result *Point3, pl *Plane, pnt *Point3) {
+	planeProjectPoint(result, pl, pnt)
+}
+
+// PlaneIntersectLine sets result to the point where the infinite line through
+// pnt0 and pnt1 crosses pl. It returns false and leaves result unchanged if
+// the line is parallel to the plane.
+func PlaneIntersectLine(result *Point3, pl *Plane, pnt0, pnt1 *Point3) bool {
+	return planeIntersectLine(result, pl, pnt0, pnt1)
+}
+
+// PlaneIntersectSegment is PlaneIntersectLine restricted to the segment
+// between pnt0 and pnt1.
+func PlaneIntersectSegment(result *Point3, pl *Plane, pnt0, pnt1 *Point3) bool {
+	return planeIntersectSegment(result, pl, pnt0, pnt1)
+}
+
+// PlaneIntersectPlanes sets result to the single point shared by three
+// planes. It returns false and leaves result unchanged when two of the planes
+// are parallel to within epsilon, using the same test as M3TryInverse on the
+// matrix whose columns are the plane normals.
+func PlaneIntersectPlanes(result *Point3, pl0, pl1, pl2 *Plane, epsilon float32) bool {
+	return planeIntersectPlanes(result, pl0, pl1, pl2, epsilon)
+}
+
+// PlaneTransformM4 sets result to pl transformed by mat, using the inverse
+// transpose of mat so that points transformed by mat stay on the plane. The
+// result is renormalized.
+func PlaneTransformM4(result, pl *Plane, mat *Matrix4) {
+	planeTransformM4(result, pl, mat)
+}
+
+// M4MakeReflection builds the matrix that mirrors points across pl, which
+// must have a unit normal.
+func M4MakeReflection(result *Matrix4, pl *Plane) {
+	m4MakeReflection(result, pl)
+}
+
+// M4MakePlanarShadow builds the matrix that projects geometry onto pl along
+// rays from light. light is homogeneous: w = 0 gives a directional light
+// shining along -xyz, w = 1 a point light at xyz.
+func M4MakePlanarShadow(result *Matrix4, pl *Plane, light *Vector4) {
+	m4MakePlanarShadow(result, pl, light)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+// PlaneOf is the set of points p for which dot(Normal, p) + D = 0. The
+// constructors below always produce a unit Normal, in which case
+// SignedDist is a true distance, positive on the side Normal points to.
+type PlaneOf[T Float] struct {
+	Normal Vector3Of[T]
+	D      T
+}
+
+// PlaneSide is the side of a plane that ClassifyPoint finds a point on.
+type PlaneSide int
+
+const (
+	PlaneBack PlaneSide = iota
+	PlaneOn
+	PlaneFront
+)
+
+func planeCopy[T Float](result, pl *PlaneOf[T]) {
+	v3Copy(&result.Normal, &pl.Normal)
+	result.D = pl.D
+}
+
+func planeMakeFromElems[T Float](result *PlaneOf[T], a, b, c, d T) {
+	v3MakeFromElems(&result.Normal, a, b, c)
+	result.D = d
+}
+
+func planeMakeFromV4[T Float](result *PlaneOf[T], vec *Vector4Of[T]) {
+	v4GetXYZ(&result.Normal, vec)
+	result.D = vec.W
+}
+
+func planeGetV4[T Float](result *Vector4Of[T], pl *PlaneOf[T]) {
+	v4MakeFromV3Scalar(result, &pl.Normal, pl.D)
+}
+
+func planeMakeFromPointNormal[T Float](result *PlaneOf[T], pnt *Point3Of[T], unitNormal *Vector3Of[T]) {
+	v3Copy(&result.Normal, unitNormal)
+	result.D = -pnt.Projection(unitNormal)
+}
+
+func planeMakeFromPoints[T Float](result *PlaneOf[T], pnt0, pnt1, pnt2 *Point3Of[T]) bool {
+	var edge1, edge2, normal Vector3Of[T]
+	p3Sub(&edge1, pnt1, pnt0)
+	p3Sub(&edge2, pnt2, pnt0)
+	v3Cross(&normal, &edge1, &edge2)
+	if normal.LengthSqr() == 0.0 {
+		return false
+	}
+	v3Normalize(&normal, &normal)
+	planeMakeFromPointNormal(result, pnt0, &normal)
+	return true
+}
+
+func planeNormalize[T Float](result, pl *PlaneOf[T]) {
+	lenInv := 1.0 / pl.Normal.Length()
+	v3ScalarMul(&result.Normal, &pl.Normal, lenInv)
+	result.D = pl.D * lenInv
+}
+
+func planeProjectPoint[T Float](result *Point3Of[T], pl *PlaneOf[T], pnt *Point3Of[T]) {
+	var tmpV3_0 Vector3Of[T]
+	v3ScalarMul(&tmpV3_0, &pl.Normal, pl.SignedDist(pnt)/pl.Normal.LengthSqr())
+	p3SubV3(result, pnt, &tmpV3_0)
+}
+
+// planeLineParam returns the parameter t at which pnt0 + t*(pnt1 - pnt0)
+// lies on the plane, or false if the line is parallel to it.
+func planeLineParam[T Float](pl *PlaneOf[T], pnt0, pnt1 *Point3Of[T]) (T, bool) {
+	var dir Vector3Of[T]
+	p3Sub(&dir, pnt1, pnt0)
+	denom := v3Dot(&pl.Normal, &dir)
+	if denom == 0.0 {
+		return 0.0, false
+	}
+	return -pl.SignedDist(pnt0) / denom, true
+}
+
+func planeIntersectLine[T Float](result *Point3Of[T], pl *PlaneOf[T], pnt0, pnt1 *Point3Of[T]) bool {
+	t, ok := planeLineParam(pl, pnt0, pnt1)
+	if !ok {
+		return false
+	}
+	p3Lerp(result, t, pnt0, pnt1)
+	return true
+}
+
+func planeIntersectSegment[T Float](result *Point3Of[T], pl *PlaneOf[T], pnt0, pnt1 *Point3Of[T]) bool {
+	t, ok := planeLineParam(pl, pnt0, pnt1)
+	if !ok || t < 0.0 || t > 1.0 {
+		return false
+	}
+	p3Lerp(result, t, pnt0, pnt1)
+	return true
+}
+
+// planeIntersectPlanes solves for the single point on all three planes.
+// With normals n0, n1, n2 the point is
+//
+//	-(d0 (n1 x n2) + d1 (n2 x n0) + d2 (n0 x n1)) / (n0 . (n1 x n2))
+//
+// and the denominator is the determinant of the matrix whose columns are
+// the normals, so it is tested for singularity the same way as
+// M3TryInverse.
+func planeIntersectPlanes[T Float](result *Point3Of[T], pl0, pl1, pl2 *PlaneOf[T], epsilon T) bool {
+	var normals Matrix3Of[T]
+	var c12, c20, c01, tmpV3_0 Vector3Of[T]
+	m3MakeFromCols(&normals, &pl0.Normal, &pl1.Normal, &pl2.Normal)
+	if !m3IsInvertible(&normals, epsilon) {
+		return false
+	}
+	v3Cross(&c12, &pl1.Normal, &pl2.Normal)
+	v3Cross(&c20, &pl2.Normal, &pl0.Normal)
+	v3Cross(&c01, &pl0.Normal, &pl1.Normal)
+	scale := -1.0 / v3Dot(&pl0.Normal, &c12)
+	v3ScalarMul(&c12, &c12, pl0.D)
+	v3ScalarMul(&c20, &c20, pl1.D)
+	v3ScalarMul(&c01, &c01, pl2.D)
+	v3Add(&tmpV3_0, &c12, &c20)
+	v3Add(&tmpV3_0, &tmpV3_0, &c01)
+	v3ScalarMul(&tmpV3_0, &tmpV3_0, scale)
+	p3MakeFromV3(result, &tmpV3_0)
+	return true
+}
+
+// planeTransformM4 transforms the plane by the inverse transpose of mat,
+// which keeps points transformed by mat on the transformed plane, then
+// renormalizes it.
+func planeTransformM4[T Float](result, pl *PlaneOf[T], mat *Matrix4Of[T]) {
+	var inv Matrix4Of[T]
+	var vec, tmpV4_0 Vector4Of[T]
+	m4Inverse(&inv, mat)
+	planeGetV4(&vec, pl)
+	v4MakeFromElems(&tmpV4_0, v4Dot(&vec, &inv.col0), v4Dot(&vec, &inv.col1), v4Dot(&vec, &inv.col2), v4Dot(&vec, &inv.col3))
+	planeMakeFromV4(result, &tmpV4_0)
+	planeNormalize(result, result)
+}
+
+// m4MakeReflection builds the mirror transform for a plane with a unit
+// normal: I - 2nn^T, followed by a translation of -2dn.
+func m4MakeReflection[T Float](result *Matrix4Of[T], pl *PlaneOf[T]) {
+	var tmpV3_0 Vector3Of[T]
+	n := &pl.Normal
+	v4MakeFromElems(&result.col0, 1.0-2.0*n.X*n.X, -2.0*n.Y*n.X, -2.0*n.Z*n.X, 0.0)
+	v4MakeFromElems(&result.col1, -2.0*n.X*n.Y, 1.0-2.0*n.Y*n.Y, -2.0*n.Z*n.Y, 0.0)
+	v4MakeFromElems(&result.col2, -2.0*n.X*n.Z, -2.0*n.Y*n.Z, 1.0-2.0*n.Z*n.Z, 0.0)
+	v3ScalarMul(&tmpV3_0, n, -2.0*pl.D)
+	v4MakeFromV3Scalar(&result.col3, &tmpV3_0, 1.0)
+}
+
+// m4MakePlanarShadow builds the matrix (P . L) I - L P^T, which flattens
+// geometry onto the plane P along rays from the homogeneous light position
+// L. Use w = 0 in L for a directional light and w = 1 for a point light.
+func m4MakePlanarShadow[T Float](result *Matrix4Of[T], pl *PlaneOf[T], light *Vector4Of[T]) {
+	var p Vector4Of[T]
+	planeGetV4(&p, pl)
+	dot := v4Dot(&p, light)
+	v4ScalarMul(&result.col0, light, -p.X)
+	v4ScalarMul(&result.col1, light, -p.Y)
+	v4ScalarMul(&result.col2, light, -p.Z)
+	v4ScalarMul(&result.col3, light, -p.W)
+	result.col0.X += dot
+	result.col1.Y += dot
+	result.col2.Z += dot
+	result.col3.W += dot
+}
+
+func (pl PlaneOf[T]) SignedDist(pnt *Point3Of[T]) T {
+	return pnt.Projection(&pl.Normal) + pl.D
+}
+
+// ClassifyPoint reports PlaneOn for points within epsilon of the plane,
+// measured by SignedDist, and otherwise the side the point lies on, with
+// PlaneFront on the side Normal points to.
+func (pl PlaneOf[T]) ClassifyPoint(pnt *Point3Of[T], epsilon T) PlaneSide {
+	d := pl.SignedDist(pnt)
+	if d > epsilon {
+		return PlaneFront
+	}
+	if d < -epsilon {
+		return PlaneBack
+	}
+	return PlaneOn
+}
+
+func (pl PlaneOf[T]) String() string {
+	return fmt.Sprintf("( %f %f %f %f )", pl.Normal.X, pl.Normal.Y, pl.Normal.Z, pl.D)
+}
+
+func (s PlaneSide) String() string {
+	switch s {
+	case PlaneBack:
+		return "Back"
+	case PlaneOn:
+		return "On"
+	case PlaneFront:
+		return "Front"
+	}
+	return fmt.Sprintf("PlaneSide(%d)", int(s))
+}
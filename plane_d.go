@@ -0,0 +1,62 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Planed = PlaneOf[float64]
+
+func PlanedCopy(result, pl *Planed) {
+	planeCopy(result, pl)
+}
+
+func PlanedMakeFromElems(result *Planed, a, b, c, d float64) {
+	planeMakeFromElems(result, a, b, c, d)
+}
+
+func PlanedMakeFromV4d(result *Planed, vec *Vector4d) {
+	planeMakeFromV4(result, vec)
+}
+
+func PlanedGetV4d(result *Vector4d, pl *Planed) {
+	planeGetV4(result, pl)
+}
+
+func PlanedMakeFromPointNormal(result *Planed, pnt *Point3d, unitNormal *Vector3d) {
+	planeMakeFromPointNormal(result, pnt, unitNormal)
+}
+
+func PlanedMakeFromPoints(result *Planed, pnt0, pnt1, pnt2 *Point3d) bool {
+	return planeMakeFromPoints(result, pnt0, pnt1, pnt2)
+}
+
+func PlanedNormalize(result, pl *Planed) {
+	planeNormalize(result, pl)
+}
+
+func PlanedProjectPoint(result *Point3d, pl *Planed, pnt *Point3d) {
+	planeProjectPoint(result, pl, pnt)
+}
+
+func PlanedIntersectLine(result *Point3d, pl *Planed, pnt0, pnt1 *Point3d) bool {
+	return planeIntersectLine(result, pl, pnt0, pnt1)
+}
+
+func PlanedIntersectSegment(result *Point3d, pl *Planed, pnt0, pnt1 *Point3d) bool {
+	return planeIntersectSegment(result, pl, pnt0, pnt1)
+}
+
+func PlanedIntersectPlanes(result *Point3d, pl0, pl1, pl2 *Planed, epsilon float64) bool {
+	return planeIntersectPlanes(result, pl0, pl1, pl2, epsilon)
+}
+
+func PlanedTransformM4d(result, pl *Planed, mat *Matrix4d) {
+	planeTransformM4(result, pl, mat)
+}
+
+func M4dMakeReflection(result *Matrix4d, pl *Planed) {
+	m4MakeReflection(result, pl)
+}
+
+func M4dMakePlanarShadow(result *Matrix4d, pl *Planed, light *Vector4d) {
+	m4MakePlanarShadow(result, pl, light)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestPlaneSignedDist(t *testing.T) {
+	var pl Plane
+	var pnt Point3
+	var normal Vector3
+	// The plane x + y = 2, with a unit normal.
+	V3MakeFromElems(&normal, 1.0, 1.0, 0.0)
+	V3Normalize(&normal, &normal)
+	P3MakeFromElems(&pnt, 1.0, 1.0, 7.0)
+	PlaneMakeFromPointNormal(&pl, &pnt, &normal)
+	for _, c := range []struct {
+		pnt  Point3
+		dist float32
+	}{
+		{Point3{X: 2.0, Y: 2.0}, 1.41421356},
+		{Point3{X: 0.0, Y: 0.0, Z: -3.0}, -1.41421356},
+		{Point3{X: 2.0, Y: 0.0, Z: 5.0}, 0.0},
+	} {
+		if got := pl.SignedDist(&c.pnt); !near(got, c.dist, 1e-6) {
+			t.Errorf("%v.SignedDist(%v) = %v, want %v", pl.String(), c.pnt, got, c.dist)
+		}
+	}
+
+	var proj Point3
+	P3MakeFromElems(&pnt, 3.0, 3.0, 1.0)
+	PlaneProjectPoint(&proj, &pl, &pnt)
+	if want := (Point3{X: 1.0, Y: 1.0, Z: 1.0}); !p3Near(&proj, &want, 1e-6) {
+		t.Errorf("PlaneProjectPoint(%v) = %v, want %v", pnt, proj, want)
+	}
+}
+
+func TestPlaneClassifyPoint(t *testing.T) {
+	var pl Plane
+	// The plane z = 1, facing +z.
+	PlaneMakeFromElems(&pl, 0.0, 0.0, 1.0, -1.0)
+	for _, c := range []struct {
+		pnt     Point3
+		epsilon float32
+		want    PlaneSide
+	}{
+		{Point3{X: 5.0, Y: -3.0, Z: 2.0}, 1e-3, PlaneFront},
+		{Point3{Z: -4.0}, 1e-3, PlaneBack},
+		{Point3{X: 100.0, Z: 1.0}, 0.0, PlaneOn},
+		{Point3{Z: 1.0005}, 1e-3, PlaneOn},
+		{Point3{Z: 0.9995}, 1e-3, PlaneOn},
+		{Point3{Z: 1.0005}, 1e-4, PlaneFront},
+		{Point3{Z: 0.9995}, 1e-4, PlaneBack},
+	} {
+		if got := pl.ClassifyPoint(&c.pnt, c.epsilon); got != c.want {
+			t.Errorf("%v.ClassifyPoint(%v, %v) = %v, want %v", pl.String(), c.pnt, c.epsilon, got, c.want)
+		}
+	}
+	if got := PlaneSide(7).String(); got != "PlaneSide(7)" {
+		t.Errorf("PlaneSide(7).String() = %q", got)
+	}
+}
+
+func TestPlaneMakeFromPoints(t *testing.T) {
+	var pl Plane
+	var pnt0, pnt1, pnt2 Point3
+	P3MakeFromElems(&pnt0, 0.0, 0.0, 3.0)
+	P3MakeFromElems(&pnt1, 1.0, 0.0, 3.0)
+	P3MakeFromElems(&pnt2, 0.0, 1.0, 3.0)
+	if !PlaneMakeFromPoints(&pl, &pnt0, &pnt1, &pnt2) {
+		t.Fatalf("PlaneMakeFromPoints failed")
+	}
+	// Counter-clockwise seen from +Z.
+	if want := (Plane{Normal: Vector3{Z: 1.0}, D: -3.0}); pl != want {
+		t.Errorf("PlaneMakeFromPoints = %v, want %v", pl.String(), want.String())
+	}
+	P3MakeFromElems(&pnt2, 2.0, 0.0, 3.0)
+	if PlaneMakeFromPoints(&pl, &pnt0, &pnt1, &pnt2) {
+		t.Errorf("PlaneMakeFromPoints succeeded on collinear points")
+	}
+
+	var vec Vector4
+	PlaneMakeFromElems(&pl, 0.0, 3.0, 4.0, 10.0)
+	PlaneNormalize(&pl, &pl)
+	PlaneGetV4(&vec, &pl)
+	if want := (Vector4{X: 0.0, Y: 0.6, Z: 0.8, W: 2.0}); !v4Near(&vec, &want, 1e-6) {
+		t.Errorf("normalized plane = %v, want %v", vec, want)
+	}
+}
+
+func TestPlaneIntersect(t *testing.T) {
+	var pl, pl1, pl2 Plane
+	var pnt0, pnt1, result Point3
+	PlaneMakeFromElems(&pl, 0.0, 0.0, 1.0, -1.0)
+	P3MakeFromElems(&pnt0, 2.0, 0.0, 3.0)
+	P3MakeFromElems(&pnt1, 2.0, 4.0, 5.0)
+
+	if !PlaneIntersectLine(&result, &pl, &pnt0, &pnt1) {
+		t.Errorf("PlaneIntersectLine missed")
+	} else if want := (Point3{X: 2.0, Y: -4.0, Z: 1.0}); !p3Near(&result, &want, 1e-6) {
+		t.Errorf("PlaneIntersectLine = %v, want %v", result, want)
+	}
+	if PlaneIntersectSegment(&result, &pl, &pnt0, &pnt1) {
+		t.Errorf("PlaneIntersectSegment hit beyond the segment at %v", result)
+	}
+	P3MakeFromElems(&pnt1, 2.0, 4.0, -1.0)
+	if !PlaneIntersectSegment(&result, &pl, &pnt0, &pnt1) {
+		t.Errorf("PlaneIntersectSegment missed")
+	} else if want := (Point3{X: 2.0, Y: 2.0, Z: 1.0}); !p3Near(&result, &want, 1e-6) {
+		t.Errorf("PlaneIntersectSegment = %v, want %v", result, want)
+	}
+	P3MakeFromElems(&pnt1, 5.0, 4.0, 3.0)
+	if PlaneIntersectLine(&result, &pl, &pnt0, &pnt1) {
+		t.Errorf("PlaneIntersectLine hit a parallel line at %v", result)
+	}
+
+	PlaneMakeFromElems(&pl1, 1.0, 0.0, 0.0, 2.0)
+	PlaneMakeFromElems(&pl2, 0.0, 1.0, 0.0, -3.0)
+	if !PlaneIntersectPlanes(&result, &pl, &pl1, &pl2, 1e-6) {
+		t.Errorf("PlaneIntersectPlanes failed")
+	} else if want := (Point3{X: -2.0, Y: 3.0, Z: 1.0}); !p3Near(&result, &want, 1e-6) {
+		t.Errorf("PlaneIntersectPlanes = %v, want %v", result, want)
+	}
+	PlaneMakeFromElems(&pl2, 1.0, 1e-8, 0.0, -3.0)
+	if PlaneIntersectPlanes(&result, &pl, &pl1, &pl2, 1e-6) {
+		t.Errorf("PlaneIntersectPlanes succeeded with nearly parallel planes")
+	}
+}
+
+func TestPlaneTransformM4(t *testing.T) {
+	var pl, moved Plane
+	var mat Matrix4
+	var scale, translation Vector3
+	var vec Vector4
+	var movedPnt Point3
+	PlaneMakeFromElems(&pl, 0.6, 0.8, 0.0, -2.0)
+	V3MakeFromElems(&scale, 2.0, 0.5, 3.0)
+	V3MakeFromElems(&translation, 1.0, 2.0, 3.0)
+	M4MakeRotationZ(&mat, 0.7)
+	M4AppendScale(&mat, &mat, &scale)
+	mat.SetTranslation(&translation)
+	PlaneTransformM4(&moved, &pl, &mat)
+	if !near(moved.Normal.Length(), 1.0, 1e-6) {
+		t.Errorf("PlaneTransformM4 normal %v is not unit length", moved.Normal)
+	}
+	// Points on the plane stay on it, and the sides are preserved.
+	for _, c := range []struct {
+		pnt  Point3
+		side float32
+	}{
+		{Point3{X: 2.0, Y: 1.0, Z: 5.0}, 0.0},
+		{Point3{X: -2.0, Y: 4.0, Z: -1.0}, 0.0},
+		{Point3{X: 3.0, Y: 3.0}, 1.0},
+		{Point3{X: -3.0, Y: 0.0}, -1.0},
+	} {
+		M4MulP3(&vec, &mat, &c.pnt)
+		P3MakeFromElems(&movedPnt, vec.X, vec.Y, vec.Z)
+		d := moved.SignedDist(&movedPnt)
+		if c.side == 0.0 && !near(d, 0.0, 1e-5) || c.side*d < 0.0 {
+			t.Errorf("%v moved to %v, at distance %v from %v", c.pnt, movedPnt, d, moved.String())
+		}
+	}
+}
+
+func TestM4MakeReflection(t *testing.T) {
+	var pl Plane
+	var mat Matrix4
+	var pnt Point3
+	var mirrored, twice Vector4
+	PlaneMakeFromElems(&pl, 0.0, 1.0, 0.0, -1.0)
+	M4MakeReflection(&mat, &pl)
+	P3MakeFromElems(&pnt, 3.0, 4.0, 5.0)
+	M4MulP3(&mirrored, &mat, &pnt)
+	if want := (Vector4{X: 3.0, Y: -2.0, Z: 5.0, W: 1.0}); !v4Near(&mirrored, &want, 1e-6) {
+		t.Errorf("reflection of %v = %v, want %v", pnt, mirrored, want)
+	}
+	M4MulV4(&twice, &mat, &mirrored)
+	if want := (Vector4{X: 3.0, Y: 4.0, Z: 5.0, W: 1.0}); !v4Near(&twice, &want, 1e-6) {
+		t.Errorf("double reflection of %v = %v", pnt, twice)
+	}
+}
+
+func TestM4MakePlanarShadow(t *testing.T) {
+	var pl Plane
+	var mat Matrix4
+	var light, shadow Vector4
+	var pnt Point3
+	PlaneMakeFromElems(&pl, 0.0, 1.0, 0.0, 0.0)
+
+	// A point light at (1, 4, 0) halves the distance to the point below it.
+	V4MakeFromElems(&light, 1.0, 4.0, 0.0, 1.0)
+	P3MakeFromElems(&pnt, 2.0, 2.0, 0.0)
+	M4MakePlanarShadow(&mat, &pl, &light)
+	M4MulP3(&shadow, &mat, &pnt)
+	V4ScalarDiv(&shadow, &shadow, shadow.W)
+	if want := (Vector4{X: 3.0, Y: 0.0, Z: 0.0, W: 1.0}); !v4Near(&shadow, &want, 1e-6) {
+		t.Errorf("point light shadow of %v = %v, want %v", pnt, shadow, want)
+	}
+
+	// A directional light shining straight down.
+	V4MakeFromElems(&light, 0.0, 1.0, 0.0, 0.0)
+	M4MakePlanarShadow(&mat, &pl, &light)
+	M4MulP3(&shadow, &mat, &pnt)
+	V4ScalarDiv(&shadow, &shadow, shadow.W)
+	if want := (Vector4{X: 2.0, Y: 0.0, Z: 0.0, W: 1.0}); !v4Near(&shadow, &want, 1e-6) {
+		t.Errorf("directional shadow of %v = %v, want %v", pnt, shadow, want)
+	}
+}
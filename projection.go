@@ -0,0 +1,23 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// M4MakePerspectiveClip is M4MakePerspective for the conventions given by
+// clip. zFar may be +Inf for a projection with no far plane, which is best
+// combined with ClipReverseZ.
+func M4MakePerspectiveClip(result *Matrix4, fovyRadians, aspect, zNear, zFar float32, clip ClipSpace) {
+	m4MakePerspectiveClip(result, fovyRadians, aspect, zNear, zFar, clip)
+}
+
+// M4MakeFrustumClip is M4MakeFrustum for the conventions given by clip. As
+// with M4MakePerspectiveClip, zFar may be +Inf.
+func M4MakeFrustumClip(result *Matrix4, left, right, bottom, top, zNear, zFar float32, clip ClipSpace) {
+	m4MakeFrustumClip(result, left, right, bottom, top, zNear, zFar, clip)
+}
+
+// M4MakeOrthographicClip is M4MakeOrthographic for the conventions given by
+// clip. zFar must be finite.
+func M4MakeOrthographicClip(result *Matrix4, left, right, bottom, top, zNear, zFar float32, clip ClipSpace) {
+	m4MakeOrthographicClip(result, left, right, bottom, top, zNear, zFar, clip)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "math"
+
+// ClipSpace describes the conventions a projection matrix is built for. The
+// zero value is OpenGL's: a right-handed view space looking down -Z, and
+// -w <= x, y, z <= w in clip space with the near plane at z = -w. The flags
+// can be combined freely.
+type ClipSpace uint32
+
+const (
+	// ClipDepthZeroToOne maps depth to 0 <= z <= w, as in Direct3D, Metal
+	// and Vulkan.
+	ClipDepthZeroToOne ClipSpace = 1 << iota
+	// ClipReverseZ swaps the depths of the near and far planes. Combined
+	// with ClipDepthZeroToOne and a floating-point depth buffer it spreads
+	// precision far more evenly over the view distance.
+	ClipReverseZ
+	// ClipLeftHanded takes view space to look down +Z instead of -Z.
+	ClipLeftHanded
+	// ClipFlipY negates y in clip space, so that +Y is down on screen, as in
+	// Vulkan.
+	ClipFlipY
+)
+
+const (
+	ClipOpenGL   ClipSpace = 0
+	ClipDirect3D ClipSpace = ClipDepthZeroToOne
+	ClipMetal    ClipSpace = ClipDepthZeroToOne
+	ClipVulkan   ClipSpace = ClipDepthZeroToOne | ClipFlipY
+)
+
+// clipDepthRange returns the clip-space depths, divided by w, of the near
+// and far planes.
+func clipDepthRange[T Float](clip ClipSpace) (T, T) {
+	zn, zf := T(-1.0), T(1.0)
+	if clip&ClipDepthZeroToOne != 0 {
+		zn = 0.0
+	}
+	if clip&ClipReverseZ != 0 {
+		zn, zf = zf, zn
+	}
+	return zn, zf
+}
+
+// m4ApplyClipSpace adjusts a projection built for a right-handed view space
+// and an unflipped Y. Viewing down +Z instead of -Z is the same as negating
+// view-space z before projecting, i.e. negating the third column.
+func m4ApplyClipSpace[T Float](result *Matrix4Of[T], clip ClipSpace) {
+	if clip&ClipLeftHanded != 0 {
+		v4Neg(&result.col2, &result.col2)
+	}
+	if clip&ClipFlipY != 0 {
+		result.col0.Y = -result.col0.Y
+		result.col1.Y = -result.col1.Y
+		result.col2.Y = -result.col2.Y
+		result.col3.Y = -result.col3.Y
+	}
+}
+
+// m4PerspectiveDepth returns the third-row elements a and b of a
+// perspective projection, for which depth d = -z is mapped to
+// (a*z + b) / d, i.e. -a + b/d, which takes the values zn at zNear and zf at
+// zFar. An infinite zFar is the limit as zFar grows.
+func m4PerspectiveDepth[T Float](zNear, zFar T, clip ClipSpace) (T, T) {
+	zn, zf := clipDepthRange[T](clip)
+	if math.IsInf(float64(zFar), 1) {
+		return -zf, (zn - zf) * zNear
+	}
+	rangeInv := 1.0 / (zFar - zNear)
+	b := (zn - zf) * zNear * zFar * rangeInv
+	a := (zn-zf)*zNear*rangeInv - zf
+	return a, b
+}
+
+func m4MakePerspectiveClip[T Float](result *Matrix4Of[T], fovyRadians, aspect, zNear, zFar T, clip ClipSpace) {
+	f := tan(g_PI_OVER_2 - (0.5 * fovyRadians))
+	a, b := m4PerspectiveDepth(zNear, zFar, clip)
+	v4MakeFromElems(&result.col0, (f / aspect), 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, f, 0.0, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, 0.0, a, -1.0)
+	v4MakeFromElems(&result.col3, 0.0, 0.0, b, 0.0)
+	m4ApplyClipSpace(result, clip)
+}
+
+func m4MakeFrustumClip[T Float](result *Matrix4Of[T], left, right, bottom, top, zNear, zFar T, clip ClipSpace) {
+	sum_rl := (right + left)
+	sum_tb := (top + bottom)
+	inv_rl := (1.0 / (right - left))
+	inv_tb := (1.0 / (top - bottom))
+	n2 := (zNear + zNear)
+	a, b := m4PerspectiveDepth(zNear, zFar, clip)
+	v4MakeFromElems(&result.col0, (n2 * inv_rl), 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, (n2 * inv_tb), 0.0, 0.0)
+	v4MakeFromElems(&result.col2, (sum_rl * inv_rl), (sum_tb * inv_tb), a, -1.0)
+	v4MakeFromElems(&result.col3, 0.0, 0.0, b, 0.0)
+	m4ApplyClipSpace(result, clip)
+}
+
+func m4MakeOrthographicClip[T Float](result *Matrix4Of[T], left, right, bottom, top, zNear, zFar T, clip ClipSpace) {
+	sum_rl := (right + left)
+	sum_tb := (top + bottom)
+	inv_rl := (1.0 / (right - left))
+	inv_tb := (1.0 / (top - bottom))
+	zn, zf := clipDepthRange[T](clip)
+	// Depth d = -z is mapped linearly to zn at zNear and zf at zFar.
+	a := (zn - zf) / (zFar - zNear)
+	b := zn + a*zNear
+	v4MakeFromElems(&result.col0, (inv_rl + inv_rl), 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, (inv_tb + inv_tb), 0.0, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, 0.0, a, 0.0)
+	v4MakeFromElems(&result.col3, (-sum_rl * inv_rl), (-sum_tb * inv_tb), b, 1.0)
+	m4ApplyClipSpace(result, clip)
+}
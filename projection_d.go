@@ -0,0 +1,16 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func M4dMakePerspectiveClip(result *Matrix4d, fovyRadians, aspect, zNear, zFar float64, clip ClipSpace) {
+	m4MakePerspectiveClip(result, fovyRadians, aspect, zNear, zFar, clip)
+}
+
+func M4dMakeFrustumClip(result *Matrix4d, left, right, bottom, top, zNear, zFar float64, clip ClipSpace) {
+	m4MakeFrustumClip(result, left, right, bottom, top, zNear, zFar, clip)
+}
+
+func M4dMakeOrthographicClip(result *Matrix4d, left, right, bottom, top, zNear, zFar float64, clip ClipSpace) {
+	m4MakeOrthographicClip(result, left, right, bottom, top, zNear, zFar, clip)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"testing"
+)
+
+// allClipSpaces returns every combination of the ClipSpace flags.
+func allClipSpaces() []ClipSpace {
+	clips := make([]ClipSpace, 16)
+	for i := range clips {
+		clips[i] = ClipSpace(i)
+	}
+	return clips
+}
+
+func TestProjectionClipOpenGLMatchesLegacy(t *testing.T) {
+	var got, want Matrix4
+	M4MakePerspectiveClip(&got, 1.1, 1.5, 0.25, 300.0, ClipOpenGL)
+	M4MakePerspective(&want, 1.1, 1.5, 0.25, 300.0)
+	if !m4Near(&got, &want, 1e-6) {
+		t.Errorf("M4MakePerspectiveClip(ClipOpenGL) = %v, want %v", got.String(), want.String())
+	}
+	M4MakeFrustumClip(&got, -1.0, 2.0, -0.5, 1.5, 0.5, 40.0, ClipOpenGL)
+	M4MakeFrustum(&want, -1.0, 2.0, -0.5, 1.5, 0.5, 40.0)
+	if !m4Near(&got, &want, 1e-6) {
+		t.Errorf("M4MakeFrustumClip(ClipOpenGL) = %v, want %v", got.String(), want.String())
+	}
+	M4MakeOrthographicClip(&got, -4.0, 2.0, -1.0, 3.0, 0.5, 40.0, ClipOpenGL)
+	M4MakeOrthographic(&want, -4.0, 2.0, -1.0, 3.0, 0.5, 40.0)
+	if !m4Near(&got, &want, 1e-6) {
+		t.Errorf("M4MakeOrthographicClip(ClipOpenGL) = %v, want %v", got.String(), want.String())
+	}
+}
+
+// projectNDC projects the view-space point at depth d along the view
+// direction, with the given x and y, and divides by w.
+func projectNDC(mat *Matrix4, x, y, d float32, clip ClipSpace) Vector3 {
+	var pnt Point3
+	var clipPos Vector4
+	var ndc Vector3
+	z := -d
+	if clip&ClipLeftHanded != 0 {
+		z = d
+	}
+	P3MakeFromElems(&pnt, x, y, z)
+	M4MulP3(&clipPos, mat, &pnt)
+	V3MakeFromElems(&ndc, clipPos.X/clipPos.W, clipPos.Y/clipPos.W, clipPos.Z/clipPos.W)
+	return ndc
+}
+
+func TestProjectionClipDepth(t *testing.T) {
+	const zNear, zFar = 0.5, 50.0
+	var mat Matrix4
+	for _, clip := range allClipSpaces() {
+		zn, zf := clipDepthRange[float32](clip)
+		wantY := float32(1.0)
+		if clip&ClipFlipY != 0 {
+			wantY = -1.0
+		}
+
+		// The top-right corner of the near and far planes, where the
+		// perspective frustum spans [-2, 2] x [-1, 1] at unit depth.
+		M4MakeFrustumClip(&mat, -2.0*zNear, 2.0*zNear, -zNear, zNear, zNear, zFar, clip)
+		for _, c := range []struct {
+			d, wantZ float32
+		}{{zNear, zn}, {zFar, zf}} {
+			got := projectNDC(&mat, 2.0*c.d, c.d, c.d, clip)
+			if !near(got.X, 1.0, 1e-5) || !near(got.Y, wantY, 1e-5) || !near(got.Z, c.wantZ, 1e-5) {
+				t.Errorf("clip %#x: frustum corner at depth %v = %v, want (1, %v, %v)", clip, c.d, got, wantY, c.wantZ)
+			}
+		}
+		// Depth increases monotonically in the direction of zf.
+		mid := projectNDC(&mat, 0.0, 0.0, 5.0, clip)
+		if (mid.Z-zn)*(zf-zn) <= 0.0 || (zf-mid.Z)*(zf-zn) <= 0.0 {
+			t.Errorf("clip %#x: depth 5 maps to %v, not between %v and %v", clip, mid.Z, zn, zf)
+		}
+
+		M4MakeOrthographicClip(&mat, -3.0, 1.0, -2.0, 2.0, zNear, zFar, clip)
+		for _, c := range []struct {
+			d, wantZ float32
+		}{{zNear, zn}, {zFar, zf}, {0.5 * (zNear + zFar), 0.5 * (zn + zf)}} {
+			got := projectNDC(&mat, 1.0, 2.0, c.d, clip)
+			if !near(got.X, 1.0, 1e-5) || !near(got.Y, wantY, 1e-5) || !near(got.Z, c.wantZ, 1e-5) {
+				t.Errorf("clip %#x: orthographic corner at depth %v = %v, want (1, %v, %v)", clip, c.d, got, wantY, c.wantZ)
+			}
+		}
+	}
+}
+
+func TestProjectionInfiniteFar(t *testing.T) {
+	inf := float32(math.Inf(1))
+	var infMat, farMat Matrix4
+	for _, clip := range allClipSpaces() {
+		zn, zf := clipDepthRange[float32](clip)
+		M4MakePerspectiveClip(&infMat, 1.0, 1.5, 0.1, inf, clip)
+		if got := projectNDC(&infMat, 0.0, 0.0, 0.1, clip); !near(got.Z, zn, 1e-6) {
+			t.Errorf("clip %#x: the near plane maps to %v, want %v", clip, got.Z, zn)
+		}
+		if got := projectNDC(&infMat, 0.0, 0.0, 1e6, clip); !near(got.Z, zf, 1e-5) || got.Z*(zf-zn) > zf*(zf-zn) {
+			t.Errorf("clip %#x: depth 1e6 maps to %v, want just short of %v", clip, got.Z, zf)
+		}
+		// The infinite projection is the limit of ever farther planes.
+		M4MakePerspectiveClip(&farMat, 1.0, 1.5, 0.1, 1e7, clip)
+		if !m4Near(&infMat, &farMat, 1e-6) {
+			t.Errorf("clip %#x: infinite projection %v, far plane at 1e7 gives %v", clip, infMat.String(), farMat.String())
+		}
+	}
+
+	M4MakeFrustumClip(&infMat, -1.0, 1.0, -1.0, 1.0, 1.0, inf, ClipVulkan|ClipReverseZ)
+	M4MakeFrustumClip(&farMat, -1.0, 1.0, -1.0, 1.0, 1.0, 1e7, ClipVulkan|ClipReverseZ)
+	if !m4Near(&infMat, &farMat, 1e-6) {
+		t.Errorf("infinite frustum %v, far plane at 1e7 gives %v", infMat.String(), farMat.String())
+	}
+}
+
+// makeTestViewProj returns projection * view for a camera at eye looking at
+// target, with Y up and a perspective projection built for clip. A
+// left-handed projection gets a view matrix with Z negated, so that the
+// camera still looks at target.
+func makeTestViewProj(eye, target Point3, fovy, aspect, zNear, zFar float32, clip ClipSpace) Matrix4 {
+	var up, flipZ Vector3
+	var view, proj, viewProj, flip Matrix4
+	V3MakeYAxis(&up)
+	M4MakeLookAt(&view, &eye, &target, &up)
+	if clip&ClipLeftHanded != 0 {
+		V3MakeFromElems(&flipZ, 1.0, 1.0, -1.0)
+		M4MakeScale(&flip, &flipZ)
+		M4Mul(&view, &flip, &view)
+	}
+	M4MakePerspectiveClip(&proj, fovy, aspect, zNear, zFar, clip)
+	M4Mul(&viewProj, &proj, &view)
+	return viewProj
+}
+
+func TestFrustumMakeFromM4Clip(t *testing.T) {
+	want := makeTestFrustum()
+	for _, clip := range allClipSpaces() {
+		var fr Frustum
+		// The camera of makeTestFrustum, for each clip space.
+		viewProj := makeTestViewProj(Point3{Z: 10.0}, Point3{}, g_PI_OVER_2, 1.0, 1.0, 10.0, clip)
+		FrustumMakeFromM4Clip(&fr, &viewProj, clip)
+		for i := range fr.Planes {
+			got, w := &fr.Planes[i], &want.Planes[i]
+			if !v3Near(&got.Normal, &w.Normal, 1e-5) || !near(got.D, w.D, 1e-4) {
+				t.Errorf("clip %#x: plane %d = %v, want %v", clip, i, got.String(), w.String())
+			}
+		}
+	}
+}
+
+func TestFrustumInfiniteFar(t *testing.T) {
+	inf := float32(math.Inf(1))
+	for _, clip := range []ClipSpace{ClipOpenGL, ClipDirect3D | ClipReverseZ, ClipVulkan | ClipReverseZ | ClipLeftHanded} {
+		var fr Frustum
+		viewProj := makeTestViewProj(Point3{Z: 10.0}, Point3{}, g_PI_OVER_2, 1.0, 1.0, inf, clip)
+		FrustumMakeFromM4Clip(&fr, &viewProj, clip)
+		far := &fr.Planes[FrustumFar]
+		if far.Normal != (Vector3{}) || !math.IsInf(float64(far.D), 1) {
+			t.Errorf("clip %#x: far plane = %v, want a zero normal and infinite D", clip, far.String())
+		}
+		for _, c := range []struct {
+			pnt  Point3
+			want CullResult
+		}{
+			{Point3{Z: 5.0}, CullInside},
+			{Point3{Z: -1e5}, CullInside},
+			{Point3{X: 1e5 + 20.0, Z: -1e5}, CullOutside},
+			{Point3{Z: 9.5}, CullOutside},
+		} {
+			if got := fr.ClassifyPoint(&c.pnt); got != c.want {
+				t.Errorf("clip %#x: ClassifyPoint(%v) = %v, want %v", clip, c.pnt, got, c.want)
+			}
+		}
+		center := Point3{Z: -1e5}
+		if got := fr.ClassifySphere(&center, 10.0); got != CullInside {
+			t.Errorf("clip %#x: a distant sphere is %v, want Inside", clip, got)
+		}
+
+		// The near corners are those of the finite frustum; the far planes
+		// do not meet, so the far corners are NaN rather than stale data.
+		var finite Frustum
+		var corners, finiteCorners [8]Point3
+		for i := range corners {
+			corners[i] = Point3{X: 7.0, Y: 7.0, Z: 7.0}
+		}
+		finiteViewProj := makeTestViewProj(Point3{Z: 10.0}, Point3{}, g_PI_OVER_2, 1.0, 1.0, 10.0, clip)
+		FrustumMakeFromM4Clip(&finite, &finiteViewProj, clip)
+		FrustumGetCorners(&corners, &fr)
+		FrustumGetCorners(&finiteCorners, &finite)
+		for i := 0; i < 4; i++ {
+			if !p3Near(&corners[i], &finiteCorners[i], 1e-4) {
+				t.Errorf("clip %#x: near corner %d = %v, want %v", clip, i, corners[i], finiteCorners[i])
+			}
+		}
+		for i := 4; i < 8; i++ {
+			c := corners[i]
+			if !math.IsNaN(float64(c.X)) || !math.IsNaN(float64(c.Y)) || !math.IsNaN(float64(c.Z)) {
+				t.Errorf("clip %#x: far corner %d = %v, want NaN", clip, i, c)
+			}
+		}
+	}
+}
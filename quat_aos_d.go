@@ -0,0 +1,139 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func QdCopy(result, quat *Quatd) {
+	qCopy(result, quat)
+}
+
+func QdMakeFromElems(result *Quatd, x, y, z, w float64) {
+	qMakeFromElems(result, x, y, z, w)
+}
+
+func QdMakeFromV3dScalar(result *Quatd, xyz *Vector3d, w float64) {
+	qMakeFromV3Scalar(result, xyz, w)
+}
+
+func QdMakeFromV4d(result *Quatd, vec *Vector4d) {
+	qMakeFromV4(result, vec)
+}
+
+func QdMakeFromScalar(result *Quatd, scalar float64) {
+	qMakeFromScalar(result, scalar)
+}
+
+func QdMakeIdentity(result *Quatd) {
+	qMakeIdentity(result)
+}
+
+func QdLerp(result *Quatd, t float64, quat0, quat1 *Quatd) {
+	qLerp(result, t, quat0, quat1)
+}
+
+func QdSlerp(result *Quatd, t float64, unitQuat0, unitQuat1 *Quatd) {
+	qSlerp(result, t, unitQuat0, unitQuat1)
+}
+
+func QdSquad(result *Quatd, t float64, unitQuat0, unitQuat1, unitQuat2, unitQuat3 *Quatd) {
+	qSquad(result, t, unitQuat0, unitQuat1, unitQuat2, unitQuat3)
+}
+
+func QdAdd(result, quat0, quat1 *Quatd) {
+	qAdd(result, quat0, quat1)
+}
+
+func QdSub(result, quat0, quat1 *Quatd) {
+	qSub(result, quat0, quat1)
+}
+
+func QdScalarMul(result, quat *Quatd, scalar float64) {
+	qScalarMul(result, quat, scalar)
+}
+
+func QdScalarDiv(result, quat *Quatd, scalar float64) {
+	qScalarDiv(result, quat, scalar)
+}
+
+func QdNeg(result, quat *Quatd) {
+	qNeg(result, quat)
+}
+
+func QdDot(quat0, quat1 *Quatd) float64 {
+	return qDot(quat0, quat1)
+}
+
+func QdNormalize(result, quat *Quatd) {
+	qNormalize(result, quat)
+}
+
+func QdMakeRotationArc(result *Quatd, unitVec0, unitVec1 *Vector3d) {
+	qMakeRotationArc(result, unitVec0, unitVec1)
+}
+
+func QdMakeLookRotation(result *Quatd, forward, up *Vector3d) {
+	qMakeLookRotation(result, forward, up)
+}
+
+func QdMakeRotationAxis(result *Quatd, radians float64, unitVec *Vector3d) {
+	qMakeRotationAxis(result, radians, unitVec)
+}
+
+func QdMakeRotationX(result *Quatd, radians float64) {
+	qMakeRotationX(result, radians)
+}
+
+func QdMakeRotationY(result *Quatd, radians float64) {
+	qMakeRotationY(result, radians)
+}
+
+func QdMakeRotationZ(result *Quatd, radians float64) {
+	qMakeRotationZ(result, radians)
+}
+
+func QdMul(result, quat0, quat1 *Quatd) {
+	qMul(result, quat0, quat1)
+}
+
+func QdRotate(result *Vector3d, quat *Quatd, vec *Vector3d) {
+	qRotate(result, quat, vec)
+}
+
+func QdConj(result, quat *Quatd) {
+	qConj(result, quat)
+}
+
+func QdInverse(result, quat *Quatd) {
+	qInverse(result, quat)
+}
+
+func QdLog(result, quat *Quatd) {
+	qLog(result, quat)
+}
+
+func QdExp(result, quat *Quatd) {
+	qExp(result, quat)
+}
+
+func QdPow(result, quat *Quatd, t float64) {
+	qPow(result, quat, t)
+}
+
+func QdGetAxisAngle(result *Vector3d, unitQuat *Quatd) float64 {
+	return qGetAxisAngle(result, unitQuat)
+}
+
+func QdSelect(result, quat0, quat1 *Quatd, select1 int) {
+	qSelect(result, quat0, quat1, select1)
+}
+
+/*******/
+
+func QdMakeFromQ(result *Quatd, quat *Quat) {
+	qConvert(result, quat)
+}
+
+func QMakeFromQd(result *Quat, quat *Quatd) {
+	qConvert(result, quat)
+}
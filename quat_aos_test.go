@@ -0,0 +1,214 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQLogExp(t *testing.T) {
+	var axis Vector3
+	var quat, logQ, back Quat
+	V3MakeFromElems(&axis, 2.0, 3.0, -6.0)
+	V3Normalize(&axis, &axis)
+	for _, radians := range []float32{0.0, 0.1, 1.5, 3.0, -2.0} {
+		QMakeRotationAxis(&quat, radians, &axis)
+		QLog(&logQ, &quat)
+		want := Quat{X: axis.X * radians / 2.0, Y: axis.Y * radians / 2.0, Z: axis.Z * radians / 2.0}
+		if !qNear(&logQ, &want, 1e-6) {
+			t.Errorf("QLog(rotation by %v) = %v, want %v", radians, logQ, want)
+		}
+		QExp(&back, &logQ)
+		if !qNear(&back, &quat, 1e-6) {
+			t.Errorf("QExp(QLog(%v)) = %v", quat, back)
+		}
+	}
+
+	// For a non-unit quaternion the scalar part carries log |quat|.
+	QMakeRotationAxis(&quat, 0.8, &axis)
+	QScalarMul(&quat, &quat, 4.0)
+	QLog(&logQ, &quat)
+	if !near(logQ.W, float32(math.Log(4.0)), 1e-6) {
+		t.Errorf("QLog(4 * unit).W = %v, want log 4", logQ.W)
+	}
+	QExp(&back, &logQ)
+	if !qNear(&back, &quat, 1e-5) {
+		t.Errorf("QExp(QLog(%v)) = %v", quat, back)
+	}
+}
+
+func TestQPow(t *testing.T) {
+	var axis Vector3
+	var quat, got, want Quat
+	V3MakeFromElems(&axis, 0.0, 0.6, 0.8)
+	QMakeRotationAxis(&quat, 1.2, &axis)
+	for _, power := range []float32{0.0, 0.5, 1.0, 2.0, -1.0} {
+		QPow(&got, &quat, power)
+		QMakeRotationAxis(&want, 1.2*power, &axis)
+		if !qNear(&got, &want, 1e-6) {
+			t.Errorf("QPow(rotation by 1.2, %v) = %v, want %v", power, got, want)
+		}
+	}
+	QPow(&got, &quat, 0.5)
+	QMul(&got, &got, &got)
+	if !qNear(&got, &quat, 1e-6) {
+		t.Errorf("QPow(quat, 0.5) squared = %v, want %v", got, quat)
+	}
+}
+
+func TestQLogNegativeIdentity(t *testing.T) {
+	// -1 is a rotation by 2pi about any axis; QLog picks X.
+	var logQ, back, got Quat
+	negIdent := Quat{W: -1.0}
+	QLog(&logQ, &negIdent)
+	if want := (Quat{X: g_PI}); !qNear(&logQ, &want, 1e-6) {
+		t.Errorf("QLog(%v) = %v, want %v", negIdent, logQ, want)
+	}
+	QExp(&back, &logQ)
+	if !qNear(&back, &negIdent, 1e-6) {
+		t.Errorf("QExp(QLog(%v)) = %v", negIdent, back)
+	}
+	QPow(&got, &negIdent, 1.0)
+	if !qNear(&got, &negIdent, 1e-6) {
+		t.Errorf("QPow(%v, 1) = %v", negIdent, got)
+	}
+	// Half of a 2pi rotation about X.
+	QPow(&got, &negIdent, 0.5)
+	if want := (Quat{X: 1.0}); !qNear(&got, &want, 1e-6) {
+		t.Errorf("QPow(%v, 0.5) = %v, want %v", negIdent, got, want)
+	}
+
+	// The identity itself still has a zero log.
+	ident := Quat{W: 1.0}
+	QLog(&logQ, &ident)
+	if logQ != (Quat{}) {
+		t.Errorf("QLog(%v) = %v, want zero", ident, logQ)
+	}
+}
+
+func TestQInverse(t *testing.T) {
+	var inv, prod, ident, conj Quat
+	quat := Quat{X: 1.0, Y: -2.0, Z: 0.5, W: 3.0}
+	QInverse(&inv, &quat)
+	QMul(&prod, &quat, &inv)
+	QMakeIdentity(&ident)
+	if !qNear(&prod, &ident, 1e-6) {
+		t.Errorf("quat * QInverse(quat) = %v, want identity", prod)
+	}
+	QMul(&prod, &inv, &quat)
+	if !qNear(&prod, &ident, 1e-6) {
+		t.Errorf("QInverse(quat) * quat = %v, want identity", prod)
+	}
+	QNormalize(&quat, &quat)
+	QInverse(&inv, &quat)
+	QConj(&conj, &quat)
+	if !qNear(&inv, &conj, 1e-6) {
+		t.Errorf("QInverse of a unit quaternion = %v, QConj gives %v", inv, conj)
+	}
+}
+
+func TestQGetAxisAngle(t *testing.T) {
+	var axis, gotAxis, negAxis Vector3
+	var quat Quat
+	V3MakeFromElems(&axis, -1.0, 4.0, 8.0)
+	V3Normalize(&axis, &axis)
+	V3Neg(&negAxis, &axis)
+	cases := []struct {
+		radians   float32
+		wantAngle float32
+		wantAxis  *Vector3
+	}{
+		{0.5, 0.5, &axis},
+		{3.0, 3.0, &axis},
+		// Negative angles come back positive about the opposite axis.
+		{-1.0, 1.0, &negAxis},
+		// Angles past pi come back as the shorter rotation the other way.
+		{4.0, 2.0*g_PI - 4.0, &negAxis},
+	}
+	for _, c := range cases {
+		QMakeRotationAxis(&quat, c.radians, &axis)
+		angle := QGetAxisAngle(&gotAxis, &quat)
+		if !near(angle, c.wantAngle, 1e-5) || !v3Near(&gotAxis, c.wantAxis, 1e-5) {
+			t.Errorf("QGetAxisAngle(rotation by %v) = %v, %v, want %v, %v", c.radians, angle, gotAxis, c.wantAngle, *c.wantAxis)
+		}
+	}
+	QMakeIdentity(&quat)
+	if angle := QGetAxisAngle(&gotAxis, &quat); angle != 0.0 || gotAxis != (Vector3{X: 1.0}) {
+		t.Errorf("QGetAxisAngle(identity) = %v, %v, want 0 about X", angle, gotAxis)
+	}
+}
+
+func TestQMakeRotationArc(t *testing.T) {
+	var from, to, got Vector3
+	var quat Quat
+	cases := [][2]Vector3{
+		{{X: 1.0}, {Y: 1.0}},
+		{{X: 0.6, Y: 0.8}, {Z: -1.0}},
+		{{X: 1.0}, {X: 1.0}},
+		// Opposite and nearly opposite vectors.
+		{{X: 1.0}, {X: -1.0}},
+		{{Y: 1.0}, {Y: -1.0}},
+		{{Z: -1.0}, {Z: 1.0}},
+		{{X: 0.48, Y: 0.6, Z: 0.64}, {X: -0.48, Y: -0.6, Z: -0.64}},
+		{{X: 1.0}, {X: -1.0, Y: 1e-4}},
+		{{X: 1.0}, {X: -1.0, Y: 1e-6, Z: -2e-6}},
+		{{X: 0.48, Y: 0.6, Z: 0.64}, {X: -0.48, Y: -0.6001, Z: -0.64}},
+	}
+	for _, c := range cases {
+		V3Normalize(&from, &c[0])
+		V3Normalize(&to, &c[1])
+		QMakeRotationArc(&quat, &from, &to)
+		if !near(quat.Length(), 1.0, 1e-6) {
+			t.Errorf("QMakeRotationArc(%v, %v) = %v, not a unit quaternion", from, to, quat)
+		}
+		QRotate(&got, &quat, &from)
+		if !v3Near(&got, &to, 1e-5) {
+			t.Errorf("QMakeRotationArc(%v, %v) takes from to %v", from, to, got)
+		}
+	}
+}
+
+func TestQMakeLookRotation(t *testing.T) {
+	var forward, up, back, gotForward, gotUp Vector3
+	var eye, target Point3
+	var quat Quat
+	var view Matrix4
+	var rot, want Matrix3
+
+	V3MakeFromElems(&forward, 0.0, 0.0, -1.0)
+	V3MakeFromElems(&up, 0.0, 1.0, 0.0)
+	QMakeLookRotation(&quat, &forward, &up)
+	if ident := (Quat{W: 1.0}); !qNear(&quat, &ident, 1e-6) {
+		t.Errorf("QMakeLookRotation(-Z, Y) = %v, want identity", quat)
+	}
+
+	// The view matrix from M4MakeLookAt holds the inverse rotation.
+	P3MakeFromElems(&eye, 1.0, 2.0, 3.0)
+	P3MakeFromElems(&target, -4.0, 0.5, 7.0)
+	V3MakeFromElems(&up, 0.2, 1.0, 0.1)
+	M4MakeLookAt(&view, &eye, &target, &up)
+	P3Sub(&forward, &target, &eye)
+	QMakeLookRotation(&quat, &forward, &up)
+	M3MakeFromQ(&rot, &quat)
+	M4GetUpper3x3(&want, &view)
+	M3Transpose(&want, &want)
+	if !m3Near(&rot, &want, 1e-5) {
+		t.Errorf("QMakeLookRotation = %v, M4MakeLookAt gives %v", rot.String(), want.String())
+	}
+
+	// With up parallel to forward, any up perpendicular to forward will do.
+	V3MakeFromElems(&forward, 0.0, 3.0, 0.0)
+	V3MakeFromElems(&up, 0.0, 1.0, 0.0)
+	QMakeLookRotation(&quat, &forward, &up)
+	V3MakeFromElems(&back, 0.0, 0.0, 1.0)
+	QRotate(&gotForward, &quat, &back)
+	QRotate(&gotUp, &quat, &up)
+	if want := (Vector3{Y: -1.0}); !near(quat.Length(), 1.0, 1e-6) || !v3Near(&gotForward, &want, 1e-6) {
+		t.Errorf("QMakeLookRotation(Y, Y) = %v turns +Z to %v, want %v", quat, gotForward, want)
+	}
+	if d := V3Dot(&gotUp, &forward); !near(d, 0.0, 1e-5) {
+		t.Errorf("QMakeLookRotation(Y, Y) up %v is not perpendicular to forward", gotUp)
+	}
+}
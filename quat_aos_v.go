@@ -0,0 +1,119 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// qMulAsm uses the float32 kernel from QMul when T is float32; see
+// m4MulAsm.
+func qMulAsm[T Float](result, quat0, quat1 *QuatOf[T]) {
+	if result32, ok := any(result).(*Quat); ok {
+		qMulF32(result32, any(quat0).(*Quat), any(quat1).(*Quat))
+		return
+	}
+	qMul(result, quat0, quat1)
+}
+
+/*******/
+
+func (q QuatOf[T]) Add(quat1 QuatOf[T]) QuatOf[T] {
+	var result QuatOf[T]
+	qAdd(&result, &q, &quat1)
+	return result
+}
+
+func (q QuatOf[T]) Sub(quat1 QuatOf[T]) QuatOf[T] {
+	var result QuatOf[T]
+	qSub(&result, &q, &quat1)
+	return result
+}
+
+func (q QuatOf[T]) Mul(quat1 QuatOf[T]) QuatOf[T] {
+	var result QuatOf[T]
+	qMulAsm(&result, &q, &quat1)
+	return result
+}
+
+func (q QuatOf[T]) Scale(scalar T) QuatOf[T] {
+	var result QuatOf[T]
+	qScalarMul(&result, &q, scalar)
+	return result
+}
+
+func (q QuatOf[T]) Div(scalar T) QuatOf[T] {
+	var result QuatOf[T]
+	qScalarDiv(&result, &q, scalar)
+	return result
+}
+
+func (q QuatOf[T]) Neg() QuatOf[T] {
+	var result QuatOf[T]
+	qNeg(&result, &q)
+	return result
+}
+
+func (q QuatOf[T]) Conj() QuatOf[T] {
+	var result QuatOf[T]
+	qConj(&result, &q)
+	return result
+}
+
+func (q QuatOf[T]) Normalize() QuatOf[T] {
+	var result QuatOf[T]
+	qNormalize(&result, &q)
+	return result
+}
+
+func (q QuatOf[T]) Inverse() QuatOf[T] {
+	var result QuatOf[T]
+	qInverse(&result, &q)
+	return result
+}
+
+func (q QuatOf[T]) Log() QuatOf[T] {
+	var result QuatOf[T]
+	qLog(&result, &q)
+	return result
+}
+
+func (q QuatOf[T]) Exp() QuatOf[T] {
+	var result QuatOf[T]
+	qExp(&result, &q)
+	return result
+}
+
+func (q QuatOf[T]) Pow(t T) QuatOf[T] {
+	var result QuatOf[T]
+	qPow(&result, &q, t)
+	return result
+}
+
+func (q QuatOf[T]) Rotate(vec Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	qRotate(&result, &q, &vec)
+	return result
+}
+
+func (q QuatOf[T]) Lerp(t T, quat1 QuatOf[T]) QuatOf[T] {
+	var result QuatOf[T]
+	qLerp(&result, t, &q, &quat1)
+	return result
+}
+
+func (q QuatOf[T]) Slerp(t T, unitQuat1 QuatOf[T]) QuatOf[T] {
+	var result QuatOf[T]
+	qSlerp(&result, t, &q, &unitQuat1)
+	return result
+}
+
+func (q QuatOf[T]) Squad(t T, unitQuat1, unitQuat2, unitQuat3 QuatOf[T]) QuatOf[T] {
+	var result QuatOf[T]
+	qSquad(&result, t, &q, &unitQuat1, &unitQuat2, &unitQuat3)
+	return result
+}
+
+func (q QuatOf[T]) Matrix3() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	m3MakeFromQ(&result, &q)
+	return result
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestQValueMethods(t *testing.T) {
+	var quat0, quat1, want Quat
+	var vec, wantV Vector3
+	QMakeRotationX(&quat0, 0.5)
+	QMakeRotationZ(&quat1, -1.2)
+	V3MakeFromElems(&vec, 1.0, 2.0, 3.0)
+
+	got := quat0.Mul(quat1)
+	QMul(&want, &quat0, &quat1)
+	if !qNear(&got, &want, 1e-7) {
+		t.Errorf("quat0.Mul(quat1) = %v, want %v", got, want)
+	}
+	got = quat0.Slerp(0.25, quat1)
+	QSlerp(&want, 0.25, &quat0, &quat1)
+	if !qNear(&got, &want, 1e-7) {
+		t.Errorf("quat0.Slerp(0.25, quat1) = %v, want %v", got, want)
+	}
+	gotV := quat0.Mul(quat1).Rotate(vec)
+	QMul(&want, &quat0, &quat1)
+	QRotate(&wantV, &want, &vec)
+	if !v3Near(&gotV, &wantV, 1e-6) {
+		t.Errorf("quat0.Mul(quat1).Rotate(vec) = %v, want %v", gotV, wantV)
+	}
+	if got := quat0.Dot(&quat0); !near(got, 1.0, 1e-6) {
+		t.Errorf("quat0.Dot(&quat0) = %v, want 1", got)
+	}
+}
+
+var benchQV3 Vector3
+
+func BenchmarkQValueRotate(b *testing.B) {
+	var quat Quat
+	var vec Vector3
+	QMakeRotationY(&quat, 0.1)
+	V3MakeFromElems(&vec, 1.0, 2.0, 3.0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		vec = quat.Rotate(vec)
+	}
+	benchQV3 = vec
+}
+
+func BenchmarkQPointerRotate(b *testing.B) {
+	var quat Quat
+	var vec Vector3
+	QMakeRotationY(&quat, 0.1)
+	V3MakeFromElems(&vec, 1.0, 2.0, 3.0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		QRotate(&vec, &quat, &vec)
+	}
+	benchQV3 = vec
+}
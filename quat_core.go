@@ -0,0 +1,397 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+func qCopy[T Float](result, quat *QuatOf[T]) {
+	result.X = quat.X
+	result.Y = quat.Y
+	result.Z = quat.Z
+	result.W = quat.W
+}
+
+func qMakeFromElems[T Float](result *QuatOf[T], x, y, z, w T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+	result.W = w
+}
+
+func qMakeFromV3Scalar[T Float](result *QuatOf[T], xyz *Vector3Of[T], w T) {
+	result.SetXYZ(xyz)
+	result.SetW(w)
+}
+
+func qMakeFromV4[T Float](result *QuatOf[T], vec *Vector4Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+	result.W = vec.W
+}
+
+func qMakeFromScalar[T Float](result *QuatOf[T], scalar T) {
+	result.X = scalar
+	result.Y = scalar
+	result.Z = scalar
+	result.W = scalar
+}
+
+func qMakeIdentity[T Float](result *QuatOf[T]) {
+	qMakeFromElems(result, 0.0, 0.0, 0.0, 1.0)
+}
+
+func qLerp[T Float](result *QuatOf[T], t T, quat0, quat1 *QuatOf[T]) {
+	var tmpQ_0, tmpQ_1 QuatOf[T]
+	qSub(&tmpQ_0, quat1, quat0)
+	qScalarMul(&tmpQ_1, &tmpQ_0, t)
+	qAdd(result, quat0, &tmpQ_1)
+}
+
+func qSlerp[T Float](result *QuatOf[T], t T, unitQuat0, unitQuat1 *QuatOf[T]) {
+	var start, tmpQ_0, tmpQ_1 QuatOf[T]
+	var scale0, scale1 T
+	cosAngle := qDot(unitQuat0, unitQuat1)
+	if cosAngle < 0.0 {
+		cosAngle = -cosAngle
+		qNeg(&start, unitQuat0)
+	} else {
+		qCopy(&start, unitQuat0)
+	}
+	if cosAngle < g_SLERP_TOL {
+		angle := acos(cosAngle)
+		recipSinAngle := (1.0 / sin(angle))
+		scale0 = (sin(((1.0 - t) * angle)) * recipSinAngle)
+		scale1 = (sin((t * angle)) * recipSinAngle)
+	} else {
+		scale0 = (1.0 - t)
+		scale1 = t
+	}
+	qScalarMul(&tmpQ_0, &start, scale0)
+	qScalarMul(&tmpQ_1, unitQuat1, scale1)
+	qAdd(result, &tmpQ_0, &tmpQ_1)
+}
+
+func qSquad[T Float](result *QuatOf[T], t T, unitQuat0, unitQuat1, unitQuat2, unitQuat3 *QuatOf[T]) {
+	var tmp0, tmp1 QuatOf[T]
+	qSlerp(&tmp0, t, unitQuat0, unitQuat3)
+	qSlerp(&tmp1, t, unitQuat1, unitQuat2)
+	qSlerp(result, (2.0*t)*(1.0-t), &tmp0, &tmp1)
+}
+
+func (q *QuatOf[T]) SetXYZ(vec *Vector3Of[T]) {
+	q.X = vec.X
+	q.Y = vec.Y
+	q.Z = vec.Z
+}
+
+func (q *QuatOf[T]) SetX(x T) {
+	q.X = x
+}
+
+func (q *QuatOf[T]) SetY(y T) {
+	q.Y = y
+}
+
+func (q *QuatOf[T]) SetZ(z T) {
+	q.Z = z
+}
+
+func (q *QuatOf[T]) SetW(w T) {
+	q.W = w
+}
+
+func (q *QuatOf[T]) SetElem(index int, value T) {
+	switch index {
+	case 0:
+		q.X = value
+	case 1:
+		q.Y = value
+	case 2:
+		q.Z = value
+	case 3:
+		q.W = value
+	}
+}
+
+func (q *QuatOf[T]) GetElem(index int) T {
+	switch index {
+	case 0:
+		return q.X
+	case 1:
+		return q.Y
+	case 2:
+		return q.Z
+	case 3:
+		return q.W
+	}
+	return 0
+}
+
+func qAdd[T Float](result, quat0, quat1 *QuatOf[T]) {
+	result.X = quat0.X + quat1.X
+	result.Y = quat0.Y + quat1.Y
+	result.Z = quat0.Z + quat1.Z
+	result.W = quat0.W + quat1.W
+}
+
+func qSub[T Float](result, quat0, quat1 *QuatOf[T]) {
+	result.X = quat0.X - quat1.X
+	result.Y = quat0.Y - quat1.Y
+	result.Z = quat0.Z - quat1.Z
+	result.W = quat0.W - quat1.W
+}
+
+func qScalarMul[T Float](result, quat *QuatOf[T], scalar T) {
+	result.X = quat.X * scalar
+	result.Y = quat.Y * scalar
+	result.Z = quat.Z * scalar
+	result.W = quat.W * scalar
+}
+
+func qScalarDiv[T Float](result, quat *QuatOf[T], scalar T) {
+	result.X = quat.X / scalar
+	result.Y = quat.Y / scalar
+	result.Z = quat.Z / scalar
+	result.W = quat.W / scalar
+}
+
+func qNeg[T Float](result, quat *QuatOf[T]) {
+	result.X = -quat.X
+	result.Y = -quat.Y
+	result.Z = -quat.Z
+	result.W = -quat.W
+}
+
+func qDot[T Float](quat0, quat1 *QuatOf[T]) T {
+	result := quat0.X * quat1.X
+	result += quat0.Y * quat1.Y
+	result += quat0.Z * quat1.Z
+	result += quat0.W * quat1.W
+	return result
+}
+
+func (q QuatOf[T]) Dot(quat1 *QuatOf[T]) T {
+	result := q.X * quat1.X
+	result += q.Y * quat1.Y
+	result += q.Z * quat1.Z
+	result += q.W * quat1.W
+	return result
+}
+
+func (q QuatOf[T]) Norm() T {
+	result := q.X * q.X
+	result += q.Y * q.Y
+	result += q.Z * q.Z
+	result += q.W * q.W
+	return result
+}
+
+func (q QuatOf[T]) Length() T {
+	return sqrt(q.Norm())
+}
+
+func qNormalize[T Float](result, quat *QuatOf[T]) {
+	lenSqr := quat.Norm()
+	lenInv := 1.0 / sqrt(lenSqr)
+	result.X = quat.X * lenInv
+	result.Y = quat.Y * lenInv
+	result.Z = quat.Z * lenInv
+	result.W = quat.W * lenInv
+}
+
+// qMakeRotationArc normalizes (cross, 1 + dot) by its computed length once
+// the angle is over 120 degrees, as the closed form divides by a rounded
+// 1 + dot that approaches zero for opposite vectors. Both parts are taken
+// from the sum of the vectors there, which keeps them accurate as the
+// vectors approach opposite. Vectors opposite to within rounding get a half
+// turn about an arbitrary perpendicular axis.
+func qMakeRotationArc[T Float](result *QuatOf[T], unitVec0, unitVec1 *Vector3Of[T]) {
+	var tmpV3_0, tmpV3_1 Vector3Of[T]
+	var tmpQ_0 QuatOf[T]
+	onePlusDot := 1.0 + v3Dot(unitVec0, unitVec1)
+	if onePlusDot < 0.5 {
+		// Near opposite vectors 1 + dot cancels badly, and so does the cross
+		// product, but the sum of the vectors is exact. For unit vectors
+		// 1 + dot = |v0 + v1|^2 / 2 and v0 x v1 = v0 x (v0 + v1).
+		v3Add(&tmpV3_1, unitVec0, unitVec1)
+		v3Cross(&tmpV3_0, unitVec0, &tmpV3_1)
+		qMakeFromV3Scalar(&tmpQ_0, &tmpV3_0, 0.5*tmpV3_1.LengthSqr())
+		if tmpQ_0.Norm() < machineEpsilon[T]()*machineEpsilon[T]() {
+			v3MakePerpendicular(&tmpV3_0, unitVec0)
+			qMakeFromV3Scalar(result, &tmpV3_0, 0.0)
+		} else {
+			qNormalize(result, &tmpQ_0)
+		}
+		return
+	}
+	cosHalfAngleX2 := sqrt(2.0 * onePlusDot)
+	recipCosHalfAngleX2 := (1.0 / cosHalfAngleX2)
+	v3Cross(&tmpV3_0, unitVec0, unitVec1)
+	v3ScalarMul(&tmpV3_1, &tmpV3_0, recipCosHalfAngleX2)
+	qMakeFromV3Scalar(result, &tmpV3_1, (cosHalfAngleX2 * 0.5))
+}
+
+// qMakeLookRotation returns the orientation of the camera frame built by
+// m4MakeLookAt: local -Z points along forward and local Y is as close to up
+// as possible. If forward and up are parallel, an arbitrary axis
+// perpendicular to forward is used as up instead.
+func qMakeLookRotation[T Float](result *QuatOf[T], forward, up *Vector3Of[T]) {
+	var frame Matrix3Of[T]
+	var tmpV3_0 Vector3Of[T]
+	v3Normalize(&frame.col2, forward)
+	v3Neg(&frame.col2, &frame.col2)
+	v3Normalize(&tmpV3_0, up)
+	v3Cross(&frame.col0, &tmpV3_0, &frame.col2)
+	if frame.col0.LengthSqr() < machineEpsilon[T]() {
+		v3MakePerpendicular(&frame.col0, &frame.col2)
+	} else {
+		v3Normalize(&frame.col0, &frame.col0)
+	}
+	v3Cross(&frame.col1, &frame.col2, &frame.col0)
+	qMakeFromM3(result, &frame)
+}
+
+func qMakeRotationAxis[T Float](result *QuatOf[T], radians T, unitVec *Vector3Of[T]) {
+	var tmpV3_0 Vector3Of[T]
+	angle := radians * 0.5
+	s, c := sincos(angle)
+	v3ScalarMul(&tmpV3_0, unitVec, s)
+	qMakeFromV3Scalar(result, &tmpV3_0, c)
+}
+
+func qMakeRotationX[T Float](result *QuatOf[T], radians T) {
+	angle := radians * 0.5
+	s, c := sincos(angle)
+	qMakeFromElems(result, s, 0.0, 0.0, c)
+}
+
+func qMakeRotationY[T Float](result *QuatOf[T], radians T) {
+	angle := radians * 0.5
+	s, c := sincos(angle)
+	qMakeFromElems(result, 0.0, s, 0.0, c)
+}
+
+func qMakeRotationZ[T Float](result *QuatOf[T], radians T) {
+	angle := radians * 0.5
+	s, c := sincos(angle)
+	qMakeFromElems(result, 0.0, 0.0, s, c)
+}
+
+func qMul[T Float](result, quat0, quat1 *QuatOf[T]) {
+	tmpX := (quat0.W * quat1.X) + (quat0.X * quat1.W) + (quat0.Y * quat1.Z) - (quat0.Z * quat1.Y)
+	tmpY := (quat0.W * quat1.Y) + (quat0.Y * quat1.W) + (quat0.Z * quat1.X) - (quat0.X * quat1.Z)
+	tmpZ := (quat0.W * quat1.Z) + (quat0.Z * quat1.W) + (quat0.X * quat1.Y) - (quat0.Y * quat1.X)
+	tmpW := (quat0.W * quat1.W) - (quat0.X * quat1.X) - (quat0.Y * quat1.Y) - (quat0.Z * quat1.Z)
+	qMakeFromElems(result, tmpX, tmpY, tmpZ, tmpW)
+}
+
+func qRotate[T Float](result *Vector3Of[T], quat *QuatOf[T], vec *Vector3Of[T]) {
+	tmpX := (quat.W * vec.X) + (quat.Y * vec.Z) - (quat.Z * vec.Y)
+	tmpY := (quat.W * vec.Y) + (quat.Z * vec.X) - (quat.X * vec.Z)
+	tmpZ := (quat.W * vec.Z) + (quat.X * vec.Y) - (quat.Y * vec.X)
+	tmpW := (quat.X * vec.X) + (quat.Y * vec.Y) + (quat.Z * vec.Z)
+	result.X = (tmpW * quat.X) + (tmpX * quat.W) - (tmpY * quat.Z) + (tmpZ * quat.Y)
+	result.Y = (tmpW * quat.Y) + (tmpY * quat.W) - (tmpZ * quat.X) + (tmpX * quat.Z)
+	result.Z = (tmpW * quat.Z) + (tmpZ * quat.W) - (tmpX * quat.Y) + (tmpY * quat.X)
+}
+
+func qConj[T Float](result, quat *QuatOf[T]) {
+	qMakeFromElems(result, -quat.X, -quat.Y, -quat.Z, quat.W)
+}
+
+// qInverse works for any non-zero quaternion; for unit quaternions qConj
+// gives the same result more cheaply.
+func qInverse[T Float](result, quat *QuatOf[T]) {
+	normInv := 1.0 / quat.Norm()
+	qMakeFromElems(result, -quat.X*normInv, -quat.Y*normInv, -quat.Z*normInv, quat.W*normInv)
+}
+
+// qLog returns (axis * angle/2, log |quat|), where angle and axis describe
+// the rotation of quat, so the scalar part is zero for unit quaternions.
+// The axis of a quaternion with a zero vector part is undefined. If W is
+// positive the vector part of its log is zero; if W is negative, a rotation
+// by 2*pi, the log uses the X axis, giving a vector part of (pi, 0, 0).
+func qLog[T Float](result, quat *QuatOf[T]) {
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromElems(&tmpV3_0, quat.X, quat.Y, quat.Z)
+	vecLen := tmpV3_0.Length()
+	if vecLen > 0.0 {
+		v3ScalarMul(&tmpV3_0, &tmpV3_0, atan2(vecLen, quat.W)/vecLen)
+	} else if quat.W < 0.0 {
+		v3MakeFromElems(&tmpV3_0, g_PI, 0.0, 0.0)
+	}
+	qMakeFromV3Scalar(result, &tmpV3_0, T(0.5)*log(quat.Norm()))
+}
+
+// qExp is the inverse of qLog.
+func qExp[T Float](result, quat *QuatOf[T]) {
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromElems(&tmpV3_0, quat.X, quat.Y, quat.Z)
+	vecLen := tmpV3_0.Length()
+	expW := exp(quat.W)
+	s, c := sincos(vecLen)
+	scale := expW
+	if vecLen > 0.0 {
+		scale = expW * s / vecLen
+	}
+	v3ScalarMul(&tmpV3_0, &tmpV3_0, scale)
+	qMakeFromV3Scalar(result, &tmpV3_0, expW*c)
+}
+
+// qPow raises quat to the power t, which for a unit quaternion scales the
+// rotation angle by t.
+func qPow[T Float](result, quat *QuatOf[T], t T) {
+	qLog(result, quat)
+	qScalarMul(result, result, t)
+	qExp(result, result)
+}
+
+// qGetAxisAngle returns the rotation angle of unitQuat in [0, pi] and
+// stores the unit axis in result, flipping it as needed for the angle to
+// stay in range. The axis of the identity rotation is taken to be X.
+func qGetAxisAngle[T Float](result *Vector3Of[T], unitQuat *QuatOf[T]) T {
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromElems(&tmpV3_0, unitQuat.X, unitQuat.Y, unitQuat.Z)
+	w := unitQuat.W
+	if w < 0.0 {
+		v3Neg(&tmpV3_0, &tmpV3_0)
+		w = -w
+	}
+	vecLen := tmpV3_0.Length()
+	if vecLen == 0.0 {
+		v3MakeXAxis(result)
+		return 0.0
+	}
+	v3ScalarDiv(result, &tmpV3_0, vecLen)
+	return 2.0 * atan2(vecLen, w)
+}
+
+func qSelect[T Float](result, quat0, quat1 *QuatOf[T], select1 int) {
+	if select1 != 0 {
+		result.X = quat1.X
+		result.Y = quat1.Y
+		result.Z = quat1.Z
+		result.W = quat1.W
+	} else {
+		result.X = quat0.X
+		result.Y = quat0.Y
+		result.Z = quat0.Z
+		result.W = quat0.W
+	}
+}
+
+func (q *QuatOf[T]) String() string {
+	return fmt.Sprintf("( %f %f %f %f )\n", q.X, q.Y, q.Z, q.W)
+}
+
+/*******/
+
+func qConvert[T, U Float](result *QuatOf[T], quat *QuatOf[U]) {
+	result.X = T(quat.X)
+	result.Y = T(quat.Y)
+	result.Z = T(quat.Z)
+	result.W = T(quat.W)
+}
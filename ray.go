@@ -0,0 +1,68 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Ray = RayOf[float32]
+
+// RayHit is filled in by the RayIntersect functions. Distance is the ray
+// parameter of the hit, so it is measured in units of the length of the
+// ray's Direction. For closed shapes the hit is the first crossing at or
+// beyond the origin, so a ray starting inside reports where it leaves, and
+// Normal points out of the shape; for planes and triangles Normal faces the
+// ray origin. Normal is always unit length.
+type RayHit = RayHitOf[float32]
+
+func RayCopy(result, r *Ray) {
+	rayCopy(result, r)
+}
+
+func RayMakeFromOriginDirection(result *Ray, origin *Point3, direction *Vector3) {
+	rayMakeFromOriginDirection(result, origin, direction)
+}
+
+// RayMakeFromPoints sets result to the ray from pnt0 through pnt1. The
+// direction is not normalized, so hits between the two points have distances
+// in [0, 1].
+func RayMakeFromPoints(result *Ray, pnt0, pnt1 *Point3) {
+	rayMakeFromPoints(result, pnt0, pnt1)
+}
+
+func RayGetPoint(result *Point3, r *Ray, t float32) {
+	rayGetPoint(result, r, t)
+}
+
+// RayIntersectPlane intersects r with the plane of points p for which
+// dot(unitNormal, p) + dist = 0. Rays parallel to the plane miss.
+func RayIntersectPlane(hit *RayHit, r *Ray, unitNormal *Vector3, dist float32) bool {
+	return rayIntersectPlane(hit, r, unitNormal, dist)
+}
+
+// RayIntersectSphere stores the first point at or beyond the ray origin where
+// r crosses the sphere in hit and returns true, or returns false and leaves
+// hit unchanged if it does not. The other RayIntersect functions behave the
+// same way; see RayHit for the exact conventions.
+func RayIntersectSphere(hit *RayHit, r *Ray, center *Point3, radius float32) bool {
+	return rayIntersectSphere(hit, r, center, radius)
+}
+
+// RayIntersectAABB uses the slab method.
+func RayIntersectAABB(hit *RayHit, r *Ray, box *AABB) bool {
+	return rayIntersectAABB(hit, r, box)
+}
+
+func RayIntersectOBB(hit *RayHit, r *Ray, box *OBB) bool {
+	return rayIntersectOBB(hit, r, box)
+}
+
+// RayIntersectTriangle uses the Möller–Trumbore algorithm and hits both
+// sides of the triangle.
+func RayIntersectTriangle(hit *RayHit, r *Ray, pnt0, pnt1, pnt2 *Point3) bool {
+	return rayIntersectTriangle(hit, r, pnt0, pnt1, pnt2)
+}
+
+// RayIntersectCapsule intersects r with the set of points within radius of
+// the segment from pnt0 to pnt1.
+func RayIntersectCapsule(hit *RayHit, r *Ray, pnt0, pnt1 *Point3, radius float32) bool {
+	return rayIntersectCapsule(hit, r, pnt0, pnt1, radius)
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"fmt"
+	"math"
+)
+
+// RayOf is the half-line Origin + t*Direction, t >= 0. Direction does not
+// have to be unit length; hit distances are measured in units of its
+// length, so they are true distances only when it is.
+type RayOf[T Float] struct {
+	Origin    Point3Of[T]
+	Direction Vector3Of[T]
+}
+
+// RayHitOf describes where a ray crosses a surface. For closed shapes
+// (sphere, box, capsule) the hit is the first crossing at or beyond the
+// origin, so a ray starting inside reports where it leaves, and Normal is
+// the outward surface normal. For open surfaces (plane, triangle) Normal
+// faces back towards the ray origin. Normal is always unit length.
+type RayHitOf[T Float] struct {
+	Distance T
+	Normal   Vector3Of[T]
+}
+
+func rayCopy[T Float](result, r *RayOf[T]) {
+	p3Copy(&result.Origin, &r.Origin)
+	v3Copy(&result.Direction, &r.Direction)
+}
+
+func rayMakeFromOriginDirection[T Float](result *RayOf[T], origin *Point3Of[T], direction *Vector3Of[T]) {
+	p3Copy(&result.Origin, origin)
+	v3Copy(&result.Direction, direction)
+}
+
+// rayMakeFromPoints leaves Direction unnormalized, so pnt1 is reached at
+// distance 1 and hits on the segment between the points have distances in
+// [0, 1].
+func rayMakeFromPoints[T Float](result *RayOf[T], pnt0, pnt1 *Point3Of[T]) {
+	p3Sub(&result.Direction, pnt1, pnt0)
+	p3Copy(&result.Origin, pnt0)
+}
+
+func rayGetPoint[T Float](result *Point3Of[T], r *RayOf[T], t T) {
+	var tmpV3_0 Vector3Of[T]
+	v3ScalarMul(&tmpV3_0, &r.Direction, t)
+	p3AddV3(result, &r.Origin, &tmpV3_0)
+}
+
+// rayFinishConvex picks the reported hit from the interval [tEnter, tExit]
+// in which the ray is inside a convex shape. It returns false if the whole
+// interval lies behind the origin.
+func rayFinishConvex[T Float](tEnter, tExit T) (T, bool) {
+	// Every shape here is bounded, so an unbounded interval can only come
+	// from a zero-length direction.
+	if tExit < 0.0 || tEnter > tExit || math.IsInf(float64(tExit), 1) {
+		return 0.0, false
+	}
+	if tEnter >= 0.0 {
+		return tEnter, true
+	}
+	return tExit, true
+}
+
+func rayIntersectPlane[T Float](hit *RayHitOf[T], r *RayOf[T], unitNormal *Vector3Of[T], dist T) bool {
+	var tmpV3_0 Vector3Of[T]
+	denom := v3Dot(unitNormal, &r.Direction)
+	if denom == 0.0 {
+		return false
+	}
+	v3MakeFromP3(&tmpV3_0, &r.Origin)
+	t := -(v3Dot(unitNormal, &tmpV3_0) + dist) / denom
+	if !(t >= 0.0) {
+		return false
+	}
+	hit.Distance = t
+	if denom < 0.0 {
+		v3Copy(&hit.Normal, unitNormal)
+	} else {
+		v3Neg(&hit.Normal, unitNormal)
+	}
+	return true
+}
+
+func raySphereInterval[T Float](r *RayOf[T], center *Point3Of[T], radius T) (T, T, bool) {
+	var m Vector3Of[T]
+	p3Sub(&m, &r.Origin, center)
+	a := r.Direction.LengthSqr()
+	b := v3Dot(&m, &r.Direction)
+	c := m.LengthSqr() - radius*radius
+	if a == 0.0 {
+		return 0.0, 0.0, false
+	}
+	disc := b*b - a*c
+	if disc < 0.0 {
+		return 0.0, 0.0, false
+	}
+	sq := sqrt(disc)
+	return (-b - sq) / a, (-b + sq) / a, true
+}
+
+func rayIntersectSphere[T Float](hit *RayHitOf[T], r *RayOf[T], center *Point3Of[T], radius T) bool {
+	var pnt Point3Of[T]
+	var tmpV3_0 Vector3Of[T]
+	tEnter, tExit, ok := raySphereInterval(r, center, radius)
+	if !ok {
+		return false
+	}
+	t, ok := rayFinishConvex(tEnter, tExit)
+	if !ok {
+		return false
+	}
+	rayGetPoint(&pnt, r, t)
+	p3Sub(&tmpV3_0, &pnt, center)
+	hit.Distance = t
+	v3Normalize(&hit.Normal, &tmpV3_0)
+	return true
+}
+
+// rayBoxInterval runs the slab test against the box [boxMin, boxMax]. It
+// also returns the axis through which the ray enters and leaves, and the
+// sign of the outward normal on those faces.
+func rayBoxInterval[T Float](r *RayOf[T], boxMin, boxMax *Point3Of[T]) (tEnter, tExit T, enterAxis, exitAxis int, enterSign, exitSign T, ok bool) {
+	tEnter = T(math.Inf(-1))
+	tExit = T(math.Inf(1))
+	for i := 0; i < 3; i++ {
+		o := r.Origin.GetElem(i)
+		d := r.Direction.GetElem(i)
+		lo := boxMin.GetElem(i)
+		hi := boxMax.GetElem(i)
+		if d == 0.0 {
+			if o < lo || o > hi {
+				return
+			}
+			continue
+		}
+		invD := 1.0 / d
+		t0 := (lo - o) * invD
+		t1 := (hi - o) * invD
+		s0, s1 := T(-1.0), T(1.0)
+		if t0 > t1 {
+			t0, t1 = t1, t0
+			s0, s1 = s1, s0
+		}
+		if t0 > tEnter {
+			tEnter, enterAxis, enterSign = t0, i, s0
+		}
+		if t1 < tExit {
+			tExit, exitAxis, exitSign = t1, i, s1
+		}
+		if tEnter > tExit {
+			return
+		}
+	}
+	ok = true
+	return
+}
+
+func rayIntersectBox[T Float](hit *RayHitOf[T], r *RayOf[T], boxMin, boxMax *Point3Of[T]) bool {
+	tEnter, tExit, enterAxis, exitAxis, enterSign, exitSign, ok := rayBoxInterval(r, boxMin, boxMax)
+	if !ok {
+		return false
+	}
+	t, ok := rayFinishConvex(tEnter, tExit)
+	if !ok {
+		return false
+	}
+	hit.Distance = t
+	v3MakeFromScalar(&hit.Normal, 0.0)
+	if t == tEnter {
+		hit.Normal.SetElem(enterAxis, enterSign)
+	} else {
+		hit.Normal.SetElem(exitAxis, exitSign)
+	}
+	return true
+}
+
+func rayIntersectAABB[T Float](hit *RayHitOf[T], r *RayOf[T], box *AABBOf[T]) bool {
+	if box.IsEmpty() {
+		return false
+	}
+	return rayIntersectBox(hit, r, &box.Min, &box.Max)
+}
+
+// rayIntersectOBB moves the ray into the box's frame, where the box is an
+// AABB centred on the origin. The frame is orthonormal, so distances carry
+// over unchanged.
+func rayIntersectOBB[T Float](hit *RayHitOf[T], r *RayOf[T], box *OBBOf[T]) bool {
+	var local RayOf[T]
+	var tmpV3_0, localNormal Vector3Of[T]
+	var boxMin, boxMax Point3Of[T]
+	p3Sub(&tmpV3_0, &r.Origin, &box.Center)
+	v3RowMul(&tmpV3_0, &tmpV3_0, &box.Axes)
+	p3MakeFromV3(&local.Origin, &tmpV3_0)
+	v3RowMul(&local.Direction, &r.Direction, &box.Axes)
+	p3MakeFromV3(&boxMax, &box.Extents)
+	p3Scale(&boxMin, &boxMax, -1.0)
+	if !rayIntersectBox(hit, &local, &boxMin, &boxMax) {
+		return false
+	}
+	v3Copy(&localNormal, &hit.Normal)
+	m3MulV3(&hit.Normal, &box.Axes, &localNormal)
+	return true
+}
+
+// rayIntersectTriangle is the Möller–Trumbore test. Both sides of the
+// triangle are hit; degenerate triangles and rays in its plane miss.
+func rayIntersectTriangle[T Float](hit *RayHitOf[T], r *RayOf[T], pnt0, pnt1, pnt2 *Point3Of[T]) bool {
+	var edge1, edge2, pvec, tvec, qvec, normal Vector3Of[T]
+	p3Sub(&edge1, pnt1, pnt0)
+	p3Sub(&edge2, pnt2, pnt0)
+	v3Cross(&pvec, &r.Direction, &edge2)
+	det := v3Dot(&edge1, &pvec)
+	if det == 0.0 {
+		return false
+	}
+	invDet := 1.0 / det
+	p3Sub(&tvec, &r.Origin, pnt0)
+	u := v3Dot(&tvec, &pvec) * invDet
+	if u < 0.0 || u > 1.0 {
+		return false
+	}
+	v3Cross(&qvec, &tvec, &edge1)
+	v := v3Dot(&r.Direction, &qvec) * invDet
+	if v < 0.0 || u+v > 1.0 {
+		return false
+	}
+	t := v3Dot(&edge2, &qvec) * invDet
+	if t < 0.0 {
+		return false
+	}
+	v3Cross(&normal, &edge1, &edge2)
+	if v3Dot(&normal, &r.Direction) > 0.0 {
+		v3Neg(&normal, &normal)
+	}
+	hit.Distance = t
+	v3Normalize(&hit.Normal, &normal)
+	return true
+}
+
+// rayCylinderInterval intersects the ray with the part of the infinite
+// cylinder around pnt0-pnt1 that lies between the two end planes.
+func rayCylinderInterval[T Float](r *RayOf[T], pnt0, pnt1 *Point3Of[T], radius T) (T, T, bool) {
+	var axis, m Vector3Of[T]
+	p3Sub(&axis, pnt1, pnt0)
+	p3Sub(&m, &r.Origin, pnt0)
+	dd := axis.LengthSqr()
+	if dd == 0.0 {
+		return 0.0, 0.0, false
+	}
+	md := v3Dot(&m, &axis)
+	nd := v3Dot(&r.Direction, &axis)
+	// Clip against the end planes, 0 <= (m + t*dir).axis <= dd.
+	tEnter := T(math.Inf(-1))
+	tExit := T(math.Inf(1))
+	if nd == 0.0 {
+		if md < 0.0 || md > dd {
+			return 0.0, 0.0, false
+		}
+	} else {
+		t0 := -md / nd
+		t1 := (dd - md) / nd
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		tEnter, tExit = t0, t1
+	}
+	// Clip against the cylinder wall, using the components of m and dir
+	// perpendicular to the axis.
+	nn := r.Direction.LengthSqr()
+	mn := v3Dot(&m, &r.Direction)
+	a := dd*nn - nd*nd
+	c := dd*(m.LengthSqr()-radius*radius) - md*md
+	if a == 0.0 {
+		if c > 0.0 {
+			return 0.0, 0.0, false
+		}
+	} else {
+		b := dd*mn - nd*md
+		disc := b*b - a*c
+		if disc < 0.0 {
+			return 0.0, 0.0, false
+		}
+		sq := sqrt(disc)
+		tEnter = max(tEnter, (-b-sq)/a)
+		tExit = min(tExit, (-b+sq)/a)
+	}
+	return tEnter, tExit, tEnter <= tExit
+}
+
+// rayIntersectCapsule treats the capsule as the union of a clipped cylinder
+// and two end spheres. The capsule is convex, so the ray is inside it over
+// the single interval that spans the intervals of all three parts.
+func rayIntersectCapsule[T Float](hit *RayHitOf[T], r *RayOf[T], pnt0, pnt1 *Point3Of[T], radius T) bool {
+	var pnt, closest Point3Of[T]
+	var axis, tmpV3_0 Vector3Of[T]
+	tEnter := T(math.Inf(1))
+	tExit := T(math.Inf(-1))
+	if t0, t1, ok := rayCylinderInterval(r, pnt0, pnt1, radius); ok {
+		tEnter, tExit = min(tEnter, t0), max(tExit, t1)
+	}
+	if t0, t1, ok := raySphereInterval(r, pnt0, radius); ok {
+		tEnter, tExit = min(tEnter, t0), max(tExit, t1)
+	}
+	if t0, t1, ok := raySphereInterval(r, pnt1, radius); ok {
+		tEnter, tExit = min(tEnter, t0), max(tExit, t1)
+	}
+	t, ok := rayFinishConvex(tEnter, tExit)
+	if !ok {
+		return false
+	}
+	rayGetPoint(&pnt, r, t)
+	p3Sub(&axis, pnt1, pnt0)
+	p3Sub(&tmpV3_0, &pnt, pnt0)
+	s := T(0.0)
+	if dd := axis.LengthSqr(); dd > 0.0 {
+		s = min(max(v3Dot(&tmpV3_0, &axis)/dd, 0.0), 1.0)
+	}
+	v3ScalarMul(&tmpV3_0, &axis, s)
+	p3AddV3(&closest, pnt0, &tmpV3_0)
+	p3Sub(&tmpV3_0, &pnt, &closest)
+	hit.Distance = t
+	v3Normalize(&hit.Normal, &tmpV3_0)
+	return true
+}
+
+func (r *RayOf[T]) String() string {
+	return fmt.Sprintf("( %f %f %f ) + t( %f %f %f )", r.Origin.X, r.Origin.Y, r.Origin.Z, r.Direction.X, r.Direction.Y, r.Direction.Z)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Rayd = RayOf[float64]
+
+type RayHitd = RayHitOf[float64]
+
+func RaydCopy(result, r *Rayd) {
+	rayCopy(result, r)
+}
+
+func RaydMakeFromOriginDirection(result *Rayd, origin *Point3d, direction *Vector3d) {
+	rayMakeFromOriginDirection(result, origin, direction)
+}
+
+func RaydMakeFromPoints(result *Rayd, pnt0, pnt1 *Point3d) {
+	rayMakeFromPoints(result, pnt0, pnt1)
+}
+
+func RaydGetPoint(result *Point3d, r *Rayd, t float64) {
+	rayGetPoint(result, r, t)
+}
+
+func RaydIntersectPlane(hit *RayHitd, r *Rayd, unitNormal *Vector3d, dist float64) bool {
+	return rayIntersectPlane(hit, r, unitNormal, dist)
+}
+
+func RaydIntersectSphere(hit *RayHitd, r *Rayd, center *Point3d, radius float64) bool {
+	return rayIntersectSphere(hit, r, center, radius)
+}
+
+func RaydIntersectAABBd(hit *RayHitd, r *Rayd, box *AABBd) bool {
+	return rayIntersectAABB(hit, r, box)
+}
+
+func RaydIntersectOBBd(hit *RayHitd, r *Rayd, box *OBBd) bool {
+	return rayIntersectOBB(hit, r, box)
+}
+
+func RaydIntersectTriangle(hit *RayHitd, r *Rayd, pnt0, pnt1, pnt2 *Point3d) bool {
+	return rayIntersectTriangle(hit, r, pnt0, pnt1, pnt2)
+}
+
+func RaydIntersectCapsule(hit *RayHitd, r *Rayd, pnt0, pnt1 *Point3d, radius float64) bool {
+	return rayIntersectCapsule(hit, r, pnt0, pnt1, radius)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"testing"
+)
+
+func makeRay(ox, oy, oz, dx, dy, dz float32) Ray {
+	var r Ray
+	P3MakeFromElems(&r.Origin, ox, oy, oz)
+	V3MakeFromElems(&r.Direction, dx, dy, dz)
+	return r
+}
+
+// rayCase is one ray against one shape; a miss has hit set to false and the
+// other fields are ignored.
+type rayCase struct {
+	name     string
+	r        Ray
+	hit      bool
+	distance float32
+	normal   Vector3
+}
+
+func checkRayCases(t *testing.T, shape string, cases []rayCase, intersect func(*RayHit, *Ray) bool) {
+	t.Helper()
+	for _, c := range cases {
+		var hit RayHit
+		ok := intersect(&hit, &c.r)
+		switch {
+		case ok != c.hit:
+			t.Errorf("%s, %s: hit = %v, want %v", shape, c.name, ok, c.hit)
+		case ok && (!near(hit.Distance, c.distance, 1e-5) || !v3Near(&hit.Normal, &c.normal, 1e-5)):
+			t.Errorf("%s, %s: hit at %v with normal %v, want %v with %v",
+				shape, c.name, hit.Distance, hit.Normal, c.distance, c.normal)
+		}
+	}
+}
+
+func TestRayIntersectPlane(t *testing.T) {
+	var normal Vector3
+	V3MakeFromElems(&normal, 0.0, 1.0, 0.0)
+	// The plane y = 2.
+	checkRayCases(t, "plane", []rayCase{
+		{"from below", makeRay(1.0, 0.0, 1.0, 0.0, 1.0, 0.0), true, 2.0, Vector3{Y: -1.0}},
+		{"from above", makeRay(1.0, 5.0, 1.0, 0.0, -0.5, 0.0), true, 6.0, Vector3{Y: 1.0}},
+		{"pointing away", makeRay(1.0, 0.0, 1.0, 0.0, -1.0, 0.0), false, 0.0, Vector3{}},
+		{"parallel", makeRay(1.0, 0.0, 1.0, 1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+	}, func(hit *RayHit, r *Ray) bool {
+		return RayIntersectPlane(hit, r, &normal, -2.0)
+	})
+}
+
+func TestRayIntersectSphere(t *testing.T) {
+	var center Point3
+	P3MakeFromElems(&center, 0.0, 0.0, -5.0)
+	checkRayCases(t, "sphere", []rayCase{
+		{"head on", makeRay(0.0, 0.0, 0.0, 0.0, 0.0, -1.0), true, 4.0, Vector3{Z: 1.0}},
+		{"from inside", makeRay(0.0, 0.0, -5.0, 1.0, 0.0, 0.0), true, 1.0, Vector3{X: 1.0}},
+		{"grazing", makeRay(1.0, 0.0, 0.0, 0.0, 0.0, -1.0), true, 5.0, Vector3{X: 1.0}},
+		{"passing by", makeRay(1.5, 0.0, 0.0, 0.0, 0.0, -1.0), false, 0.0, Vector3{}},
+		{"behind", makeRay(0.0, 0.0, -10.0, 0.0, 0.0, -1.0), false, 0.0, Vector3{}},
+		{"zero direction", makeRay(0.0, 0.0, 0.0, 0.0, 0.0, 0.0), false, 0.0, Vector3{}},
+	}, func(hit *RayHit, r *Ray) bool {
+		return RayIntersectSphere(hit, r, &center, 1.0)
+	})
+}
+
+func TestRayIntersectAABB(t *testing.T) {
+	box := makeAABB(1.0, -1.0, -1.0, 3.0, 1.0, 1.0)
+	checkRayCases(t, "AABB", []rayCase{
+		{"through -X face", makeRay(0.0, 0.0, 0.0, 1.0, 0.0, 0.0), true, 1.0, Vector3{X: -1.0}},
+		{"diagonal through +Y face", makeRay(2.0, 3.0, 0.0, 0.0, -2.0, 0.0), true, 1.0, Vector3{Y: 1.0}},
+		{"from inside", makeRay(2.0, 0.0, 0.0, 0.0, 0.0, 0.5), true, 2.0, Vector3{Z: 1.0}},
+		{"on a face, parallel", makeRay(0.0, 1.0, 0.0, 1.0, 0.0, 0.0), true, 1.0, Vector3{X: -1.0}},
+		{"above, parallel", makeRay(0.0, 1.5, 0.0, 1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+		{"missing a corner", makeRay(0.0, 0.5, 0.0, 1.0, 1.6, 0.0), false, 0.0, Vector3{}},
+		{"behind", makeRay(4.0, 0.0, 0.0, 1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+	}, func(hit *RayHit, r *Ray) bool {
+		return RayIntersectAABB(hit, r, &box)
+	})
+
+	var hit RayHit
+	r := makeRay(0.0, 0.0, 0.0, 1.0, 0.0, 0.0)
+	AABBMakeEmpty(&box)
+	if RayIntersectAABB(&hit, &r, &box) {
+		t.Errorf("ray hit the empty box")
+	}
+}
+
+func TestRayIntersectOBB(t *testing.T) {
+	var box OBB
+	var center Point3
+	var axes Matrix3
+	var extents Vector3
+	// A unit cube turned 45 degrees about Z, centred on (5, 0, 0).
+	P3MakeFromElems(&center, 5.0, 0.0, 0.0)
+	M3MakeRotationZ(&axes, g_PI_OVER_2/2.0)
+	V3MakeFromElems(&extents, 1.0, 1.0, 1.0)
+	OBBMakeFromCenterAxesExtents(&box, &center, &axes, &extents)
+	s := float32(0.70710678)
+	checkRayCases(t, "OBB", []rayCase{
+		{"along X", makeRay(0.0, 0.2, 0.0, 1.0, 0.0, 0.0), true, 5.2 - float32(math.Sqrt2), Vector3{X: -s, Y: s}},
+		{"along a box axis", makeRay(5.0+5.0*s, 5.0*s, 0.0, -s, -s, 0.0), true, 4.0, Vector3{X: s, Y: s}},
+		{"past the corner", makeRay(0.0, 1.5, 0.0, 1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+	}, func(hit *RayHit, r *Ray) bool {
+		return RayIntersectOBB(hit, r, &box)
+	})
+
+	var pnt Point3
+	P3MakeFromElems(&pnt, 6.3, 0.0, 0.0)
+	if !box.ContainsPoint(&pnt) {
+		t.Errorf("%v does not contain %v", box.String(), pnt)
+	}
+	P3MakeFromElems(&pnt, 6.0, 1.0, 0.0)
+	if box.ContainsPoint(&pnt) {
+		t.Errorf("%v contains %v", box.String(), pnt)
+	}
+}
+
+func TestRayIntersectTriangle(t *testing.T) {
+	var pnt0, pnt1, pnt2 Point3
+	P3MakeFromElems(&pnt0, 0.0, 0.0, 0.0)
+	P3MakeFromElems(&pnt1, 2.0, 0.0, 0.0)
+	P3MakeFromElems(&pnt2, 0.0, 2.0, 0.0)
+	checkRayCases(t, "triangle", []rayCase{
+		{"front", makeRay(0.5, 0.5, 3.0, 0.0, 0.0, -1.0), true, 3.0, Vector3{Z: 1.0}},
+		{"back", makeRay(0.5, 0.5, -2.0, 0.0, 0.0, 1.0), true, 2.0, Vector3{Z: -1.0}},
+		{"on an edge", makeRay(1.0, 1.0, 1.0, 0.0, 0.0, -1.0), true, 1.0, Vector3{Z: 1.0}},
+		{"outside the hypotenuse", makeRay(1.1, 1.0, 1.0, 0.0, 0.0, -1.0), false, 0.0, Vector3{}},
+		{"behind", makeRay(0.5, 0.5, -1.0, 0.0, 0.0, -1.0), false, 0.0, Vector3{}},
+		{"in the plane", makeRay(-1.0, 0.5, 0.0, 1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+	}, func(hit *RayHit, r *Ray) bool {
+		return RayIntersectTriangle(hit, r, &pnt0, &pnt1, &pnt2)
+	})
+}
+
+func TestRayIntersectCapsule(t *testing.T) {
+	var pnt0, pnt1 Point3
+	P3MakeFromElems(&pnt0, 0.0, 0.0, 0.0)
+	P3MakeFromElems(&pnt1, 0.0, 4.0, 0.0)
+	checkRayCases(t, "capsule", []rayCase{
+		{"side wall", makeRay(-5.0, 2.0, 0.0, 1.0, 0.0, 0.0), true, 4.0, Vector3{X: -1.0}},
+		{"top cap", makeRay(0.0, 10.0, 0.0, 0.0, -1.0, 0.0), true, 5.0, Vector3{Y: 1.0}},
+		{"bottom cap, off axis", makeRay(0.6, -5.0, 0.0, 0.0, 1.0, 0.0), true, 4.2, Vector3{X: 0.6, Y: -0.8}},
+		{"from inside", makeRay(0.0, 2.0, 0.0, 0.0, 0.0, 1.0), true, 1.0, Vector3{Z: 1.0}},
+		{"passing by", makeRay(-5.0, 2.0, 1.5, 1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+		{"behind", makeRay(-5.0, 2.0, 0.0, -1.0, 0.0, 0.0), false, 0.0, Vector3{}},
+	}, func(hit *RayHit, r *Ray) bool {
+		return RayIntersectCapsule(hit, r, &pnt0, &pnt1, 1.0)
+	})
+}
+
+func TestRayMakeFromPoints(t *testing.T) {
+	var r Ray
+	var pnt0, pnt1, mid, want Point3
+	var hit RayHit
+	P3MakeFromElems(&pnt0, 1.0, 1.0, 1.0)
+	P3MakeFromElems(&pnt1, 1.0, 1.0, 11.0)
+	RayMakeFromPoints(&r, &pnt0, &pnt1)
+	RayGetPoint(&mid, &r, 0.5)
+	P3MakeFromElems(&want, 1.0, 1.0, 6.0)
+	if mid != want {
+		t.Errorf("RayGetPoint(0.5) = %v, want %v", mid, want)
+	}
+	box := makeAABB(0.0, 0.0, 3.0, 2.0, 2.0, 4.0)
+	if !RayIntersectAABB(&hit, &r, &box) || !near(hit.Distance, 0.2, 1e-6) {
+		t.Errorf("segment hit at %v, want 0.2", hit.Distance)
+	}
+}
@@ -0,0 +1,551 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Vector3SoA = Vector3SoAOf[float32]
+
+type Vector4SoA = Vector4SoAOf[float32]
+
+type Point3SoA = Point3SoAOf[float32]
+
+type QuatSoA = QuatSoAOf[float32]
+
+type Matrix3SoA = Matrix3SoAOf[float32]
+
+type Matrix4SoA = Matrix4SoAOf[float32]
+
+type Transform3SoA = Transform3SoAOf[float32]
+
+func V3SoACopy(result, vec *Vector3SoA) {
+	v3SoACopy(result, vec)
+}
+
+func V3SoAMakeFromElems(result *Vector3SoA, x, y, z [4]float32) {
+	v3SoAMakeFromElems(result, x, y, z)
+}
+
+func V3SoAMakeFromP3SoA(result *Vector3SoA, pnt *Point3SoA) {
+	v3SoAMakeFromP3SoA(result, pnt)
+}
+
+// V3SoAMakeFromAoS replicates vec into all four lanes.
+func V3SoAMakeFromAoS(result *Vector3SoA, vec *Vector3) {
+	v3SoAMakeFromAoS(result, vec)
+}
+
+// V3SoAMakeFrom4AoS gathers four vectors into the lanes of result.
+func V3SoAMakeFrom4AoS(result *Vector3SoA, vec0, vec1, vec2, vec3 *Vector3) {
+	v3SoAMakeFrom4AoS(result, vec0, vec1, vec2, vec3)
+}
+
+// V3SoAGet4AoS scatters the four lanes of vec into separate vectors.
+func V3SoAGet4AoS(result0, result1, result2, result3 *Vector3, vec *Vector3SoA) {
+	v3SoAGet4AoS(result0, result1, result2, result3, vec)
+}
+
+func V3SoALerp(result *Vector3SoA, t [4]float32, vec0, vec1 *Vector3SoA) {
+	v3SoALerp(result, t, vec0, vec1)
+}
+
+// V3SoASlerp is V3Slerp applied to each lane.
+func V3SoASlerp(result *Vector3SoA, t [4]float32, unitVec0, unitVec1 *Vector3SoA) {
+	v3SoASlerp(result, t, unitVec0, unitVec1)
+}
+
+func V3SoAAdd(result, vec0, vec1 *Vector3SoA) {
+	v3SoAAdd(result, vec0, vec1)
+}
+
+func V3SoASub(result, vec0, vec1 *Vector3SoA) {
+	v3SoASub(result, vec0, vec1)
+}
+
+func V3SoAAddP3SoA(result *Point3SoA, vec *Vector3SoA, pnt *Point3SoA) {
+	v3SoAAddP3SoA(result, vec, pnt)
+}
+
+func V3SoAScalarMul(result, vec *Vector3SoA, scalar [4]float32) {
+	v3SoAScalarMul(result, vec, scalar)
+}
+
+func V3SoAScalarDiv(result, vec *Vector3SoA, scalar [4]float32) {
+	v3SoAScalarDiv(result, vec, scalar)
+}
+
+func V3SoANeg(result, vec *Vector3SoA) {
+	v3SoANeg(result, vec)
+}
+
+func V3SoAMulPerElem(result, vec0, vec1 *Vector3SoA) {
+	v3SoAMulPerElem(result, vec0, vec1)
+}
+
+func V3SoADivPerElem(result, vec0, vec1 *Vector3SoA) {
+	v3SoADivPerElem(result, vec0, vec1)
+}
+
+func V3SoAAbsPerElem(result, vec *Vector3SoA) {
+	v3SoAAbsPerElem(result, vec)
+}
+
+func V3SoAMaxPerElem(result, vec0, vec1 *Vector3SoA) {
+	v3SoAMaxPerElem(result, vec0, vec1)
+}
+
+func V3SoAMinPerElem(result, vec0, vec1 *Vector3SoA) {
+	v3SoAMinPerElem(result, vec0, vec1)
+}
+
+func V3SoADot(vec0, vec1 *Vector3SoA) [4]float32 {
+	return v3SoADot(vec0, vec1)
+}
+
+func V3SoANormalize(result, vec *Vector3SoA) {
+	v3SoANormalize(result, vec)
+}
+
+func V3SoACross(result, vec0, vec1 *Vector3SoA) {
+	v3SoACross(result, vec0, vec1)
+}
+
+func V3SoASelect(result, vec0, vec1 *Vector3SoA, select1 [4]bool) {
+	v3SoASelect(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func V4SoACopy(result, vec *Vector4SoA) {
+	v4SoACopy(result, vec)
+}
+
+func V4SoAMakeFromElems(result *Vector4SoA, x, y, z, w [4]float32) {
+	v4SoAMakeFromElems(result, x, y, z, w)
+}
+
+func V4SoAMakeFromV3SoAScalar(result *Vector4SoA, xyz *Vector3SoA, w [4]float32) {
+	v4SoAMakeFromV3SoAScalar(result, xyz, w)
+}
+
+func V4SoAMakeFromAoS(result *Vector4SoA, vec *Vector4) {
+	v4SoAMakeFromAoS(result, vec)
+}
+
+func V4SoAMakeFrom4AoS(result *Vector4SoA, vec0, vec1, vec2, vec3 *Vector4) {
+	v4SoAMakeFrom4AoS(result, vec0, vec1, vec2, vec3)
+}
+
+func V4SoAGet4AoS(result0, result1, result2, result3 *Vector4, vec *Vector4SoA) {
+	v4SoAGet4AoS(result0, result1, result2, result3, vec)
+}
+
+func V4SoAGetXYZ(result *Vector3SoA, vec *Vector4SoA) {
+	v4SoAGetXYZ(result, vec)
+}
+
+func V4SoALerp(result *Vector4SoA, t [4]float32, vec0, vec1 *Vector4SoA) {
+	v4SoALerp(result, t, vec0, vec1)
+}
+
+func V4SoAAdd(result, vec0, vec1 *Vector4SoA) {
+	v4SoAAdd(result, vec0, vec1)
+}
+
+func V4SoASub(result, vec0, vec1 *Vector4SoA) {
+	v4SoASub(result, vec0, vec1)
+}
+
+func V4SoAScalarMul(result, vec *Vector4SoA, scalar [4]float32) {
+	v4SoAScalarMul(result, vec, scalar)
+}
+
+func V4SoANeg(result, vec *Vector4SoA) {
+	v4SoANeg(result, vec)
+}
+
+func V4SoAMulPerElem(result, vec0, vec1 *Vector4SoA) {
+	v4SoAMulPerElem(result, vec0, vec1)
+}
+
+func V4SoADot(vec0, vec1 *Vector4SoA) [4]float32 {
+	return v4SoADot(vec0, vec1)
+}
+
+func V4SoANormalize(result, vec *Vector4SoA) {
+	v4SoANormalize(result, vec)
+}
+
+func V4SoASelect(result, vec0, vec1 *Vector4SoA, select1 [4]bool) {
+	v4SoASelect(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func P3SoACopy(result, pnt *Point3SoA) {
+	p3SoACopy(result, pnt)
+}
+
+func P3SoAMakeFromElems(result *Point3SoA, x, y, z [4]float32) {
+	p3SoAMakeFromElems(result, x, y, z)
+}
+
+func P3SoAMakeFromV3SoA(result *Point3SoA, vec *Vector3SoA) {
+	p3SoAMakeFromV3SoA(result, vec)
+}
+
+func P3SoAMakeFromAoS(result *Point3SoA, pnt *Point3) {
+	p3SoAMakeFromAoS(result, pnt)
+}
+
+func P3SoAMakeFrom4AoS(result *Point3SoA, pnt0, pnt1, pnt2, pnt3 *Point3) {
+	p3SoAMakeFrom4AoS(result, pnt0, pnt1, pnt2, pnt3)
+}
+
+func P3SoAGet4AoS(result0, result1, result2, result3 *Point3, pnt *Point3SoA) {
+	p3SoAGet4AoS(result0, result1, result2, result3, pnt)
+}
+
+func P3SoALerp(result *Point3SoA, t [4]float32, pnt0, pnt1 *Point3SoA) {
+	p3SoALerp(result, t, pnt0, pnt1)
+}
+
+func P3SoASub(result *Vector3SoA, pnt0, pnt1 *Point3SoA) {
+	p3SoASub(result, pnt0, pnt1)
+}
+
+func P3SoAAddV3SoA(result, pnt *Point3SoA, vec *Vector3SoA) {
+	p3SoAAddV3SoA(result, pnt, vec)
+}
+
+func P3SoASubV3SoA(result, pnt *Point3SoA, vec *Vector3SoA) {
+	p3SoASubV3SoA(result, pnt, vec)
+}
+
+func P3SoAScale(result, pnt *Point3SoA, scaleVal [4]float32) {
+	p3SoAScale(result, pnt, scaleVal)
+}
+
+func P3SoAMaxPerElem(result, pnt0, pnt1 *Point3SoA) {
+	p3SoAMaxPerElem(result, pnt0, pnt1)
+}
+
+func P3SoAMinPerElem(result, pnt0, pnt1 *Point3SoA) {
+	p3SoAMinPerElem(result, pnt0, pnt1)
+}
+
+func P3SoASelect(result, pnt0, pnt1 *Point3SoA, select1 [4]bool) {
+	p3SoASelect(result, pnt0, pnt1, select1)
+}
+
+/*******/
+
+func QSoACopy(result, quat *QuatSoA) {
+	qSoACopy(result, quat)
+}
+
+func QSoAMakeFromElems(result *QuatSoA, x, y, z, w [4]float32) {
+	qSoAMakeFromElems(result, x, y, z, w)
+}
+
+func QSoAMakeIdentity(result *QuatSoA) {
+	qSoAMakeIdentity(result)
+}
+
+func QSoAMakeRotationAxis(result *QuatSoA, radians [4]float32, unitVec *Vector3SoA) {
+	qSoAMakeRotationAxis(result, radians, unitVec)
+}
+
+func QSoAMakeRotationX(result *QuatSoA, radians [4]float32) {
+	qSoAMakeRotationX(result, radians)
+}
+
+func QSoAMakeRotationY(result *QuatSoA, radians [4]float32) {
+	qSoAMakeRotationY(result, radians)
+}
+
+func QSoAMakeRotationZ(result *QuatSoA, radians [4]float32) {
+	qSoAMakeRotationZ(result, radians)
+}
+
+func QSoAMakeFromAoS(result *QuatSoA, quat *Quat) {
+	qSoAMakeFromAoS(result, quat)
+}
+
+func QSoAMakeFrom4AoS(result *QuatSoA, quat0, quat1, quat2, quat3 *Quat) {
+	qSoAMakeFrom4AoS(result, quat0, quat1, quat2, quat3)
+}
+
+func QSoAGet4AoS(result0, result1, result2, result3 *Quat, quat *QuatSoA) {
+	qSoAGet4AoS(result0, result1, result2, result3, quat)
+}
+
+func QSoALerp(result *QuatSoA, t [4]float32, quat0, quat1 *QuatSoA) {
+	qSoALerp(result, t, quat0, quat1)
+}
+
+// QSoASlerp is QSlerp applied to each lane. Each lane takes the shortest
+// path and falls back to a lerp for nearly equal inputs on its own.
+func QSoASlerp(result *QuatSoA, t [4]float32, unitQuat0, unitQuat1 *QuatSoA) {
+	qSoASlerp(result, t, unitQuat0, unitQuat1)
+}
+
+// QSoASquad is QSquad applied to each lane.
+func QSoASquad(result *QuatSoA, t [4]float32, unitQuat0, unitQuat1, unitQuat2, unitQuat3 *QuatSoA) {
+	qSoASquad(result, t, unitQuat0, unitQuat1, unitQuat2, unitQuat3)
+}
+
+func QSoAAdd(result, quat0, quat1 *QuatSoA) {
+	qSoAAdd(result, quat0, quat1)
+}
+
+func QSoASub(result, quat0, quat1 *QuatSoA) {
+	qSoASub(result, quat0, quat1)
+}
+
+func QSoAScalarMul(result, quat *QuatSoA, scalar [4]float32) {
+	qSoAScalarMul(result, quat, scalar)
+}
+
+func QSoANeg(result, quat *QuatSoA) {
+	qSoANeg(result, quat)
+}
+
+func QSoADot(quat0, quat1 *QuatSoA) [4]float32 {
+	return qSoADot(quat0, quat1)
+}
+
+func QSoANormalize(result, quat *QuatSoA) {
+	qSoANormalize(result, quat)
+}
+
+func QSoAMul(result, quat0, quat1 *QuatSoA) {
+	qSoAMul(result, quat0, quat1)
+}
+
+func QSoARotate(result *Vector3SoA, quat *QuatSoA, vec *Vector3SoA) {
+	qSoARotate(result, quat, vec)
+}
+
+func QSoAConj(result, quat *QuatSoA) {
+	qSoAConj(result, quat)
+}
+
+func QSoASelect(result, quat0, quat1 *QuatSoA, select1 [4]bool) {
+	qSoASelect(result, quat0, quat1, select1)
+}
+
+/*******/
+
+func M3SoACopy(result, mat *Matrix3SoA) {
+	m3SoACopy(result, mat)
+}
+
+func M3SoAMakeFromCols(result *Matrix3SoA, col0, col1, col2 *Vector3SoA) {
+	m3SoAMakeFromCols(result, col0, col1, col2)
+}
+
+func M3SoAMakeIdentity(result *Matrix3SoA) {
+	m3SoAMakeIdentity(result)
+}
+
+func M3SoAMakeFromAoS(result *Matrix3SoA, mat *Matrix3) {
+	m3SoAMakeFromAoS(result, mat)
+}
+
+func M3SoAMakeFrom4AoS(result *Matrix3SoA, mat0, mat1, mat2, mat3 *Matrix3) {
+	m3SoAMakeFrom4AoS(result, mat0, mat1, mat2, mat3)
+}
+
+func M3SoAGet4AoS(result0, result1, result2, result3 *Matrix3, mat *Matrix3SoA) {
+	m3SoAGet4AoS(result0, result1, result2, result3, mat)
+}
+
+func M3SoAMakeFromQSoA(result *Matrix3SoA, unitQuat *QuatSoA) {
+	m3SoAMakeFromQSoA(result, unitQuat)
+}
+
+func M3SoAMakeRotationX(result *Matrix3SoA, radians [4]float32) {
+	m3SoAMakeRotationX(result, radians)
+}
+
+func M3SoAMakeRotationY(result *Matrix3SoA, radians [4]float32) {
+	m3SoAMakeRotationY(result, radians)
+}
+
+func M3SoAMakeRotationZ(result *Matrix3SoA, radians [4]float32) {
+	m3SoAMakeRotationZ(result, radians)
+}
+
+func M3SoAMakeRotationAxis(result *Matrix3SoA, radians [4]float32, unitVec *Vector3SoA) {
+	m3SoAMakeRotationAxis(result, radians, unitVec)
+}
+
+func M3SoATranspose(result, mat *Matrix3SoA) {
+	m3SoATranspose(result, mat)
+}
+
+func M3SoAInverse(result, mat *Matrix3SoA) {
+	m3SoAInverse(result, mat)
+}
+
+func M3SoAAdd(result, mat0, mat1 *Matrix3SoA) {
+	m3SoAAdd(result, mat0, mat1)
+}
+
+func M3SoASub(result, mat0, mat1 *Matrix3SoA) {
+	m3SoASub(result, mat0, mat1)
+}
+
+func M3SoAScalarMul(result, mat *Matrix3SoA, scalar [4]float32) {
+	m3SoAScalarMul(result, mat, scalar)
+}
+
+func M3SoAMulV3SoA(result *Vector3SoA, mat *Matrix3SoA, vec *Vector3SoA) {
+	m3SoAMulV3SoA(result, mat, vec)
+}
+
+func M3SoAMul(result, mat0, mat1 *Matrix3SoA) {
+	m3SoAMul(result, mat0, mat1)
+}
+
+func M3SoASelect(result, mat0, mat1 *Matrix3SoA, select1 [4]bool) {
+	m3SoASelect(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func M4SoACopy(result, mat *Matrix4SoA) {
+	m4SoACopy(result, mat)
+}
+
+func M4SoAMakeFromCols(result *Matrix4SoA, col0, col1, col2, col3 *Vector4SoA) {
+	m4SoAMakeFromCols(result, col0, col1, col2, col3)
+}
+
+func M4SoAMakeIdentity(result *Matrix4SoA) {
+	m4SoAMakeIdentity(result)
+}
+
+func M4SoAMakeFromT3SoA(result *Matrix4SoA, tfrm *Transform3SoA) {
+	m4SoAMakeFromT3SoA(result, tfrm)
+}
+
+func M4SoAMakeFromAoS(result *Matrix4SoA, mat *Matrix4) {
+	m4SoAMakeFromAoS(result, mat)
+}
+
+func M4SoAMakeFrom4AoS(result *Matrix4SoA, mat0, mat1, mat2, mat3 *Matrix4) {
+	m4SoAMakeFrom4AoS(result, mat0, mat1, mat2, mat3)
+}
+
+func M4SoAGet4AoS(result0, result1, result2, result3 *Matrix4, mat *Matrix4SoA) {
+	m4SoAGet4AoS(result0, result1, result2, result3, mat)
+}
+
+func M4SoATranspose(result, mat *Matrix4SoA) {
+	m4SoATranspose(result, mat)
+}
+
+// M4SoAInverse inverts each lane with M4Inverse.
+func M4SoAInverse(result, mat *Matrix4SoA) {
+	m4SoAInverse(result, mat)
+}
+
+// M4SoAAffineInverse inverts each lane with M4AffineInverse.
+func M4SoAAffineInverse(result, mat *Matrix4SoA) {
+	m4SoAAffineInverse(result, mat)
+}
+
+func M4SoAAdd(result, mat0, mat1 *Matrix4SoA) {
+	m4SoAAdd(result, mat0, mat1)
+}
+
+func M4SoASub(result, mat0, mat1 *Matrix4SoA) {
+	m4SoASub(result, mat0, mat1)
+}
+
+func M4SoAScalarMul(result, mat *Matrix4SoA, scalar [4]float32) {
+	m4SoAScalarMul(result, mat, scalar)
+}
+
+func M4SoAMulV4SoA(result *Vector4SoA, mat *Matrix4SoA, vec *Vector4SoA) {
+	m4SoAMulV4SoA(result, mat, vec)
+}
+
+func M4SoAMulV3SoA(result *Vector4SoA, mat *Matrix4SoA, vec *Vector3SoA) {
+	m4SoAMulV3SoA(result, mat, vec)
+}
+
+func M4SoAMulP3SoA(result *Vector4SoA, mat *Matrix4SoA, pnt *Point3SoA) {
+	m4SoAMulP3SoA(result, mat, pnt)
+}
+
+func M4SoAMul(result, mat0, mat1 *Matrix4SoA) {
+	m4SoAMul(result, mat0, mat1)
+}
+
+func M4SoAMulT3SoA(result, mat *Matrix4SoA, tfrm *Transform3SoA) {
+	m4SoAMulT3SoA(result, mat, tfrm)
+}
+
+func M4SoASelect(result, mat0, mat1 *Matrix4SoA, select1 [4]bool) {
+	m4SoASelect(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func T3SoACopy(result, tfrm *Transform3SoA) {
+	t3SoACopy(result, tfrm)
+}
+
+func T3SoAMakeFromCols(result *Transform3SoA, col0, col1, col2, col3 *Vector3SoA) {
+	t3SoAMakeFromCols(result, col0, col1, col2, col3)
+}
+
+func T3SoAMakeIdentity(result *Transform3SoA) {
+	t3SoAMakeIdentity(result)
+}
+
+func T3SoAMakeFromM3SoAV3SoA(result *Transform3SoA, tfrm *Matrix3SoA, translateVec *Vector3SoA) {
+	t3SoAMakeFromM3SoAV3SoA(result, tfrm, translateVec)
+}
+
+func T3SoAMakeFromQSoAV3SoA(result *Transform3SoA, unitQuat *QuatSoA, translateVec *Vector3SoA) {
+	t3SoAMakeFromQSoAV3SoA(result, unitQuat, translateVec)
+}
+
+func T3SoAMakeFromAoS(result *Transform3SoA, tfrm *Transform3) {
+	t3SoAMakeFromAoS(result, tfrm)
+}
+
+func T3SoAMakeFrom4AoS(result *Transform3SoA, tfrm0, tfrm1, tfrm2, tfrm3 *Transform3) {
+	t3SoAMakeFrom4AoS(result, tfrm0, tfrm1, tfrm2, tfrm3)
+}
+
+func T3SoAGet4AoS(result0, result1, result2, result3 *Transform3, tfrm *Transform3SoA) {
+	t3SoAGet4AoS(result0, result1, result2, result3, tfrm)
+}
+
+func T3SoAInverse(result, tfrm *Transform3SoA) {
+	t3SoAInverse(result, tfrm)
+}
+
+func T3SoAOrthoInverse(result, tfrm *Transform3SoA) {
+	t3SoAOrthoInverse(result, tfrm)
+}
+
+func T3SoAMulV3SoA(result *Vector3SoA, tfrm *Transform3SoA, vec *Vector3SoA) {
+	t3SoAMulV3SoA(result, tfrm, vec)
+}
+
+func T3SoAMulP3SoA(result *Point3SoA, tfrm *Transform3SoA, pnt *Point3SoA) {
+	t3SoAMulP3SoA(result, tfrm, pnt)
+}
+
+func T3SoAMul(result, tfrm0, tfrm1 *Transform3SoA) {
+	t3SoAMul(result, tfrm0, tfrm1)
+}
+
+func T3SoASelect(result, tfrm0, tfrm1 *Transform3SoA, select1 [4]bool) {
+	t3SoASelect(result, tfrm0, tfrm1, select1)
+}
@@ -0,0 +1,1358 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+// The SoA (structure of arrays) types hold four independent values of the
+// matching AoS type, one per lane, with each component stored as an array
+// of four. Every operation works on all four lanes at once; lane i of the
+// result depends only on lane i of the arguments. Per-lane scalars, such as
+// the result of a dot product or the t of a lerp, are passed as [4]T.
+//
+// The SoA set covers the AoS arithmetic, products, inverses, lerps, slerps,
+// squad and the M3 and quaternion rotation builders. M4 and Transform3
+// rotations come from the M3 ones through T3SoAMakeFromM3SoAV3SoA and
+// M4SoAMakeFromT3SoA. Left out are the builders whose inputs are normally
+// the same in all four lanes, where building one AoS value and replicating
+// it with the MakeFromAoS functions is cheaper: perspective, frustum,
+// orthographic, look-at, scale and translation. The iterative and branchy
+// AoS operations (Euler angles, decomposition, SVD, eigen, log and exp) are
+// left out as well; apply them to each lane with GetLane and SetLane.
+
+type Vector3SoAOf[T Float] struct {
+	X, Y, Z [4]T
+}
+
+type Vector4SoAOf[T Float] struct {
+	X, Y, Z, W [4]T
+}
+
+type Point3SoAOf[T Float] struct {
+	X, Y, Z [4]T
+}
+
+type QuatSoAOf[T Float] struct {
+	X, Y, Z, W [4]T
+}
+
+type Matrix3SoAOf[T Float] struct {
+	col0, col1, col2 Vector3SoAOf[T]
+}
+
+type Matrix4SoAOf[T Float] struct {
+	col0, col1, col2, col3 Vector4SoAOf[T]
+}
+
+type Transform3SoAOf[T Float] struct {
+	col0, col1, col2, col3 Vector3SoAOf[T]
+}
+
+/*******/
+
+func v3SoACopy[T Float](result, vec *Vector3SoAOf[T]) {
+	*result = *vec
+}
+
+func v3SoAMakeFromElems[T Float](result *Vector3SoAOf[T], x, y, z [4]T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+}
+
+func v3SoAMakeFromP3SoA[T Float](result *Vector3SoAOf[T], pnt *Point3SoAOf[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+	result.Z = pnt.Z
+}
+
+// v3SoAMakeFromAoS replicates vec into all four lanes.
+func v3SoAMakeFromAoS[T Float](result *Vector3SoAOf[T], vec *Vector3Of[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec.X
+		result.Y[i] = vec.Y
+		result.Z[i] = vec.Z
+	}
+}
+
+func v3SoAMakeFrom4AoS[T Float](result *Vector3SoAOf[T], vec0, vec1, vec2, vec3 *Vector3Of[T]) {
+	result.X = [4]T{vec0.X, vec1.X, vec2.X, vec3.X}
+	result.Y = [4]T{vec0.Y, vec1.Y, vec2.Y, vec3.Y}
+	result.Z = [4]T{vec0.Z, vec1.Z, vec2.Z, vec3.Z}
+}
+
+func v3SoAGet4AoS[T Float](result0, result1, result2, result3 *Vector3Of[T], vec *Vector3SoAOf[T]) {
+	v3MakeFromElems(result0, vec.X[0], vec.Y[0], vec.Z[0])
+	v3MakeFromElems(result1, vec.X[1], vec.Y[1], vec.Z[1])
+	v3MakeFromElems(result2, vec.X[2], vec.Y[2], vec.Z[2])
+	v3MakeFromElems(result3, vec.X[3], vec.Y[3], vec.Z[3])
+}
+
+func v3SoALerp[T Float](result *Vector3SoAOf[T], t [4]T, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] + (vec1.X[i]-vec0.X[i])*t[i]
+		result.Y[i] = vec0.Y[i] + (vec1.Y[i]-vec0.Y[i])*t[i]
+		result.Z[i] = vec0.Z[i] + (vec1.Z[i]-vec0.Z[i])*t[i]
+	}
+}
+
+func v3SoASlerp[T Float](result *Vector3SoAOf[T], t [4]T, unitVec0, unitVec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		var scale0, scale1 T
+		cosAngle := unitVec0.X[i]*unitVec1.X[i] + unitVec0.Y[i]*unitVec1.Y[i] + unitVec0.Z[i]*unitVec1.Z[i]
+		if cosAngle < g_SLERP_TOL {
+			angle := acos(cosAngle)
+			recipSinAngle := 1.0 / sin(angle)
+			scale0 = sin((1.0-t[i])*angle) * recipSinAngle
+			scale1 = sin(t[i]*angle) * recipSinAngle
+		} else {
+			scale0 = 1.0 - t[i]
+			scale1 = t[i]
+		}
+		result.X[i] = unitVec0.X[i]*scale0 + unitVec1.X[i]*scale1
+		result.Y[i] = unitVec0.Y[i]*scale0 + unitVec1.Y[i]*scale1
+		result.Z[i] = unitVec0.Z[i]*scale0 + unitVec1.Z[i]*scale1
+	}
+}
+
+func v3SoAAdd[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] + vec1.X[i]
+		result.Y[i] = vec0.Y[i] + vec1.Y[i]
+		result.Z[i] = vec0.Z[i] + vec1.Z[i]
+	}
+}
+
+func v3SoASub[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] - vec1.X[i]
+		result.Y[i] = vec0.Y[i] - vec1.Y[i]
+		result.Z[i] = vec0.Z[i] - vec1.Z[i]
+	}
+}
+
+func v3SoAAddP3SoA[T Float](result *Point3SoAOf[T], vec *Vector3SoAOf[T], pnt *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec.X[i] + pnt.X[i]
+		result.Y[i] = vec.Y[i] + pnt.Y[i]
+		result.Z[i] = vec.Z[i] + pnt.Z[i]
+	}
+}
+
+func v3SoAScalarMul[T Float](result, vec *Vector3SoAOf[T], scalar [4]T) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec.X[i] * scalar[i]
+		result.Y[i] = vec.Y[i] * scalar[i]
+		result.Z[i] = vec.Z[i] * scalar[i]
+	}
+}
+
+func v3SoAScalarDiv[T Float](result, vec *Vector3SoAOf[T], scalar [4]T) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec.X[i] / scalar[i]
+		result.Y[i] = vec.Y[i] / scalar[i]
+		result.Z[i] = vec.Z[i] / scalar[i]
+	}
+}
+
+func v3SoANeg[T Float](result, vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = -vec.X[i]
+		result.Y[i] = -vec.Y[i]
+		result.Z[i] = -vec.Z[i]
+	}
+}
+
+func v3SoAMulPerElem[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] * vec1.X[i]
+		result.Y[i] = vec0.Y[i] * vec1.Y[i]
+		result.Z[i] = vec0.Z[i] * vec1.Z[i]
+	}
+}
+
+func v3SoADivPerElem[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] / vec1.X[i]
+		result.Y[i] = vec0.Y[i] / vec1.Y[i]
+		result.Z[i] = vec0.Z[i] / vec1.Z[i]
+	}
+}
+
+func v3SoAAbsPerElem[T Float](result, vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = abs(vec.X[i])
+		result.Y[i] = abs(vec.Y[i])
+		result.Z[i] = abs(vec.Z[i])
+	}
+}
+
+func v3SoAMaxPerElem[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = max(vec0.X[i], vec1.X[i])
+		result.Y[i] = max(vec0.Y[i], vec1.Y[i])
+		result.Z[i] = max(vec0.Z[i], vec1.Z[i])
+	}
+}
+
+func v3SoAMinPerElem[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = min(vec0.X[i], vec1.X[i])
+		result.Y[i] = min(vec0.Y[i], vec1.Y[i])
+		result.Z[i] = min(vec0.Z[i], vec1.Z[i])
+	}
+}
+
+func v3SoADot[T Float](vec0, vec1 *Vector3SoAOf[T]) [4]T {
+	var result [4]T
+	for i := 0; i < 4; i++ {
+		result[i] = vec0.X[i]*vec1.X[i] + vec0.Y[i]*vec1.Y[i] + vec0.Z[i]*vec1.Z[i]
+	}
+	return result
+}
+
+func v3SoANormalize[T Float](result, vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		lenInv := 1.0 / sqrt(vec.X[i]*vec.X[i]+vec.Y[i]*vec.Y[i]+vec.Z[i]*vec.Z[i])
+		result.X[i] = vec.X[i] * lenInv
+		result.Y[i] = vec.Y[i] * lenInv
+		result.Z[i] = vec.Z[i] * lenInv
+	}
+}
+
+func v3SoACross[T Float](result, vec0, vec1 *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		tmpX := vec0.Y[i]*vec1.Z[i] - vec0.Z[i]*vec1.Y[i]
+		tmpY := vec0.Z[i]*vec1.X[i] - vec0.X[i]*vec1.Z[i]
+		tmpZ := vec0.X[i]*vec1.Y[i] - vec0.Y[i]*vec1.X[i]
+		result.X[i] = tmpX
+		result.Y[i] = tmpY
+		result.Z[i] = tmpZ
+	}
+}
+
+func v3SoASelect[T Float](result, vec0, vec1 *Vector3SoAOf[T], select1 [4]bool) {
+	for i := 0; i < 4; i++ {
+		if select1[i] {
+			result.X[i] = vec1.X[i]
+			result.Y[i] = vec1.Y[i]
+			result.Z[i] = vec1.Z[i]
+		} else {
+			result.X[i] = vec0.X[i]
+			result.Y[i] = vec0.Y[i]
+			result.Z[i] = vec0.Z[i]
+		}
+	}
+}
+
+func (v *Vector3SoAOf[T]) GetLane(lane int) Vector3Of[T] {
+	return Vector3Of[T]{X: v.X[lane], Y: v.Y[lane], Z: v.Z[lane]}
+}
+
+func (v *Vector3SoAOf[T]) SetLane(lane int, vec *Vector3Of[T]) {
+	v.X[lane] = vec.X
+	v.Y[lane] = vec.Y
+	v.Z[lane] = vec.Z
+}
+
+func (v Vector3SoAOf[T]) LengthSqr() [4]T {
+	return v3SoADot(&v, &v)
+}
+
+func (v Vector3SoAOf[T]) Length() [4]T {
+	result := v3SoADot(&v, &v)
+	for i := 0; i < 4; i++ {
+		result[i] = sqrt(result[i])
+	}
+	return result
+}
+
+func (v *Vector3SoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		s += fmt.Sprintf("( %f %f %f )\n", v.X[i], v.Y[i], v.Z[i])
+	}
+	return s
+}
+
+/*******/
+
+func v4SoACopy[T Float](result, vec *Vector4SoAOf[T]) {
+	*result = *vec
+}
+
+func v4SoAMakeFromElems[T Float](result *Vector4SoAOf[T], x, y, z, w [4]T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+	result.W = w
+}
+
+func v4SoAMakeFromV3SoAScalar[T Float](result *Vector4SoAOf[T], xyz *Vector3SoAOf[T], w [4]T) {
+	result.X = xyz.X
+	result.Y = xyz.Y
+	result.Z = xyz.Z
+	result.W = w
+}
+
+func v4SoAMakeFromAoS[T Float](result *Vector4SoAOf[T], vec *Vector4Of[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec.X
+		result.Y[i] = vec.Y
+		result.Z[i] = vec.Z
+		result.W[i] = vec.W
+	}
+}
+
+func v4SoAMakeFrom4AoS[T Float](result *Vector4SoAOf[T], vec0, vec1, vec2, vec3 *Vector4Of[T]) {
+	result.X = [4]T{vec0.X, vec1.X, vec2.X, vec3.X}
+	result.Y = [4]T{vec0.Y, vec1.Y, vec2.Y, vec3.Y}
+	result.Z = [4]T{vec0.Z, vec1.Z, vec2.Z, vec3.Z}
+	result.W = [4]T{vec0.W, vec1.W, vec2.W, vec3.W}
+}
+
+func v4SoAGet4AoS[T Float](result0, result1, result2, result3 *Vector4Of[T], vec *Vector4SoAOf[T]) {
+	v4MakeFromElems(result0, vec.X[0], vec.Y[0], vec.Z[0], vec.W[0])
+	v4MakeFromElems(result1, vec.X[1], vec.Y[1], vec.Z[1], vec.W[1])
+	v4MakeFromElems(result2, vec.X[2], vec.Y[2], vec.Z[2], vec.W[2])
+	v4MakeFromElems(result3, vec.X[3], vec.Y[3], vec.Z[3], vec.W[3])
+}
+
+func v4SoAGetXYZ[T Float](result *Vector3SoAOf[T], vec *Vector4SoAOf[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+}
+
+func v4SoALerp[T Float](result *Vector4SoAOf[T], t [4]T, vec0, vec1 *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] + (vec1.X[i]-vec0.X[i])*t[i]
+		result.Y[i] = vec0.Y[i] + (vec1.Y[i]-vec0.Y[i])*t[i]
+		result.Z[i] = vec0.Z[i] + (vec1.Z[i]-vec0.Z[i])*t[i]
+		result.W[i] = vec0.W[i] + (vec1.W[i]-vec0.W[i])*t[i]
+	}
+}
+
+func v4SoAAdd[T Float](result, vec0, vec1 *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] + vec1.X[i]
+		result.Y[i] = vec0.Y[i] + vec1.Y[i]
+		result.Z[i] = vec0.Z[i] + vec1.Z[i]
+		result.W[i] = vec0.W[i] + vec1.W[i]
+	}
+}
+
+func v4SoASub[T Float](result, vec0, vec1 *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] - vec1.X[i]
+		result.Y[i] = vec0.Y[i] - vec1.Y[i]
+		result.Z[i] = vec0.Z[i] - vec1.Z[i]
+		result.W[i] = vec0.W[i] - vec1.W[i]
+	}
+}
+
+func v4SoAScalarMul[T Float](result, vec *Vector4SoAOf[T], scalar [4]T) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec.X[i] * scalar[i]
+		result.Y[i] = vec.Y[i] * scalar[i]
+		result.Z[i] = vec.Z[i] * scalar[i]
+		result.W[i] = vec.W[i] * scalar[i]
+	}
+}
+
+func v4SoANeg[T Float](result, vec *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = -vec.X[i]
+		result.Y[i] = -vec.Y[i]
+		result.Z[i] = -vec.Z[i]
+		result.W[i] = -vec.W[i]
+	}
+}
+
+func v4SoAMulPerElem[T Float](result, vec0, vec1 *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = vec0.X[i] * vec1.X[i]
+		result.Y[i] = vec0.Y[i] * vec1.Y[i]
+		result.Z[i] = vec0.Z[i] * vec1.Z[i]
+		result.W[i] = vec0.W[i] * vec1.W[i]
+	}
+}
+
+func v4SoADot[T Float](vec0, vec1 *Vector4SoAOf[T]) [4]T {
+	var result [4]T
+	for i := 0; i < 4; i++ {
+		result[i] = vec0.X[i]*vec1.X[i] + vec0.Y[i]*vec1.Y[i] + vec0.Z[i]*vec1.Z[i] + vec0.W[i]*vec1.W[i]
+	}
+	return result
+}
+
+func v4SoANormalize[T Float](result, vec *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		lenInv := 1.0 / sqrt(vec.X[i]*vec.X[i]+vec.Y[i]*vec.Y[i]+vec.Z[i]*vec.Z[i]+vec.W[i]*vec.W[i])
+		result.X[i] = vec.X[i] * lenInv
+		result.Y[i] = vec.Y[i] * lenInv
+		result.Z[i] = vec.Z[i] * lenInv
+		result.W[i] = vec.W[i] * lenInv
+	}
+}
+
+func v4SoASelect[T Float](result, vec0, vec1 *Vector4SoAOf[T], select1 [4]bool) {
+	for i := 0; i < 4; i++ {
+		if select1[i] {
+			result.X[i] = vec1.X[i]
+			result.Y[i] = vec1.Y[i]
+			result.Z[i] = vec1.Z[i]
+			result.W[i] = vec1.W[i]
+		} else {
+			result.X[i] = vec0.X[i]
+			result.Y[i] = vec0.Y[i]
+			result.Z[i] = vec0.Z[i]
+			result.W[i] = vec0.W[i]
+		}
+	}
+}
+
+func (v *Vector4SoAOf[T]) GetLane(lane int) Vector4Of[T] {
+	return Vector4Of[T]{X: v.X[lane], Y: v.Y[lane], Z: v.Z[lane], W: v.W[lane]}
+}
+
+func (v *Vector4SoAOf[T]) SetLane(lane int, vec *Vector4Of[T]) {
+	v.X[lane] = vec.X
+	v.Y[lane] = vec.Y
+	v.Z[lane] = vec.Z
+	v.W[lane] = vec.W
+}
+
+func (v Vector4SoAOf[T]) LengthSqr() [4]T {
+	return v4SoADot(&v, &v)
+}
+
+func (v Vector4SoAOf[T]) Length() [4]T {
+	result := v4SoADot(&v, &v)
+	for i := 0; i < 4; i++ {
+		result[i] = sqrt(result[i])
+	}
+	return result
+}
+
+func (v *Vector4SoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		s += fmt.Sprintf("( %f %f %f %f )\n", v.X[i], v.Y[i], v.Z[i], v.W[i])
+	}
+	return s
+}
+
+/*******/
+
+func p3SoACopy[T Float](result, pnt *Point3SoAOf[T]) {
+	*result = *pnt
+}
+
+func p3SoAMakeFromElems[T Float](result *Point3SoAOf[T], x, y, z [4]T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+}
+
+func p3SoAMakeFromV3SoA[T Float](result *Point3SoAOf[T], vec *Vector3SoAOf[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+}
+
+func p3SoAMakeFromAoS[T Float](result *Point3SoAOf[T], pnt *Point3Of[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = pnt.X
+		result.Y[i] = pnt.Y
+		result.Z[i] = pnt.Z
+	}
+}
+
+func p3SoAMakeFrom4AoS[T Float](result *Point3SoAOf[T], pnt0, pnt1, pnt2, pnt3 *Point3Of[T]) {
+	result.X = [4]T{pnt0.X, pnt1.X, pnt2.X, pnt3.X}
+	result.Y = [4]T{pnt0.Y, pnt1.Y, pnt2.Y, pnt3.Y}
+	result.Z = [4]T{pnt0.Z, pnt1.Z, pnt2.Z, pnt3.Z}
+}
+
+func p3SoAGet4AoS[T Float](result0, result1, result2, result3 *Point3Of[T], pnt *Point3SoAOf[T]) {
+	p3MakeFromElems(result0, pnt.X[0], pnt.Y[0], pnt.Z[0])
+	p3MakeFromElems(result1, pnt.X[1], pnt.Y[1], pnt.Z[1])
+	p3MakeFromElems(result2, pnt.X[2], pnt.Y[2], pnt.Z[2])
+	p3MakeFromElems(result3, pnt.X[3], pnt.Y[3], pnt.Z[3])
+}
+
+func p3SoALerp[T Float](result *Point3SoAOf[T], t [4]T, pnt0, pnt1 *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = pnt0.X[i] + (pnt1.X[i]-pnt0.X[i])*t[i]
+		result.Y[i] = pnt0.Y[i] + (pnt1.Y[i]-pnt0.Y[i])*t[i]
+		result.Z[i] = pnt0.Z[i] + (pnt1.Z[i]-pnt0.Z[i])*t[i]
+	}
+}
+
+func p3SoASub[T Float](result *Vector3SoAOf[T], pnt0, pnt1 *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = pnt0.X[i] - pnt1.X[i]
+		result.Y[i] = pnt0.Y[i] - pnt1.Y[i]
+		result.Z[i] = pnt0.Z[i] - pnt1.Z[i]
+	}
+}
+
+func p3SoAAddV3SoA[T Float](result, pnt *Point3SoAOf[T], vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = pnt.X[i] + vec.X[i]
+		result.Y[i] = pnt.Y[i] + vec.Y[i]
+		result.Z[i] = pnt.Z[i] + vec.Z[i]
+	}
+}
+
+func p3SoASubV3SoA[T Float](result, pnt *Point3SoAOf[T], vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = pnt.X[i] - vec.X[i]
+		result.Y[i] = pnt.Y[i] - vec.Y[i]
+		result.Z[i] = pnt.Z[i] - vec.Z[i]
+	}
+}
+
+func p3SoAScale[T Float](result, pnt *Point3SoAOf[T], scaleVal [4]T) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = pnt.X[i] * scaleVal[i]
+		result.Y[i] = pnt.Y[i] * scaleVal[i]
+		result.Z[i] = pnt.Z[i] * scaleVal[i]
+	}
+}
+
+func p3SoAMaxPerElem[T Float](result, pnt0, pnt1 *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = max(pnt0.X[i], pnt1.X[i])
+		result.Y[i] = max(pnt0.Y[i], pnt1.Y[i])
+		result.Z[i] = max(pnt0.Z[i], pnt1.Z[i])
+	}
+}
+
+func p3SoAMinPerElem[T Float](result, pnt0, pnt1 *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = min(pnt0.X[i], pnt1.X[i])
+		result.Y[i] = min(pnt0.Y[i], pnt1.Y[i])
+		result.Z[i] = min(pnt0.Z[i], pnt1.Z[i])
+	}
+}
+
+func p3SoASelect[T Float](result, pnt0, pnt1 *Point3SoAOf[T], select1 [4]bool) {
+	for i := 0; i < 4; i++ {
+		if select1[i] {
+			result.X[i] = pnt1.X[i]
+			result.Y[i] = pnt1.Y[i]
+			result.Z[i] = pnt1.Z[i]
+		} else {
+			result.X[i] = pnt0.X[i]
+			result.Y[i] = pnt0.Y[i]
+			result.Z[i] = pnt0.Z[i]
+		}
+	}
+}
+
+func (p *Point3SoAOf[T]) GetLane(lane int) Point3Of[T] {
+	return Point3Of[T]{X: p.X[lane], Y: p.Y[lane], Z: p.Z[lane]}
+}
+
+func (p *Point3SoAOf[T]) SetLane(lane int, pnt *Point3Of[T]) {
+	p.X[lane] = pnt.X
+	p.Y[lane] = pnt.Y
+	p.Z[lane] = pnt.Z
+}
+
+func (p Point3SoAOf[T]) DistSqr(pnt1 *Point3SoAOf[T]) [4]T {
+	var tmpV3_0 Vector3SoAOf[T]
+	p3SoASub(&tmpV3_0, pnt1, &p)
+	return tmpV3_0.LengthSqr()
+}
+
+func (p Point3SoAOf[T]) Dist(pnt1 *Point3SoAOf[T]) [4]T {
+	var tmpV3_0 Vector3SoAOf[T]
+	p3SoASub(&tmpV3_0, pnt1, &p)
+	return tmpV3_0.Length()
+}
+
+func (p *Point3SoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		s += fmt.Sprintf("( %f %f %f )\n", p.X[i], p.Y[i], p.Z[i])
+	}
+	return s
+}
+
+/*******/
+
+func qSoACopy[T Float](result, quat *QuatSoAOf[T]) {
+	*result = *quat
+}
+
+func qSoAMakeFromElems[T Float](result *QuatSoAOf[T], x, y, z, w [4]T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+	result.W = w
+}
+
+func qSoAMakeIdentity[T Float](result *QuatSoAOf[T]) {
+	result.X = [4]T{}
+	result.Y = [4]T{}
+	result.Z = [4]T{}
+	result.W = [4]T{1.0, 1.0, 1.0, 1.0}
+}
+
+func qSoAMakeRotationAxis[T Float](result *QuatSoAOf[T], radians [4]T, unitVec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i] * 0.5)
+		result.X[i] = unitVec.X[i] * s
+		result.Y[i] = unitVec.Y[i] * s
+		result.Z[i] = unitVec.Z[i] * s
+		result.W[i] = c
+	}
+}
+
+func qSoAMakeRotationX[T Float](result *QuatSoAOf[T], radians [4]T) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i] * 0.5)
+		result.X[i], result.Y[i], result.Z[i], result.W[i] = s, 0.0, 0.0, c
+	}
+}
+
+func qSoAMakeRotationY[T Float](result *QuatSoAOf[T], radians [4]T) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i] * 0.5)
+		result.X[i], result.Y[i], result.Z[i], result.W[i] = 0.0, s, 0.0, c
+	}
+}
+
+func qSoAMakeRotationZ[T Float](result *QuatSoAOf[T], radians [4]T) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i] * 0.5)
+		result.X[i], result.Y[i], result.Z[i], result.W[i] = 0.0, 0.0, s, c
+	}
+}
+
+func qSoAMakeFromAoS[T Float](result *QuatSoAOf[T], quat *QuatOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = quat.X
+		result.Y[i] = quat.Y
+		result.Z[i] = quat.Z
+		result.W[i] = quat.W
+	}
+}
+
+func qSoAMakeFrom4AoS[T Float](result *QuatSoAOf[T], quat0, quat1, quat2, quat3 *QuatOf[T]) {
+	result.X = [4]T{quat0.X, quat1.X, quat2.X, quat3.X}
+	result.Y = [4]T{quat0.Y, quat1.Y, quat2.Y, quat3.Y}
+	result.Z = [4]T{quat0.Z, quat1.Z, quat2.Z, quat3.Z}
+	result.W = [4]T{quat0.W, quat1.W, quat2.W, quat3.W}
+}
+
+func qSoAGet4AoS[T Float](result0, result1, result2, result3 *QuatOf[T], quat *QuatSoAOf[T]) {
+	qMakeFromElems(result0, quat.X[0], quat.Y[0], quat.Z[0], quat.W[0])
+	qMakeFromElems(result1, quat.X[1], quat.Y[1], quat.Z[1], quat.W[1])
+	qMakeFromElems(result2, quat.X[2], quat.Y[2], quat.Z[2], quat.W[2])
+	qMakeFromElems(result3, quat.X[3], quat.Y[3], quat.Z[3], quat.W[3])
+}
+
+func qSoALerp[T Float](result *QuatSoAOf[T], t [4]T, quat0, quat1 *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = quat0.X[i] + (quat1.X[i]-quat0.X[i])*t[i]
+		result.Y[i] = quat0.Y[i] + (quat1.Y[i]-quat0.Y[i])*t[i]
+		result.Z[i] = quat0.Z[i] + (quat1.Z[i]-quat0.Z[i])*t[i]
+		result.W[i] = quat0.W[i] + (quat1.W[i]-quat0.W[i])*t[i]
+	}
+}
+
+// qSoASlerp follows qSlerp, including its shortest path sign flip, with
+// each lane choosing between the slerp and the lerp on its own.
+func qSoASlerp[T Float](result *QuatSoAOf[T], t [4]T, unitQuat0, unitQuat1 *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		var scale0, scale1 T
+		cosAngle := unitQuat0.X[i]*unitQuat1.X[i] + unitQuat0.Y[i]*unitQuat1.Y[i] + unitQuat0.Z[i]*unitQuat1.Z[i] + unitQuat0.W[i]*unitQuat1.W[i]
+		sign := T(1.0)
+		if cosAngle < 0.0 {
+			cosAngle = -cosAngle
+			sign = -1.0
+		}
+		if cosAngle < g_SLERP_TOL {
+			angle := acos(cosAngle)
+			recipSinAngle := 1.0 / sin(angle)
+			scale0 = sin((1.0-t[i])*angle) * recipSinAngle
+			scale1 = sin(t[i]*angle) * recipSinAngle
+		} else {
+			scale0 = 1.0 - t[i]
+			scale1 = t[i]
+		}
+		scale0 *= sign
+		result.X[i] = unitQuat0.X[i]*scale0 + unitQuat1.X[i]*scale1
+		result.Y[i] = unitQuat0.Y[i]*scale0 + unitQuat1.Y[i]*scale1
+		result.Z[i] = unitQuat0.Z[i]*scale0 + unitQuat1.Z[i]*scale1
+		result.W[i] = unitQuat0.W[i]*scale0 + unitQuat1.W[i]*scale1
+	}
+}
+
+func qSoASquad[T Float](result *QuatSoAOf[T], t [4]T, unitQuat0, unitQuat1, unitQuat2, unitQuat3 *QuatSoAOf[T]) {
+	var tmp0, tmp1 QuatSoAOf[T]
+	var t2 [4]T
+	qSoASlerp(&tmp0, t, unitQuat0, unitQuat3)
+	qSoASlerp(&tmp1, t, unitQuat1, unitQuat2)
+	for i := 0; i < 4; i++ {
+		t2[i] = (2.0 * t[i]) * (1.0 - t[i])
+	}
+	qSoASlerp(result, t2, &tmp0, &tmp1)
+}
+
+func qSoAAdd[T Float](result, quat0, quat1 *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = quat0.X[i] + quat1.X[i]
+		result.Y[i] = quat0.Y[i] + quat1.Y[i]
+		result.Z[i] = quat0.Z[i] + quat1.Z[i]
+		result.W[i] = quat0.W[i] + quat1.W[i]
+	}
+}
+
+func qSoASub[T Float](result, quat0, quat1 *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = quat0.X[i] - quat1.X[i]
+		result.Y[i] = quat0.Y[i] - quat1.Y[i]
+		result.Z[i] = quat0.Z[i] - quat1.Z[i]
+		result.W[i] = quat0.W[i] - quat1.W[i]
+	}
+}
+
+func qSoAScalarMul[T Float](result, quat *QuatSoAOf[T], scalar [4]T) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = quat.X[i] * scalar[i]
+		result.Y[i] = quat.Y[i] * scalar[i]
+		result.Z[i] = quat.Z[i] * scalar[i]
+		result.W[i] = quat.W[i] * scalar[i]
+	}
+}
+
+func qSoANeg[T Float](result, quat *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = -quat.X[i]
+		result.Y[i] = -quat.Y[i]
+		result.Z[i] = -quat.Z[i]
+		result.W[i] = -quat.W[i]
+	}
+}
+
+func qSoADot[T Float](quat0, quat1 *QuatSoAOf[T]) [4]T {
+	var result [4]T
+	for i := 0; i < 4; i++ {
+		result[i] = quat0.X[i]*quat1.X[i] + quat0.Y[i]*quat1.Y[i] + quat0.Z[i]*quat1.Z[i] + quat0.W[i]*quat1.W[i]
+	}
+	return result
+}
+
+func qSoANormalize[T Float](result, quat *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		lenInv := 1.0 / sqrt(quat.X[i]*quat.X[i]+quat.Y[i]*quat.Y[i]+quat.Z[i]*quat.Z[i]+quat.W[i]*quat.W[i])
+		result.X[i] = quat.X[i] * lenInv
+		result.Y[i] = quat.Y[i] * lenInv
+		result.Z[i] = quat.Z[i] * lenInv
+		result.W[i] = quat.W[i] * lenInv
+	}
+}
+
+func qSoAMul[T Float](result, quat0, quat1 *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		tmpX := (quat0.W[i] * quat1.X[i]) + (quat0.X[i] * quat1.W[i]) + (quat0.Y[i] * quat1.Z[i]) - (quat0.Z[i] * quat1.Y[i])
+		tmpY := (quat0.W[i] * quat1.Y[i]) + (quat0.Y[i] * quat1.W[i]) + (quat0.Z[i] * quat1.X[i]) - (quat0.X[i] * quat1.Z[i])
+		tmpZ := (quat0.W[i] * quat1.Z[i]) + (quat0.Z[i] * quat1.W[i]) + (quat0.X[i] * quat1.Y[i]) - (quat0.Y[i] * quat1.X[i])
+		tmpW := (quat0.W[i] * quat1.W[i]) - (quat0.X[i] * quat1.X[i]) - (quat0.Y[i] * quat1.Y[i]) - (quat0.Z[i] * quat1.Z[i])
+		result.X[i] = tmpX
+		result.Y[i] = tmpY
+		result.Z[i] = tmpZ
+		result.W[i] = tmpW
+	}
+}
+
+func qSoARotate[T Float](result *Vector3SoAOf[T], quat *QuatSoAOf[T], vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		qx, qy, qz, qw := quat.X[i], quat.Y[i], quat.Z[i], quat.W[i]
+		vx, vy, vz := vec.X[i], vec.Y[i], vec.Z[i]
+		tmpX := (qw * vx) + (qy * vz) - (qz * vy)
+		tmpY := (qw * vy) + (qz * vx) - (qx * vz)
+		tmpZ := (qw * vz) + (qx * vy) - (qy * vx)
+		tmpW := (qx * vx) + (qy * vy) + (qz * vz)
+		result.X[i] = (tmpW * qx) + (tmpX * qw) - (tmpY * qz) + (tmpZ * qy)
+		result.Y[i] = (tmpW * qy) + (tmpY * qw) - (tmpZ * qx) + (tmpX * qz)
+		result.Z[i] = (tmpW * qz) + (tmpZ * qw) - (tmpX * qy) + (tmpY * qx)
+	}
+}
+
+func qSoAConj[T Float](result, quat *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		result.X[i] = -quat.X[i]
+		result.Y[i] = -quat.Y[i]
+		result.Z[i] = -quat.Z[i]
+		result.W[i] = quat.W[i]
+	}
+}
+
+func qSoASelect[T Float](result, quat0, quat1 *QuatSoAOf[T], select1 [4]bool) {
+	for i := 0; i < 4; i++ {
+		if select1[i] {
+			result.X[i] = quat1.X[i]
+			result.Y[i] = quat1.Y[i]
+			result.Z[i] = quat1.Z[i]
+			result.W[i] = quat1.W[i]
+		} else {
+			result.X[i] = quat0.X[i]
+			result.Y[i] = quat0.Y[i]
+			result.Z[i] = quat0.Z[i]
+			result.W[i] = quat0.W[i]
+		}
+	}
+}
+
+func (q *QuatSoAOf[T]) GetLane(lane int) QuatOf[T] {
+	return QuatOf[T]{X: q.X[lane], Y: q.Y[lane], Z: q.Z[lane], W: q.W[lane]}
+}
+
+func (q *QuatSoAOf[T]) SetLane(lane int, quat *QuatOf[T]) {
+	q.X[lane] = quat.X
+	q.Y[lane] = quat.Y
+	q.Z[lane] = quat.Z
+	q.W[lane] = quat.W
+}
+
+func (q QuatSoAOf[T]) Norm() [4]T {
+	return qSoADot(&q, &q)
+}
+
+func (q QuatSoAOf[T]) Length() [4]T {
+	result := qSoADot(&q, &q)
+	for i := 0; i < 4; i++ {
+		result[i] = sqrt(result[i])
+	}
+	return result
+}
+
+func (q *QuatSoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		s += fmt.Sprintf("( %f %f %f %f )\n", q.X[i], q.Y[i], q.Z[i], q.W[i])
+	}
+	return s
+}
+
+/*******/
+
+func m3SoACopy[T Float](result, mat *Matrix3SoAOf[T]) {
+	*result = *mat
+}
+
+func m3SoAMakeFromCols[T Float](result *Matrix3SoAOf[T], col0, col1, col2 *Vector3SoAOf[T]) {
+	result.col0 = *col0
+	result.col1 = *col1
+	result.col2 = *col2
+}
+
+func m3SoAMakeIdentity[T Float](result *Matrix3SoAOf[T]) {
+	var mat Matrix3Of[T]
+	m3MakeIdentity(&mat)
+	m3SoAMakeFromAoS(result, &mat)
+}
+
+func m3SoAMakeFromAoS[T Float](result *Matrix3SoAOf[T], mat *Matrix3Of[T]) {
+	v3SoAMakeFromAoS(&result.col0, &mat.col0)
+	v3SoAMakeFromAoS(&result.col1, &mat.col1)
+	v3SoAMakeFromAoS(&result.col2, &mat.col2)
+}
+
+func m3SoAMakeFrom4AoS[T Float](result *Matrix3SoAOf[T], mat0, mat1, mat2, mat3 *Matrix3Of[T]) {
+	v3SoAMakeFrom4AoS(&result.col0, &mat0.col0, &mat1.col0, &mat2.col0, &mat3.col0)
+	v3SoAMakeFrom4AoS(&result.col1, &mat0.col1, &mat1.col1, &mat2.col1, &mat3.col1)
+	v3SoAMakeFrom4AoS(&result.col2, &mat0.col2, &mat1.col2, &mat2.col2, &mat3.col2)
+}
+
+func m3SoAGet4AoS[T Float](result0, result1, result2, result3 *Matrix3Of[T], mat *Matrix3SoAOf[T]) {
+	v3SoAGet4AoS(&result0.col0, &result1.col0, &result2.col0, &result3.col0, &mat.col0)
+	v3SoAGet4AoS(&result0.col1, &result1.col1, &result2.col1, &result3.col1, &mat.col1)
+	v3SoAGet4AoS(&result0.col2, &result1.col2, &result2.col2, &result3.col2, &mat.col2)
+}
+
+func m3SoAMakeFromQSoA[T Float](result *Matrix3SoAOf[T], unitQuat *QuatSoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		qx, qy, qz, qw := unitQuat.X[i], unitQuat.Y[i], unitQuat.Z[i], unitQuat.W[i]
+		qx2 := qx + qx
+		qy2 := qy + qy
+		qz2 := qz + qz
+		qxqx2 := qx * qx2
+		qxqy2 := qx * qy2
+		qxqz2 := qx * qz2
+		qxqw2 := qw * qx2
+		qyqy2 := qy * qy2
+		qyqz2 := qy * qz2
+		qyqw2 := qw * qy2
+		qzqz2 := qz * qz2
+		qzqw2 := qw * qz2
+		result.col0.X[i] = (1.0 - qyqy2) - qzqz2
+		result.col0.Y[i] = qxqy2 + qzqw2
+		result.col0.Z[i] = qxqz2 - qyqw2
+		result.col1.X[i] = qxqy2 - qzqw2
+		result.col1.Y[i] = (1.0 - qxqx2) - qzqz2
+		result.col1.Z[i] = qyqz2 + qxqw2
+		result.col2.X[i] = qxqz2 + qyqw2
+		result.col2.Y[i] = qyqz2 - qxqw2
+		result.col2.Z[i] = (1.0 - qxqx2) - qyqy2
+	}
+}
+
+func m3SoAMakeRotationX[T Float](result *Matrix3SoAOf[T], radians [4]T) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i])
+		result.col0.X[i], result.col0.Y[i], result.col0.Z[i] = 1.0, 0.0, 0.0
+		result.col1.X[i], result.col1.Y[i], result.col1.Z[i] = 0.0, c, s
+		result.col2.X[i], result.col2.Y[i], result.col2.Z[i] = 0.0, -s, c
+	}
+}
+
+func m3SoAMakeRotationY[T Float](result *Matrix3SoAOf[T], radians [4]T) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i])
+		result.col0.X[i], result.col0.Y[i], result.col0.Z[i] = c, 0.0, -s
+		result.col1.X[i], result.col1.Y[i], result.col1.Z[i] = 0.0, 1.0, 0.0
+		result.col2.X[i], result.col2.Y[i], result.col2.Z[i] = s, 0.0, c
+	}
+}
+
+func m3SoAMakeRotationZ[T Float](result *Matrix3SoAOf[T], radians [4]T) {
+	for i := 0; i < 4; i++ {
+		s, c := sincos(radians[i])
+		result.col0.X[i], result.col0.Y[i], result.col0.Z[i] = c, s, 0.0
+		result.col1.X[i], result.col1.Y[i], result.col1.Z[i] = -s, c, 0.0
+		result.col2.X[i], result.col2.Y[i], result.col2.Z[i] = 0.0, 0.0, 1.0
+	}
+}
+
+func m3SoAMakeRotationAxis[T Float](result *Matrix3SoAOf[T], radians [4]T, unitVec *Vector3SoAOf[T]) {
+	var unitQuat QuatSoAOf[T]
+	qSoAMakeRotationAxis(&unitQuat, radians, unitVec)
+	m3SoAMakeFromQSoA(result, &unitQuat)
+}
+
+func m3SoATranspose[T Float](result, mat *Matrix3SoAOf[T]) {
+	var tmp Matrix3SoAOf[T]
+	tmp.col0.X, tmp.col0.Y, tmp.col0.Z = mat.col0.X, mat.col1.X, mat.col2.X
+	tmp.col1.X, tmp.col1.Y, tmp.col1.Z = mat.col0.Y, mat.col1.Y, mat.col2.Y
+	tmp.col2.X, tmp.col2.Y, tmp.col2.Z = mat.col0.Z, mat.col1.Z, mat.col2.Z
+	*result = tmp
+}
+
+func m3SoAInverse[T Float](result, mat *Matrix3SoAOf[T]) {
+	var tmp0, tmp1, tmp2 Vector3SoAOf[T]
+	v3SoACross(&tmp0, &mat.col1, &mat.col2)
+	v3SoACross(&tmp1, &mat.col2, &mat.col0)
+	v3SoACross(&tmp2, &mat.col0, &mat.col1)
+	detinv := v3SoADot(&mat.col2, &tmp2)
+	for i := 0; i < 4; i++ {
+		detinv[i] = 1.0 / detinv[i]
+	}
+	result.col0.X = [4]T{tmp0.X[0] * detinv[0], tmp0.X[1] * detinv[1], tmp0.X[2] * detinv[2], tmp0.X[3] * detinv[3]}
+	result.col0.Y = [4]T{tmp1.X[0] * detinv[0], tmp1.X[1] * detinv[1], tmp1.X[2] * detinv[2], tmp1.X[3] * detinv[3]}
+	result.col0.Z = [4]T{tmp2.X[0] * detinv[0], tmp2.X[1] * detinv[1], tmp2.X[2] * detinv[2], tmp2.X[3] * detinv[3]}
+	result.col1.X = [4]T{tmp0.Y[0] * detinv[0], tmp0.Y[1] * detinv[1], tmp0.Y[2] * detinv[2], tmp0.Y[3] * detinv[3]}
+	result.col1.Y = [4]T{tmp1.Y[0] * detinv[0], tmp1.Y[1] * detinv[1], tmp1.Y[2] * detinv[2], tmp1.Y[3] * detinv[3]}
+	result.col1.Z = [4]T{tmp2.Y[0] * detinv[0], tmp2.Y[1] * detinv[1], tmp2.Y[2] * detinv[2], tmp2.Y[3] * detinv[3]}
+	result.col2.X = [4]T{tmp0.Z[0] * detinv[0], tmp0.Z[1] * detinv[1], tmp0.Z[2] * detinv[2], tmp0.Z[3] * detinv[3]}
+	result.col2.Y = [4]T{tmp1.Z[0] * detinv[0], tmp1.Z[1] * detinv[1], tmp1.Z[2] * detinv[2], tmp1.Z[3] * detinv[3]}
+	result.col2.Z = [4]T{tmp2.Z[0] * detinv[0], tmp2.Z[1] * detinv[1], tmp2.Z[2] * detinv[2], tmp2.Z[3] * detinv[3]}
+}
+
+func m3SoAAdd[T Float](result, mat0, mat1 *Matrix3SoAOf[T]) {
+	v3SoAAdd(&result.col0, &mat0.col0, &mat1.col0)
+	v3SoAAdd(&result.col1, &mat0.col1, &mat1.col1)
+	v3SoAAdd(&result.col2, &mat0.col2, &mat1.col2)
+}
+
+func m3SoASub[T Float](result, mat0, mat1 *Matrix3SoAOf[T]) {
+	v3SoASub(&result.col0, &mat0.col0, &mat1.col0)
+	v3SoASub(&result.col1, &mat0.col1, &mat1.col1)
+	v3SoASub(&result.col2, &mat0.col2, &mat1.col2)
+}
+
+func m3SoAScalarMul[T Float](result, mat *Matrix3SoAOf[T], scalar [4]T) {
+	v3SoAScalarMul(&result.col0, &mat.col0, scalar)
+	v3SoAScalarMul(&result.col1, &mat.col1, scalar)
+	v3SoAScalarMul(&result.col2, &mat.col2, scalar)
+}
+
+func m3SoAMulV3SoA[T Float](result *Vector3SoAOf[T], mat *Matrix3SoAOf[T], vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		vx, vy, vz := vec.X[i], vec.Y[i], vec.Z[i]
+		result.X[i] = mat.col0.X[i]*vx + mat.col1.X[i]*vy + mat.col2.X[i]*vz
+		result.Y[i] = mat.col0.Y[i]*vx + mat.col1.Y[i]*vy + mat.col2.Y[i]*vz
+		result.Z[i] = mat.col0.Z[i]*vx + mat.col1.Z[i]*vy + mat.col2.Z[i]*vz
+	}
+}
+
+func m3SoAMul[T Float](result, mat0, mat1 *Matrix3SoAOf[T]) {
+	var tmp Matrix3SoAOf[T]
+	m3SoAMulV3SoA(&tmp.col0, mat0, &mat1.col0)
+	m3SoAMulV3SoA(&tmp.col1, mat0, &mat1.col1)
+	m3SoAMulV3SoA(&tmp.col2, mat0, &mat1.col2)
+	*result = tmp
+}
+
+func m3SoASelect[T Float](result, mat0, mat1 *Matrix3SoAOf[T], select1 [4]bool) {
+	v3SoASelect(&result.col0, &mat0.col0, &mat1.col0, select1)
+	v3SoASelect(&result.col1, &mat0.col1, &mat1.col1, select1)
+	v3SoASelect(&result.col2, &mat0.col2, &mat1.col2, select1)
+}
+
+func (m *Matrix3SoAOf[T]) GetLane(lane int) Matrix3Of[T] {
+	return Matrix3Of[T]{m.col0.GetLane(lane), m.col1.GetLane(lane), m.col2.GetLane(lane)}
+}
+
+func (m *Matrix3SoAOf[T]) SetLane(lane int, mat *Matrix3Of[T]) {
+	m.col0.SetLane(lane, &mat.col0)
+	m.col1.SetLane(lane, &mat.col1)
+	m.col2.SetLane(lane, &mat.col2)
+}
+
+func (m Matrix3SoAOf[T]) Determinant() [4]T {
+	var tmpV3_0 Vector3SoAOf[T]
+	v3SoACross(&tmpV3_0, &m.col0, &m.col1)
+	return v3SoADot(&m.col2, &tmpV3_0)
+}
+
+func (m *Matrix3SoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		mat := m.GetLane(i)
+		s += mat.String()
+	}
+	return s
+}
+
+/*******/
+
+func m4SoACopy[T Float](result, mat *Matrix4SoAOf[T]) {
+	*result = *mat
+}
+
+func m4SoAMakeFromCols[T Float](result *Matrix4SoAOf[T], col0, col1, col2, col3 *Vector4SoAOf[T]) {
+	result.col0 = *col0
+	result.col1 = *col1
+	result.col2 = *col2
+	result.col3 = *col3
+}
+
+func m4SoAMakeIdentity[T Float](result *Matrix4SoAOf[T]) {
+	var mat Matrix4Of[T]
+	m4MakeIdentity(&mat)
+	m4SoAMakeFromAoS(result, &mat)
+}
+
+func m4SoAMakeFromT3SoA[T Float](result *Matrix4SoAOf[T], tfrm *Transform3SoAOf[T]) {
+	v4SoAMakeFromV3SoAScalar(&result.col0, &tfrm.col0, [4]T{})
+	v4SoAMakeFromV3SoAScalar(&result.col1, &tfrm.col1, [4]T{})
+	v4SoAMakeFromV3SoAScalar(&result.col2, &tfrm.col2, [4]T{})
+	v4SoAMakeFromV3SoAScalar(&result.col3, &tfrm.col3, [4]T{1.0, 1.0, 1.0, 1.0})
+}
+
+func m4SoAMakeFromAoS[T Float](result *Matrix4SoAOf[T], mat *Matrix4Of[T]) {
+	v4SoAMakeFromAoS(&result.col0, &mat.col0)
+	v4SoAMakeFromAoS(&result.col1, &mat.col1)
+	v4SoAMakeFromAoS(&result.col2, &mat.col2)
+	v4SoAMakeFromAoS(&result.col3, &mat.col3)
+}
+
+func m4SoAMakeFrom4AoS[T Float](result *Matrix4SoAOf[T], mat0, mat1, mat2, mat3 *Matrix4Of[T]) {
+	v4SoAMakeFrom4AoS(&result.col0, &mat0.col0, &mat1.col0, &mat2.col0, &mat3.col0)
+	v4SoAMakeFrom4AoS(&result.col1, &mat0.col1, &mat1.col1, &mat2.col1, &mat3.col1)
+	v4SoAMakeFrom4AoS(&result.col2, &mat0.col2, &mat1.col2, &mat2.col2, &mat3.col2)
+	v4SoAMakeFrom4AoS(&result.col3, &mat0.col3, &mat1.col3, &mat2.col3, &mat3.col3)
+}
+
+func m4SoAGet4AoS[T Float](result0, result1, result2, result3 *Matrix4Of[T], mat *Matrix4SoAOf[T]) {
+	v4SoAGet4AoS(&result0.col0, &result1.col0, &result2.col0, &result3.col0, &mat.col0)
+	v4SoAGet4AoS(&result0.col1, &result1.col1, &result2.col1, &result3.col1, &mat.col1)
+	v4SoAGet4AoS(&result0.col2, &result1.col2, &result2.col2, &result3.col2, &mat.col2)
+	v4SoAGet4AoS(&result0.col3, &result1.col3, &result2.col3, &result3.col3, &mat.col3)
+}
+
+func m4SoATranspose[T Float](result, mat *Matrix4SoAOf[T]) {
+	var tmp Matrix4SoAOf[T]
+	tmp.col0.X, tmp.col0.Y, tmp.col0.Z, tmp.col0.W = mat.col0.X, mat.col1.X, mat.col2.X, mat.col3.X
+	tmp.col1.X, tmp.col1.Y, tmp.col1.Z, tmp.col1.W = mat.col0.Y, mat.col1.Y, mat.col2.Y, mat.col3.Y
+	tmp.col2.X, tmp.col2.Y, tmp.col2.Z, tmp.col2.W = mat.col0.Z, mat.col1.Z, mat.col2.Z, mat.col3.Z
+	tmp.col3.X, tmp.col3.Y, tmp.col3.Z, tmp.col3.W = mat.col0.W, mat.col1.W, mat.col2.W, mat.col3.W
+	*result = tmp
+}
+
+// m4SoAInverse inverts each lane with the AoS code, which is long enough
+// that the gather and scatter are lost in the noise.
+func m4SoAInverse[T Float](result, mat *Matrix4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		tmp := mat.GetLane(i)
+		m4Inverse(&tmp, &tmp)
+		result.SetLane(i, &tmp)
+	}
+}
+
+func m4SoAAffineInverse[T Float](result, mat *Matrix4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		tmp := mat.GetLane(i)
+		m4AffineInverse(&tmp, &tmp)
+		result.SetLane(i, &tmp)
+	}
+}
+
+func m4SoAAdd[T Float](result, mat0, mat1 *Matrix4SoAOf[T]) {
+	v4SoAAdd(&result.col0, &mat0.col0, &mat1.col0)
+	v4SoAAdd(&result.col1, &mat0.col1, &mat1.col1)
+	v4SoAAdd(&result.col2, &mat0.col2, &mat1.col2)
+	v4SoAAdd(&result.col3, &mat0.col3, &mat1.col3)
+}
+
+func m4SoASub[T Float](result, mat0, mat1 *Matrix4SoAOf[T]) {
+	v4SoASub(&result.col0, &mat0.col0, &mat1.col0)
+	v4SoASub(&result.col1, &mat0.col1, &mat1.col1)
+	v4SoASub(&result.col2, &mat0.col2, &mat1.col2)
+	v4SoASub(&result.col3, &mat0.col3, &mat1.col3)
+}
+
+func m4SoAScalarMul[T Float](result, mat *Matrix4SoAOf[T], scalar [4]T) {
+	v4SoAScalarMul(&result.col0, &mat.col0, scalar)
+	v4SoAScalarMul(&result.col1, &mat.col1, scalar)
+	v4SoAScalarMul(&result.col2, &mat.col2, scalar)
+	v4SoAScalarMul(&result.col3, &mat.col3, scalar)
+}
+
+func m4SoAMulV4SoA[T Float](result *Vector4SoAOf[T], mat *Matrix4SoAOf[T], vec *Vector4SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		vx, vy, vz, vw := vec.X[i], vec.Y[i], vec.Z[i], vec.W[i]
+		result.X[i] = mat.col0.X[i]*vx + mat.col1.X[i]*vy + mat.col2.X[i]*vz + mat.col3.X[i]*vw
+		result.Y[i] = mat.col0.Y[i]*vx + mat.col1.Y[i]*vy + mat.col2.Y[i]*vz + mat.col3.Y[i]*vw
+		result.Z[i] = mat.col0.Z[i]*vx + mat.col1.Z[i]*vy + mat.col2.Z[i]*vz + mat.col3.Z[i]*vw
+		result.W[i] = mat.col0.W[i]*vx + mat.col1.W[i]*vy + mat.col2.W[i]*vz + mat.col3.W[i]*vw
+	}
+}
+
+func m4SoAMulV3SoA[T Float](result *Vector4SoAOf[T], mat *Matrix4SoAOf[T], vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		vx, vy, vz := vec.X[i], vec.Y[i], vec.Z[i]
+		result.X[i] = mat.col0.X[i]*vx + mat.col1.X[i]*vy + mat.col2.X[i]*vz
+		result.Y[i] = mat.col0.Y[i]*vx + mat.col1.Y[i]*vy + mat.col2.Y[i]*vz
+		result.Z[i] = mat.col0.Z[i]*vx + mat.col1.Z[i]*vy + mat.col2.Z[i]*vz
+		result.W[i] = mat.col0.W[i]*vx + mat.col1.W[i]*vy + mat.col2.W[i]*vz
+	}
+}
+
+func m4SoAMulP3SoA[T Float](result *Vector4SoAOf[T], mat *Matrix4SoAOf[T], pnt *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		px, py, pz := pnt.X[i], pnt.Y[i], pnt.Z[i]
+		result.X[i] = mat.col0.X[i]*px + mat.col1.X[i]*py + mat.col2.X[i]*pz + mat.col3.X[i]
+		result.Y[i] = mat.col0.Y[i]*px + mat.col1.Y[i]*py + mat.col2.Y[i]*pz + mat.col3.Y[i]
+		result.Z[i] = mat.col0.Z[i]*px + mat.col1.Z[i]*py + mat.col2.Z[i]*pz + mat.col3.Z[i]
+		result.W[i] = mat.col0.W[i]*px + mat.col1.W[i]*py + mat.col2.W[i]*pz + mat.col3.W[i]
+	}
+}
+
+func m4SoAMul[T Float](result, mat0, mat1 *Matrix4SoAOf[T]) {
+	var tmp Matrix4SoAOf[T]
+	m4SoAMulV4SoA(&tmp.col0, mat0, &mat1.col0)
+	m4SoAMulV4SoA(&tmp.col1, mat0, &mat1.col1)
+	m4SoAMulV4SoA(&tmp.col2, mat0, &mat1.col2)
+	m4SoAMulV4SoA(&tmp.col3, mat0, &mat1.col3)
+	*result = tmp
+}
+
+func m4SoAMulT3SoA[T Float](result, mat *Matrix4SoAOf[T], tfrm *Transform3SoAOf[T]) {
+	var tmp Matrix4SoAOf[T]
+	m4SoAMulV3SoA(&tmp.col0, mat, &tfrm.col0)
+	m4SoAMulV3SoA(&tmp.col1, mat, &tfrm.col1)
+	m4SoAMulV3SoA(&tmp.col2, mat, &tfrm.col2)
+	var pnt Point3SoAOf[T]
+	p3SoAMakeFromV3SoA(&pnt, &tfrm.col3)
+	m4SoAMulP3SoA(&tmp.col3, mat, &pnt)
+	*result = tmp
+}
+
+func m4SoASelect[T Float](result, mat0, mat1 *Matrix4SoAOf[T], select1 [4]bool) {
+	v4SoASelect(&result.col0, &mat0.col0, &mat1.col0, select1)
+	v4SoASelect(&result.col1, &mat0.col1, &mat1.col1, select1)
+	v4SoASelect(&result.col2, &mat0.col2, &mat1.col2, select1)
+	v4SoASelect(&result.col3, &mat0.col3, &mat1.col3, select1)
+}
+
+func (m *Matrix4SoAOf[T]) GetLane(lane int) Matrix4Of[T] {
+	return Matrix4Of[T]{m.col0.GetLane(lane), m.col1.GetLane(lane), m.col2.GetLane(lane), m.col3.GetLane(lane)}
+}
+
+func (m *Matrix4SoAOf[T]) SetLane(lane int, mat *Matrix4Of[T]) {
+	m.col0.SetLane(lane, &mat.col0)
+	m.col1.SetLane(lane, &mat.col1)
+	m.col2.SetLane(lane, &mat.col2)
+	m.col3.SetLane(lane, &mat.col3)
+}
+
+func (m Matrix4SoAOf[T]) Determinant() [4]T {
+	var result [4]T
+	for i := 0; i < 4; i++ {
+		mA, mB, mC, mD := m.col0.X[i], m.col0.Y[i], m.col0.Z[i], m.col0.W[i]
+		mE, mF, mG, mH := m.col1.X[i], m.col1.Y[i], m.col1.Z[i], m.col1.W[i]
+		mI, mJ, mK, mL := m.col2.X[i], m.col2.Y[i], m.col2.Z[i], m.col2.W[i]
+		mM, mN, mO, mP := m.col3.X[i], m.col3.Y[i], m.col3.Z[i], m.col3.W[i]
+		tmp0 := (mK * mD) - (mC * mL)
+		tmp1 := (mO * mH) - (mG * mP)
+		tmp2 := (mB * mK) - (mJ * mC)
+		tmp3 := (mF * mO) - (mN * mG)
+		tmp4 := (mJ * mD) - (mB * mL)
+		tmp5 := (mN * mH) - (mF * mP)
+		dx := ((mJ * tmp1) - (mL * tmp3)) - (mK * tmp5)
+		dy := ((mN * tmp0) - (mP * tmp2)) - (mO * tmp4)
+		dz := ((mD * tmp3) + (mC * tmp5)) - (mB * tmp1)
+		dw := ((mH * tmp2) + (mG * tmp4)) - (mF * tmp0)
+		result[i] = (((mA * dx) + (mE * dy)) + (mI * dz)) + (mM * dw)
+	}
+	return result
+}
+
+func (m *Matrix4SoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		mat := m.GetLane(i)
+		s += mat.String()
+	}
+	return s
+}
+
+/*******/
+
+func t3SoACopy[T Float](result, tfrm *Transform3SoAOf[T]) {
+	*result = *tfrm
+}
+
+func t3SoAMakeFromCols[T Float](result *Transform3SoAOf[T], col0, col1, col2, col3 *Vector3SoAOf[T]) {
+	result.col0 = *col0
+	result.col1 = *col1
+	result.col2 = *col2
+	result.col3 = *col3
+}
+
+func t3SoAMakeIdentity[T Float](result *Transform3SoAOf[T]) {
+	var tfrm Transform3Of[T]
+	t3MakeIdentity(&tfrm)
+	t3SoAMakeFromAoS(result, &tfrm)
+}
+
+func t3SoAMakeFromM3SoAV3SoA[T Float](result *Transform3SoAOf[T], tfrm *Matrix3SoAOf[T], translateVec *Vector3SoAOf[T]) {
+	result.col0 = tfrm.col0
+	result.col1 = tfrm.col1
+	result.col2 = tfrm.col2
+	result.col3 = *translateVec
+}
+
+func t3SoAMakeFromQSoAV3SoA[T Float](result *Transform3SoAOf[T], unitQuat *QuatSoAOf[T], translateVec *Vector3SoAOf[T]) {
+	var tmpM3_0 Matrix3SoAOf[T]
+	m3SoAMakeFromQSoA(&tmpM3_0, unitQuat)
+	t3SoAMakeFromM3SoAV3SoA(result, &tmpM3_0, translateVec)
+}
+
+func t3SoAMakeFromAoS[T Float](result *Transform3SoAOf[T], tfrm *Transform3Of[T]) {
+	v3SoAMakeFromAoS(&result.col0, &tfrm.col0)
+	v3SoAMakeFromAoS(&result.col1, &tfrm.col1)
+	v3SoAMakeFromAoS(&result.col2, &tfrm.col2)
+	v3SoAMakeFromAoS(&result.col3, &tfrm.col3)
+}
+
+func t3SoAMakeFrom4AoS[T Float](result *Transform3SoAOf[T], tfrm0, tfrm1, tfrm2, tfrm3 *Transform3Of[T]) {
+	v3SoAMakeFrom4AoS(&result.col0, &tfrm0.col0, &tfrm1.col0, &tfrm2.col0, &tfrm3.col0)
+	v3SoAMakeFrom4AoS(&result.col1, &tfrm0.col1, &tfrm1.col1, &tfrm2.col1, &tfrm3.col1)
+	v3SoAMakeFrom4AoS(&result.col2, &tfrm0.col2, &tfrm1.col2, &tfrm2.col2, &tfrm3.col2)
+	v3SoAMakeFrom4AoS(&result.col3, &tfrm0.col3, &tfrm1.col3, &tfrm2.col3, &tfrm3.col3)
+}
+
+func t3SoAGet4AoS[T Float](result0, result1, result2, result3 *Transform3Of[T], tfrm *Transform3SoAOf[T]) {
+	v3SoAGet4AoS(&result0.col0, &result1.col0, &result2.col0, &result3.col0, &tfrm.col0)
+	v3SoAGet4AoS(&result0.col1, &result1.col1, &result2.col1, &result3.col1, &tfrm.col1)
+	v3SoAGet4AoS(&result0.col2, &result1.col2, &result2.col2, &result3.col2, &tfrm.col2)
+	v3SoAGet4AoS(&result0.col3, &result1.col3, &result2.col3, &result3.col3, &tfrm.col3)
+}
+
+func t3SoAInverse[T Float](result, tfrm *Transform3SoAOf[T]) {
+	var upper Matrix3SoAOf[T]
+	var tmpV3_0 Vector3SoAOf[T]
+	m3SoAMakeFromCols(&upper, &tfrm.col0, &tfrm.col1, &tfrm.col2)
+	m3SoAInverse(&upper, &upper)
+	m3SoAMulV3SoA(&tmpV3_0, &upper, &tfrm.col3)
+	v3SoANeg(&tmpV3_0, &tmpV3_0)
+	t3SoAMakeFromM3SoAV3SoA(result, &upper, &tmpV3_0)
+}
+
+func t3SoAOrthoInverse[T Float](result, tfrm *Transform3SoAOf[T]) {
+	var upper Matrix3SoAOf[T]
+	var tmpV3_0 Vector3SoAOf[T]
+	m3SoAMakeFromCols(&upper, &tfrm.col0, &tfrm.col1, &tfrm.col2)
+	m3SoATranspose(&upper, &upper)
+	m3SoAMulV3SoA(&tmpV3_0, &upper, &tfrm.col3)
+	v3SoANeg(&tmpV3_0, &tmpV3_0)
+	t3SoAMakeFromM3SoAV3SoA(result, &upper, &tmpV3_0)
+}
+
+func t3SoAMulV3SoA[T Float](result *Vector3SoAOf[T], tfrm *Transform3SoAOf[T], vec *Vector3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		vx, vy, vz := vec.X[i], vec.Y[i], vec.Z[i]
+		result.X[i] = tfrm.col0.X[i]*vx + tfrm.col1.X[i]*vy + tfrm.col2.X[i]*vz
+		result.Y[i] = tfrm.col0.Y[i]*vx + tfrm.col1.Y[i]*vy + tfrm.col2.Y[i]*vz
+		result.Z[i] = tfrm.col0.Z[i]*vx + tfrm.col1.Z[i]*vy + tfrm.col2.Z[i]*vz
+	}
+}
+
+func t3SoAMulP3SoA[T Float](result *Point3SoAOf[T], tfrm *Transform3SoAOf[T], pnt *Point3SoAOf[T]) {
+	for i := 0; i < 4; i++ {
+		px, py, pz := pnt.X[i], pnt.Y[i], pnt.Z[i]
+		result.X[i] = tfrm.col0.X[i]*px + tfrm.col1.X[i]*py + tfrm.col2.X[i]*pz + tfrm.col3.X[i]
+		result.Y[i] = tfrm.col0.Y[i]*px + tfrm.col1.Y[i]*py + tfrm.col2.Y[i]*pz + tfrm.col3.Y[i]
+		result.Z[i] = tfrm.col0.Z[i]*px + tfrm.col1.Z[i]*py + tfrm.col2.Z[i]*pz + tfrm.col3.Z[i]
+	}
+}
+
+func t3SoAMul[T Float](result, tfrm0, tfrm1 *Transform3SoAOf[T]) {
+	var tmp Transform3SoAOf[T]
+	var pnt Point3SoAOf[T]
+	t3SoAMulV3SoA(&tmp.col0, tfrm0, &tfrm1.col0)
+	t3SoAMulV3SoA(&tmp.col1, tfrm0, &tfrm1.col1)
+	t3SoAMulV3SoA(&tmp.col2, tfrm0, &tfrm1.col2)
+	p3SoAMakeFromV3SoA(&pnt, &tfrm1.col3)
+	t3SoAMulP3SoA(&pnt, tfrm0, &pnt)
+	v3SoAMakeFromP3SoA(&tmp.col3, &pnt)
+	*result = tmp
+}
+
+func t3SoASelect[T Float](result, tfrm0, tfrm1 *Transform3SoAOf[T], select1 [4]bool) {
+	v3SoASelect(&result.col0, &tfrm0.col0, &tfrm1.col0, select1)
+	v3SoASelect(&result.col1, &tfrm0.col1, &tfrm1.col1, select1)
+	v3SoASelect(&result.col2, &tfrm0.col2, &tfrm1.col2, select1)
+	v3SoASelect(&result.col3, &tfrm0.col3, &tfrm1.col3, select1)
+}
+
+func (t *Transform3SoAOf[T]) GetLane(lane int) Transform3Of[T] {
+	return Transform3Of[T]{t.col0.GetLane(lane), t.col1.GetLane(lane), t.col2.GetLane(lane), t.col3.GetLane(lane)}
+}
+
+func (t *Transform3SoAOf[T]) SetLane(lane int, tfrm *Transform3Of[T]) {
+	t.col0.SetLane(lane, &tfrm.col0)
+	t.col1.SetLane(lane, &tfrm.col1)
+	t.col2.SetLane(lane, &tfrm.col2)
+	t.col3.SetLane(lane, &tfrm.col3)
+}
+
+func (t *Transform3SoAOf[T]) String() string {
+	s := ""
+	for i := 0; i < 4; i++ {
+		tfrm := t.GetLane(i)
+		s += tfrm.String()
+	}
+	return s
+}
@@ -0,0 +1,542 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Vector3SoAd = Vector3SoAOf[float64]
+
+type Vector4SoAd = Vector4SoAOf[float64]
+
+type Point3SoAd = Point3SoAOf[float64]
+
+type QuatSoAd = QuatSoAOf[float64]
+
+type Matrix3SoAd = Matrix3SoAOf[float64]
+
+type Matrix4SoAd = Matrix4SoAOf[float64]
+
+type Transform3SoAd = Transform3SoAOf[float64]
+
+func V3SoAdCopy(result, vec *Vector3SoAd) {
+	v3SoACopy(result, vec)
+}
+
+func V3SoAdMakeFromElems(result *Vector3SoAd, x, y, z [4]float64) {
+	v3SoAMakeFromElems(result, x, y, z)
+}
+
+func V3SoAdMakeFromP3SoAd(result *Vector3SoAd, pnt *Point3SoAd) {
+	v3SoAMakeFromP3SoA(result, pnt)
+}
+
+func V3SoAdMakeFromAoS(result *Vector3SoAd, vec *Vector3d) {
+	v3SoAMakeFromAoS(result, vec)
+}
+
+func V3SoAdMakeFrom4AoS(result *Vector3SoAd, vec0, vec1, vec2, vec3 *Vector3d) {
+	v3SoAMakeFrom4AoS(result, vec0, vec1, vec2, vec3)
+}
+
+func V3SoAdGet4AoS(result0, result1, result2, result3 *Vector3d, vec *Vector3SoAd) {
+	v3SoAGet4AoS(result0, result1, result2, result3, vec)
+}
+
+func V3SoAdLerp(result *Vector3SoAd, t [4]float64, vec0, vec1 *Vector3SoAd) {
+	v3SoALerp(result, t, vec0, vec1)
+}
+
+func V3SoAdSlerp(result *Vector3SoAd, t [4]float64, unitVec0, unitVec1 *Vector3SoAd) {
+	v3SoASlerp(result, t, unitVec0, unitVec1)
+}
+
+func V3SoAdAdd(result, vec0, vec1 *Vector3SoAd) {
+	v3SoAAdd(result, vec0, vec1)
+}
+
+func V3SoAdSub(result, vec0, vec1 *Vector3SoAd) {
+	v3SoASub(result, vec0, vec1)
+}
+
+func V3SoAdAddP3SoAd(result *Point3SoAd, vec *Vector3SoAd, pnt *Point3SoAd) {
+	v3SoAAddP3SoA(result, vec, pnt)
+}
+
+func V3SoAdScalarMul(result, vec *Vector3SoAd, scalar [4]float64) {
+	v3SoAScalarMul(result, vec, scalar)
+}
+
+func V3SoAdScalarDiv(result, vec *Vector3SoAd, scalar [4]float64) {
+	v3SoAScalarDiv(result, vec, scalar)
+}
+
+func V3SoAdNeg(result, vec *Vector3SoAd) {
+	v3SoANeg(result, vec)
+}
+
+func V3SoAdMulPerElem(result, vec0, vec1 *Vector3SoAd) {
+	v3SoAMulPerElem(result, vec0, vec1)
+}
+
+func V3SoAdDivPerElem(result, vec0, vec1 *Vector3SoAd) {
+	v3SoADivPerElem(result, vec0, vec1)
+}
+
+func V3SoAdAbsPerElem(result, vec *Vector3SoAd) {
+	v3SoAAbsPerElem(result, vec)
+}
+
+func V3SoAdMaxPerElem(result, vec0, vec1 *Vector3SoAd) {
+	v3SoAMaxPerElem(result, vec0, vec1)
+}
+
+func V3SoAdMinPerElem(result, vec0, vec1 *Vector3SoAd) {
+	v3SoAMinPerElem(result, vec0, vec1)
+}
+
+func V3SoAdDot(vec0, vec1 *Vector3SoAd) [4]float64 {
+	return v3SoADot(vec0, vec1)
+}
+
+func V3SoAdNormalize(result, vec *Vector3SoAd) {
+	v3SoANormalize(result, vec)
+}
+
+func V3SoAdCross(result, vec0, vec1 *Vector3SoAd) {
+	v3SoACross(result, vec0, vec1)
+}
+
+func V3SoAdSelect(result, vec0, vec1 *Vector3SoAd, select1 [4]bool) {
+	v3SoASelect(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func V4SoAdCopy(result, vec *Vector4SoAd) {
+	v4SoACopy(result, vec)
+}
+
+func V4SoAdMakeFromElems(result *Vector4SoAd, x, y, z, w [4]float64) {
+	v4SoAMakeFromElems(result, x, y, z, w)
+}
+
+func V4SoAdMakeFromV3SoAdScalar(result *Vector4SoAd, xyz *Vector3SoAd, w [4]float64) {
+	v4SoAMakeFromV3SoAScalar(result, xyz, w)
+}
+
+func V4SoAdMakeFromAoS(result *Vector4SoAd, vec *Vector4d) {
+	v4SoAMakeFromAoS(result, vec)
+}
+
+func V4SoAdMakeFrom4AoS(result *Vector4SoAd, vec0, vec1, vec2, vec3 *Vector4d) {
+	v4SoAMakeFrom4AoS(result, vec0, vec1, vec2, vec3)
+}
+
+func V4SoAdGet4AoS(result0, result1, result2, result3 *Vector4d, vec *Vector4SoAd) {
+	v4SoAGet4AoS(result0, result1, result2, result3, vec)
+}
+
+func V4SoAdGetXYZ(result *Vector3SoAd, vec *Vector4SoAd) {
+	v4SoAGetXYZ(result, vec)
+}
+
+func V4SoAdLerp(result *Vector4SoAd, t [4]float64, vec0, vec1 *Vector4SoAd) {
+	v4SoALerp(result, t, vec0, vec1)
+}
+
+func V4SoAdAdd(result, vec0, vec1 *Vector4SoAd) {
+	v4SoAAdd(result, vec0, vec1)
+}
+
+func V4SoAdSub(result, vec0, vec1 *Vector4SoAd) {
+	v4SoASub(result, vec0, vec1)
+}
+
+func V4SoAdScalarMul(result, vec *Vector4SoAd, scalar [4]float64) {
+	v4SoAScalarMul(result, vec, scalar)
+}
+
+func V4SoAdNeg(result, vec *Vector4SoAd) {
+	v4SoANeg(result, vec)
+}
+
+func V4SoAdMulPerElem(result, vec0, vec1 *Vector4SoAd) {
+	v4SoAMulPerElem(result, vec0, vec1)
+}
+
+func V4SoAdDot(vec0, vec1 *Vector4SoAd) [4]float64 {
+	return v4SoADot(vec0, vec1)
+}
+
+func V4SoAdNormalize(result, vec *Vector4SoAd) {
+	v4SoANormalize(result, vec)
+}
+
+func V4SoAdSelect(result, vec0, vec1 *Vector4SoAd, select1 [4]bool) {
+	v4SoASelect(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func P3SoAdCopy(result, pnt *Point3SoAd) {
+	p3SoACopy(result, pnt)
+}
+
+func P3SoAdMakeFromElems(result *Point3SoAd, x, y, z [4]float64) {
+	p3SoAMakeFromElems(result, x, y, z)
+}
+
+func P3SoAdMakeFromV3SoAd(result *Point3SoAd, vec *Vector3SoAd) {
+	p3SoAMakeFromV3SoA(result, vec)
+}
+
+func P3SoAdMakeFromAoS(result *Point3SoAd, pnt *Point3d) {
+	p3SoAMakeFromAoS(result, pnt)
+}
+
+func P3SoAdMakeFrom4AoS(result *Point3SoAd, pnt0, pnt1, pnt2, pnt3 *Point3d) {
+	p3SoAMakeFrom4AoS(result, pnt0, pnt1, pnt2, pnt3)
+}
+
+func P3SoAdGet4AoS(result0, result1, result2, result3 *Point3d, pnt *Point3SoAd) {
+	p3SoAGet4AoS(result0, result1, result2, result3, pnt)
+}
+
+func P3SoAdLerp(result *Point3SoAd, t [4]float64, pnt0, pnt1 *Point3SoAd) {
+	p3SoALerp(result, t, pnt0, pnt1)
+}
+
+func P3SoAdSub(result *Vector3SoAd, pnt0, pnt1 *Point3SoAd) {
+	p3SoASub(result, pnt0, pnt1)
+}
+
+func P3SoAdAddV3SoAd(result, pnt *Point3SoAd, vec *Vector3SoAd) {
+	p3SoAAddV3SoA(result, pnt, vec)
+}
+
+func P3SoAdSubV3SoAd(result, pnt *Point3SoAd, vec *Vector3SoAd) {
+	p3SoASubV3SoA(result, pnt, vec)
+}
+
+func P3SoAdScale(result, pnt *Point3SoAd, scaleVal [4]float64) {
+	p3SoAScale(result, pnt, scaleVal)
+}
+
+func P3SoAdMaxPerElem(result, pnt0, pnt1 *Point3SoAd) {
+	p3SoAMaxPerElem(result, pnt0, pnt1)
+}
+
+func P3SoAdMinPerElem(result, pnt0, pnt1 *Point3SoAd) {
+	p3SoAMinPerElem(result, pnt0, pnt1)
+}
+
+func P3SoAdSelect(result, pnt0, pnt1 *Point3SoAd, select1 [4]bool) {
+	p3SoASelect(result, pnt0, pnt1, select1)
+}
+
+/*******/
+
+func QSoAdCopy(result, quat *QuatSoAd) {
+	qSoACopy(result, quat)
+}
+
+func QSoAdMakeFromElems(result *QuatSoAd, x, y, z, w [4]float64) {
+	qSoAMakeFromElems(result, x, y, z, w)
+}
+
+func QSoAdMakeIdentity(result *QuatSoAd) {
+	qSoAMakeIdentity(result)
+}
+
+func QSoAdMakeRotationAxis(result *QuatSoAd, radians [4]float64, unitVec *Vector3SoAd) {
+	qSoAMakeRotationAxis(result, radians, unitVec)
+}
+
+func QSoAdMakeRotationX(result *QuatSoAd, radians [4]float64) {
+	qSoAMakeRotationX(result, radians)
+}
+
+func QSoAdMakeRotationY(result *QuatSoAd, radians [4]float64) {
+	qSoAMakeRotationY(result, radians)
+}
+
+func QSoAdMakeRotationZ(result *QuatSoAd, radians [4]float64) {
+	qSoAMakeRotationZ(result, radians)
+}
+
+func QSoAdMakeFromAoS(result *QuatSoAd, quat *Quatd) {
+	qSoAMakeFromAoS(result, quat)
+}
+
+func QSoAdMakeFrom4AoS(result *QuatSoAd, quat0, quat1, quat2, quat3 *Quatd) {
+	qSoAMakeFrom4AoS(result, quat0, quat1, quat2, quat3)
+}
+
+func QSoAdGet4AoS(result0, result1, result2, result3 *Quatd, quat *QuatSoAd) {
+	qSoAGet4AoS(result0, result1, result2, result3, quat)
+}
+
+func QSoAdLerp(result *QuatSoAd, t [4]float64, quat0, quat1 *QuatSoAd) {
+	qSoALerp(result, t, quat0, quat1)
+}
+
+func QSoAdSlerp(result *QuatSoAd, t [4]float64, unitQuat0, unitQuat1 *QuatSoAd) {
+	qSoASlerp(result, t, unitQuat0, unitQuat1)
+}
+
+func QSoAdSquad(result *QuatSoAd, t [4]float64, unitQuat0, unitQuat1, unitQuat2, unitQuat3 *QuatSoAd) {
+	qSoASquad(result, t, unitQuat0, unitQuat1, unitQuat2, unitQuat3)
+}
+
+func QSoAdAdd(result, quat0, quat1 *QuatSoAd) {
+	qSoAAdd(result, quat0, quat1)
+}
+
+func QSoAdSub(result, quat0, quat1 *QuatSoAd) {
+	qSoASub(result, quat0, quat1)
+}
+
+func QSoAdScalarMul(result, quat *QuatSoAd, scalar [4]float64) {
+	qSoAScalarMul(result, quat, scalar)
+}
+
+func QSoAdNeg(result, quat *QuatSoAd) {
+	qSoANeg(result, quat)
+}
+
+func QSoAdDot(quat0, quat1 *QuatSoAd) [4]float64 {
+	return qSoADot(quat0, quat1)
+}
+
+func QSoAdNormalize(result, quat *QuatSoAd) {
+	qSoANormalize(result, quat)
+}
+
+func QSoAdMul(result, quat0, quat1 *QuatSoAd) {
+	qSoAMul(result, quat0, quat1)
+}
+
+func QSoAdRotate(result *Vector3SoAd, quat *QuatSoAd, vec *Vector3SoAd) {
+	qSoARotate(result, quat, vec)
+}
+
+func QSoAdConj(result, quat *QuatSoAd) {
+	qSoAConj(result, quat)
+}
+
+func QSoAdSelect(result, quat0, quat1 *QuatSoAd, select1 [4]bool) {
+	qSoASelect(result, quat0, quat1, select1)
+}
+
+/*******/
+
+func M3SoAdCopy(result, mat *Matrix3SoAd) {
+	m3SoACopy(result, mat)
+}
+
+func M3SoAdMakeFromCols(result *Matrix3SoAd, col0, col1, col2 *Vector3SoAd) {
+	m3SoAMakeFromCols(result, col0, col1, col2)
+}
+
+func M3SoAdMakeIdentity(result *Matrix3SoAd) {
+	m3SoAMakeIdentity(result)
+}
+
+func M3SoAdMakeFromAoS(result *Matrix3SoAd, mat *Matrix3d) {
+	m3SoAMakeFromAoS(result, mat)
+}
+
+func M3SoAdMakeFrom4AoS(result *Matrix3SoAd, mat0, mat1, mat2, mat3 *Matrix3d) {
+	m3SoAMakeFrom4AoS(result, mat0, mat1, mat2, mat3)
+}
+
+func M3SoAdGet4AoS(result0, result1, result2, result3 *Matrix3d, mat *Matrix3SoAd) {
+	m3SoAGet4AoS(result0, result1, result2, result3, mat)
+}
+
+func M3SoAdMakeFromQSoAd(result *Matrix3SoAd, unitQuat *QuatSoAd) {
+	m3SoAMakeFromQSoA(result, unitQuat)
+}
+
+func M3SoAdMakeRotationX(result *Matrix3SoAd, radians [4]float64) {
+	m3SoAMakeRotationX(result, radians)
+}
+
+func M3SoAdMakeRotationY(result *Matrix3SoAd, radians [4]float64) {
+	m3SoAMakeRotationY(result, radians)
+}
+
+func M3SoAdMakeRotationZ(result *Matrix3SoAd, radians [4]float64) {
+	m3SoAMakeRotationZ(result, radians)
+}
+
+func M3SoAdMakeRotationAxis(result *Matrix3SoAd, radians [4]float64, unitVec *Vector3SoAd) {
+	m3SoAMakeRotationAxis(result, radians, unitVec)
+}
+
+func M3SoAdTranspose(result, mat *Matrix3SoAd) {
+	m3SoATranspose(result, mat)
+}
+
+func M3SoAdInverse(result, mat *Matrix3SoAd) {
+	m3SoAInverse(result, mat)
+}
+
+func M3SoAdAdd(result, mat0, mat1 *Matrix3SoAd) {
+	m3SoAAdd(result, mat0, mat1)
+}
+
+func M3SoAdSub(result, mat0, mat1 *Matrix3SoAd) {
+	m3SoASub(result, mat0, mat1)
+}
+
+func M3SoAdScalarMul(result, mat *Matrix3SoAd, scalar [4]float64) {
+	m3SoAScalarMul(result, mat, scalar)
+}
+
+func M3SoAdMulV3SoAd(result *Vector3SoAd, mat *Matrix3SoAd, vec *Vector3SoAd) {
+	m3SoAMulV3SoA(result, mat, vec)
+}
+
+func M3SoAdMul(result, mat0, mat1 *Matrix3SoAd) {
+	m3SoAMul(result, mat0, mat1)
+}
+
+func M3SoAdSelect(result, mat0, mat1 *Matrix3SoAd, select1 [4]bool) {
+	m3SoASelect(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func M4SoAdCopy(result, mat *Matrix4SoAd) {
+	m4SoACopy(result, mat)
+}
+
+func M4SoAdMakeFromCols(result *Matrix4SoAd, col0, col1, col2, col3 *Vector4SoAd) {
+	m4SoAMakeFromCols(result, col0, col1, col2, col3)
+}
+
+func M4SoAdMakeIdentity(result *Matrix4SoAd) {
+	m4SoAMakeIdentity(result)
+}
+
+func M4SoAdMakeFromT3SoAd(result *Matrix4SoAd, tfrm *Transform3SoAd) {
+	m4SoAMakeFromT3SoA(result, tfrm)
+}
+
+func M4SoAdMakeFromAoS(result *Matrix4SoAd, mat *Matrix4d) {
+	m4SoAMakeFromAoS(result, mat)
+}
+
+func M4SoAdMakeFrom4AoS(result *Matrix4SoAd, mat0, mat1, mat2, mat3 *Matrix4d) {
+	m4SoAMakeFrom4AoS(result, mat0, mat1, mat2, mat3)
+}
+
+func M4SoAdGet4AoS(result0, result1, result2, result3 *Matrix4d, mat *Matrix4SoAd) {
+	m4SoAGet4AoS(result0, result1, result2, result3, mat)
+}
+
+func M4SoAdTranspose(result, mat *Matrix4SoAd) {
+	m4SoATranspose(result, mat)
+}
+
+func M4SoAdInverse(result, mat *Matrix4SoAd) {
+	m4SoAInverse(result, mat)
+}
+
+func M4SoAdAffineInverse(result, mat *Matrix4SoAd) {
+	m4SoAAffineInverse(result, mat)
+}
+
+func M4SoAdAdd(result, mat0, mat1 *Matrix4SoAd) {
+	m4SoAAdd(result, mat0, mat1)
+}
+
+func M4SoAdSub(result, mat0, mat1 *Matrix4SoAd) {
+	m4SoASub(result, mat0, mat1)
+}
+
+func M4SoAdScalarMul(result, mat *Matrix4SoAd, scalar [4]float64) {
+	m4SoAScalarMul(result, mat, scalar)
+}
+
+func M4SoAdMulV4SoAd(result *Vector4SoAd, mat *Matrix4SoAd, vec *Vector4SoAd) {
+	m4SoAMulV4SoA(result, mat, vec)
+}
+
+func M4SoAdMulV3SoAd(result *Vector4SoAd, mat *Matrix4SoAd, vec *Vector3SoAd) {
+	m4SoAMulV3SoA(result, mat, vec)
+}
+
+func M4SoAdMulP3SoAd(result *Vector4SoAd, mat *Matrix4SoAd, pnt *Point3SoAd) {
+	m4SoAMulP3SoA(result, mat, pnt)
+}
+
+func M4SoAdMul(result, mat0, mat1 *Matrix4SoAd) {
+	m4SoAMul(result, mat0, mat1)
+}
+
+func M4SoAdMulT3SoAd(result, mat *Matrix4SoAd, tfrm *Transform3SoAd) {
+	m4SoAMulT3SoA(result, mat, tfrm)
+}
+
+func M4SoAdSelect(result, mat0, mat1 *Matrix4SoAd, select1 [4]bool) {
+	m4SoASelect(result, mat0, mat1, select1)
+}
+
+/*******/
+
+func T3SoAdCopy(result, tfrm *Transform3SoAd) {
+	t3SoACopy(result, tfrm)
+}
+
+func T3SoAdMakeFromCols(result *Transform3SoAd, col0, col1, col2, col3 *Vector3SoAd) {
+	t3SoAMakeFromCols(result, col0, col1, col2, col3)
+}
+
+func T3SoAdMakeIdentity(result *Transform3SoAd) {
+	t3SoAMakeIdentity(result)
+}
+
+func T3SoAdMakeFromM3SoAdV3SoAd(result *Transform3SoAd, tfrm *Matrix3SoAd, translateVec *Vector3SoAd) {
+	t3SoAMakeFromM3SoAV3SoA(result, tfrm, translateVec)
+}
+
+func T3SoAdMakeFromQSoAdV3SoAd(result *Transform3SoAd, unitQuat *QuatSoAd, translateVec *Vector3SoAd) {
+	t3SoAMakeFromQSoAV3SoA(result, unitQuat, translateVec)
+}
+
+func T3SoAdMakeFromAoS(result *Transform3SoAd, tfrm *Transform3d) {
+	t3SoAMakeFromAoS(result, tfrm)
+}
+
+func T3SoAdMakeFrom4AoS(result *Transform3SoAd, tfrm0, tfrm1, tfrm2, tfrm3 *Transform3d) {
+	t3SoAMakeFrom4AoS(result, tfrm0, tfrm1, tfrm2, tfrm3)
+}
+
+func T3SoAdGet4AoS(result0, result1, result2, result3 *Transform3d, tfrm *Transform3SoAd) {
+	t3SoAGet4AoS(result0, result1, result2, result3, tfrm)
+}
+
+func T3SoAdInverse(result, tfrm *Transform3SoAd) {
+	t3SoAInverse(result, tfrm)
+}
+
+func T3SoAdOrthoInverse(result, tfrm *Transform3SoAd) {
+	t3SoAOrthoInverse(result, tfrm)
+}
+
+func T3SoAdMulV3SoAd(result *Vector3SoAd, tfrm *Transform3SoAd, vec *Vector3SoAd) {
+	t3SoAMulV3SoA(result, tfrm, vec)
+}
+
+func T3SoAdMulP3SoAd(result *Point3SoAd, tfrm *Transform3SoAd, pnt *Point3SoAd) {
+	t3SoAMulP3SoA(result, tfrm, pnt)
+}
+
+func T3SoAdMul(result, tfrm0, tfrm1 *Transform3SoAd) {
+	t3SoAMul(result, tfrm0, tfrm1)
+}
+
+func T3SoAdSelect(result, tfrm0, tfrm1 *Transform3SoAd, select1 [4]bool) {
+	t3SoASelect(result, tfrm0, tfrm1, select1)
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randUnitQ(rng *rand.Rand) Quat {
+	quat := Quat{X: rng.Float32() - 0.5, Y: rng.Float32() - 0.5, Z: rng.Float32() - 0.5, W: rng.Float32() - 0.5}
+	QNormalize(&quat, &quat)
+	return quat
+}
+
+func randV3(rng *rand.Rand) Vector3 {
+	return Vector3{X: rng.Float32()*4.0 - 2.0, Y: rng.Float32()*4.0 - 2.0, Z: rng.Float32()*4.0 - 2.0}
+}
+
+func TestSoAGatherScatter(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	var tfrms, back [4]Transform3
+	for i := range tfrms {
+		quat, vec := randUnitQ(rng), randV3(rng)
+		T3MakeFromQV3(&tfrms[i], &quat, &vec)
+	}
+	var soa Transform3SoA
+	T3SoAMakeFrom4AoS(&soa, &tfrms[0], &tfrms[1], &tfrms[2], &tfrms[3])
+	T3SoAGet4AoS(&back[0], &back[1], &back[2], &back[3], &soa)
+	if back != tfrms {
+		t.Errorf("T3SoAGet4AoS(T3SoAMakeFrom4AoS(tfrms)) = %v, want %v", back, tfrms)
+	}
+	var vec Vector3SoA
+	V3SoAMakeFromAoS(&vec, &Vector3{X: 1.0, Y: 2.0, Z: 3.0})
+	for i := 0; i < 4; i++ {
+		if got := vec.GetLane(i); got != (Vector3{X: 1.0, Y: 2.0, Z: 3.0}) {
+			t.Errorf("V3SoAMakeFromAoS lane %d = %v", i, got)
+		}
+	}
+}
+
+func TestSoAVectorParity(t *testing.T) {
+	rng := rand.New(rand.NewSource(12))
+	var vec0, vec1 [4]Vector3
+	for i := range vec0 {
+		vec0[i], vec1[i] = randV3(rng), randV3(rng)
+	}
+	var soa0, soa1, cross, norm, slerp Vector3SoA
+	V3SoAMakeFrom4AoS(&soa0, &vec0[0], &vec0[1], &vec0[2], &vec0[3])
+	V3SoAMakeFrom4AoS(&soa1, &vec1[0], &vec1[1], &vec1[2], &vec1[3])
+	V3SoACross(&cross, &soa0, &soa1)
+	V3SoANormalize(&norm, &soa0)
+	var unit1 Vector3SoA
+	V3SoANormalize(&unit1, &soa1)
+	// Lane 3 slerps between nearly equal vectors and takes the lerp branch.
+	unit1.SetLane(3, &Vector3{X: norm.X[3], Y: norm.Y[3] + 1e-3, Z: norm.Z[3]})
+	t4 := [4]float32{0.0, 0.3, 0.7, 0.5}
+	V3SoASlerp(&slerp, t4, &norm, &unit1)
+	dot := V3SoADot(&soa0, &soa1)
+	length := soa0.Length()
+	for i := 0; i < 4; i++ {
+		var want, u0, u1 Vector3
+		V3Cross(&want, &vec0[i], &vec1[i])
+		if got := cross.GetLane(i); !v3Near(&got, &want, 1e-5) {
+			t.Errorf("V3SoACross lane %d = %v, want %v", i, got, want)
+		}
+		V3Normalize(&u0, &vec0[i])
+		if got := norm.GetLane(i); !v3Near(&got, &u0, 1e-6) {
+			t.Errorf("V3SoANormalize lane %d = %v, want %v", i, got, u0)
+		}
+		if want := V3Dot(&vec0[i], &vec1[i]); !near(dot[i], want, 1e-5) {
+			t.Errorf("V3SoADot lane %d = %v, want %v", i, dot[i], want)
+		}
+		if want := vec0[i].Length(); !near(length[i], want, 1e-6) {
+			t.Errorf("Length lane %d = %v, want %v", i, length[i], want)
+		}
+		u1 = unit1.GetLane(i)
+		V3Slerp(&want, t4[i], &u0, &u1)
+		if got := slerp.GetLane(i); !v3Near(&got, &want, 1e-6) {
+			t.Errorf("V3SoASlerp lane %d = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestSoAQuatParity(t *testing.T) {
+	rng := rand.New(rand.NewSource(13))
+	var quats [4][4]Quat
+	var soas [4]QuatSoA
+	for n := range quats {
+		for i := range quats[n] {
+			quats[n][i] = randUnitQ(rng)
+		}
+		QSoAMakeFrom4AoS(&soas[n], &quats[n][0], &quats[n][1], &quats[n][2], &quats[n][3])
+	}
+	// Lane 0 crosses the hemisphere, so the slerp negates its start; lane 1
+	// slerps between nearly equal quaternions.
+	QMakeRotationAxis(&quats[1][0], 0.2, &Vector3{X: 1.0})
+	QMul(&quats[1][0], &quats[0][0], &quats[1][0])
+	QNeg(&quats[1][0], &quats[1][0])
+	quats[1][1] = quats[0][1]
+	quats[1][1].X += 1e-4
+	QNormalize(&quats[1][1], &quats[1][1])
+	QSoAMakeFrom4AoS(&soas[1], &quats[1][0], &quats[1][1], &quats[1][2], &quats[1][3])
+
+	var radians [4]float32
+	var axes Vector3SoA
+	for i := range radians {
+		radians[i] = rng.Float32()*6.0 - 3.0
+		axis := randV3(rng)
+		V3Normalize(&axis, &axis)
+		axes.SetLane(i, &axis)
+	}
+	t4 := [4]float32{0.25, 0.5, 0.75, 1.0}
+	var slerp, squad, mul, fromAxis QuatSoA
+	var rot Matrix3SoA
+	QSoASlerp(&slerp, t4, &soas[0], &soas[1])
+	QSoASquad(&squad, t4, &soas[0], &soas[1], &soas[2], &soas[3])
+	QSoAMul(&mul, &soas[0], &soas[1])
+	QSoAMakeRotationAxis(&fromAxis, radians, &axes)
+	M3SoAMakeFromQSoA(&rot, &soas[2])
+	length := soas[3].Length()
+	for i := 0; i < 4; i++ {
+		var want Quat
+		var wantM Matrix3
+		QSlerp(&want, t4[i], &quats[0][i], &quats[1][i])
+		if got := slerp.GetLane(i); !qNear(&got, &want, 1e-6) {
+			t.Errorf("QSoASlerp lane %d = %v, want %v", i, got, want)
+		}
+		QSquad(&want, t4[i], &quats[0][i], &quats[1][i], &quats[2][i], &quats[3][i])
+		if got := squad.GetLane(i); !qNear(&got, &want, 1e-6) {
+			t.Errorf("QSoASquad lane %d = %v, want %v", i, got, want)
+		}
+		QMul(&want, &quats[0][i], &quats[1][i])
+		if got := mul.GetLane(i); !qNear(&got, &want, 1e-6) {
+			t.Errorf("QSoAMul lane %d = %v, want %v", i, got, want)
+		}
+		axis := axes.GetLane(i)
+		QMakeRotationAxis(&want, radians[i], &axis)
+		if got := fromAxis.GetLane(i); !qNear(&got, &want, 1e-6) {
+			t.Errorf("QSoAMakeRotationAxis lane %d = %v, want %v", i, got, want)
+		}
+		M3MakeFromQ(&wantM, &quats[2][i])
+		if got := rot.GetLane(i); !m3Near(&got, &wantM, 1e-6) {
+			t.Errorf("M3SoAMakeFromQSoA lane %d = %v, want %v", i, got.String(), wantM.String())
+		}
+		if !near(length[i], 1.0, 1e-6) {
+			t.Errorf("Length of unit quaternion lane %d = %v", i, length[i])
+		}
+	}
+}
+
+func TestSoAMatrixParity(t *testing.T) {
+	rng := rand.New(rand.NewSource(14))
+	var mats [4]Matrix4
+	var radians [4]float32
+	var axes Vector3SoA
+	for i := range mats {
+		quat, vec, scale := randUnitQ(rng), randV3(rng), randV3(rng)
+		M4MakeFromQV3(&mats[i], &quat, &vec)
+		M4AppendScale(&mats[i], &mats[i], &scale)
+		mats[i].col0.W = rng.Float32() - 0.5
+		radians[i] = rng.Float32()*6.0 - 3.0
+		axis := randV3(rng)
+		V3Normalize(&axis, &axis)
+		axes.SetLane(i, &axis)
+	}
+	var soa, inv, prod Matrix4SoA
+	var rotX, rotY, rotZ, rotAxis Matrix3SoA
+	M4SoAMakeFrom4AoS(&soa, &mats[0], &mats[1], &mats[2], &mats[3])
+	M4SoAInverse(&inv, &soa)
+	M4SoAMul(&prod, &soa, &inv)
+	det := soa.Determinant()
+	M3SoAMakeRotationX(&rotX, radians)
+	M3SoAMakeRotationY(&rotY, radians)
+	M3SoAMakeRotationZ(&rotZ, radians)
+	M3SoAMakeRotationAxis(&rotAxis, radians, &axes)
+	det3 := rotAxis.Determinant()
+	for i := 0; i < 4; i++ {
+		var ident Matrix4
+		var want Matrix3
+		M4MakeIdentity(&ident)
+		if got := prod.GetLane(i); !m4Near(&got, &ident, 1e-4) {
+			t.Errorf("M4SoAMul(mat, M4SoAInverse(mat)) lane %d = %v", i, got.String())
+		}
+		if want := mats[i].Determinant(); !near(det[i], want, 1e-5*abs(want)) {
+			t.Errorf("Determinant lane %d = %v, want %v", i, det[i], want)
+		}
+		M3MakeRotationX(&want, radians[i])
+		if got := rotX.GetLane(i); !m3Near(&got, &want, 1e-6) {
+			t.Errorf("M3SoAMakeRotationX lane %d = %v, want %v", i, got.String(), want.String())
+		}
+		M3MakeRotationY(&want, radians[i])
+		if got := rotY.GetLane(i); !m3Near(&got, &want, 1e-6) {
+			t.Errorf("M3SoAMakeRotationY lane %d = %v, want %v", i, got.String(), want.String())
+		}
+		M3MakeRotationZ(&want, radians[i])
+		if got := rotZ.GetLane(i); !m3Near(&got, &want, 1e-6) {
+			t.Errorf("M3SoAMakeRotationZ lane %d = %v, want %v", i, got.String(), want.String())
+		}
+		axis := axes.GetLane(i)
+		M3MakeRotationAxis(&want, radians[i], &axis)
+		if got := rotAxis.GetLane(i); !m3Near(&got, &want, 1e-5) {
+			t.Errorf("M3SoAMakeRotationAxis lane %d = %v, want %v", i, got.String(), want.String())
+		}
+		if !near(det3[i], 1.0, 1e-5) {
+			t.Errorf("rotation determinant lane %d = %v", i, det3[i])
+		}
+	}
+}
+
+func TestSoATransformParity(t *testing.T) {
+	rng := rand.New(rand.NewSource(15))
+	var tfrms [4]Transform3
+	var pnts [4]Point3
+	for i := range tfrms {
+		quat, vec := randUnitQ(rng), randV3(rng)
+		T3MakeFromQV3(&tfrms[i], &quat, &vec)
+		pnts[i] = Point3(randV3(rng))
+	}
+	var soa, inv Transform3SoA
+	var pnt, moved Point3SoA
+	T3SoAMakeFrom4AoS(&soa, &tfrms[0], &tfrms[1], &tfrms[2], &tfrms[3])
+	P3SoAMakeFrom4AoS(&pnt, &pnts[0], &pnts[1], &pnts[2], &pnts[3])
+	T3SoAMulP3SoA(&moved, &soa, &pnt)
+	T3SoAOrthoInverse(&inv, &soa)
+	for i := 0; i < 4; i++ {
+		var want Point3
+		var wantInv Transform3
+		T3MulP3(&want, &tfrms[i], &pnts[i])
+		if got := moved.GetLane(i); !p3Near(&got, &want, 1e-6) {
+			t.Errorf("T3SoAMulP3SoA lane %d = %v, want %v", i, got, want)
+		}
+		T3OrthoInverse(&wantInv, &tfrms[i])
+		if got := inv.GetLane(i); !t3Near(&got, &wantInv, 1e-6) {
+			t.Errorf("T3SoAOrthoInverse lane %d = %v, want %v", i, got.String(), wantInv.String())
+		}
+	}
+}
+
+// The SoA benchmarks do the same work as their AoS counterparts, one
+// transform or rotation per element over benchElems elements, with the data
+// laid out four elements to a struct.
+
+const benchElems = 1024
+
+func makeBenchTransforms() ([]Transform3, []Point3) {
+	rng := rand.New(rand.NewSource(12))
+	tfrms := make([]Transform3, benchElems)
+	pnts := make([]Point3, benchElems)
+	for i := range tfrms {
+		quat, vec := randUnitQ(rng), randV3(rng)
+		T3MakeFromQV3(&tfrms[i], &quat, &vec)
+		P3MakeFromV3(&pnts[i], &vec)
+	}
+	return tfrms, pnts
+}
+
+func BenchmarkT3MulP3AoS(b *testing.B) {
+	tfrms, pnts := makeBenchTransforms()
+	result := make([]Point3, benchElems)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range tfrms {
+			T3MulP3(&result[i], &tfrms[i], &pnts[i])
+		}
+	}
+}
+
+func BenchmarkT3MulP3SoA(b *testing.B) {
+	tfrms, pnts := makeBenchTransforms()
+	tfrmsSoA := make([]Transform3SoA, benchElems/4)
+	pntsSoA := make([]Point3SoA, benchElems/4)
+	result := make([]Point3SoA, benchElems/4)
+	for i := range tfrmsSoA {
+		T3SoAMakeFrom4AoS(&tfrmsSoA[i], &tfrms[4*i], &tfrms[4*i+1], &tfrms[4*i+2], &tfrms[4*i+3])
+		P3SoAMakeFrom4AoS(&pntsSoA[i], &pnts[4*i], &pnts[4*i+1], &pnts[4*i+2], &pnts[4*i+3])
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range tfrmsSoA {
+			T3SoAMulP3SoA(&result[i], &tfrmsSoA[i], &pntsSoA[i])
+		}
+	}
+}
+
+func makeBenchRotations() ([]Quat, []Vector3) {
+	rng := rand.New(rand.NewSource(13))
+	quats := make([]Quat, benchElems)
+	vecs := make([]Vector3, benchElems)
+	for i := range quats {
+		quats[i], vecs[i] = randUnitQ(rng), randV3(rng)
+	}
+	return quats, vecs
+}
+
+func BenchmarkQRotateAoS(b *testing.B) {
+	quats, vecs := makeBenchRotations()
+	result := make([]Vector3, benchElems)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range quats {
+			QRotate(&result[i], &quats[i], &vecs[i])
+		}
+	}
+}
+
+func BenchmarkQRotateSoA(b *testing.B) {
+	quats, vecs := makeBenchRotations()
+	quatsSoA := make([]QuatSoA, benchElems/4)
+	vecsSoA := make([]Vector3SoA, benchElems/4)
+	result := make([]Vector3SoA, benchElems/4)
+	for i := range quatsSoA {
+		QSoAMakeFrom4AoS(&quatsSoA[i], &quats[4*i], &quats[4*i+1], &quats[4*i+2], &quats[4*i+3])
+		V3SoAMakeFrom4AoS(&vecsSoA[i], &vecs[4*i], &vecs[4*i+1], &vecs[4*i+2], &vecs[4*i+3])
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range quatsSoA {
+			QSoARotate(&result[i], &quatsSoA[i], &vecsSoA[i])
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// QuatSpline is a SQUAD spline through a sequence of key orientations. Build
+// one with QSplineMake.
+type QuatSpline = QuatSplineOf[float32]
+
+// QAlignHemispheres copies keys to result, negating each key as needed to
+// have a non-negative dot product with the one before it. q and -q are the
+// same rotation, but interpolating between keys in opposite hemispheres goes
+// the long way round. result and keys may be the same slice.
+func QAlignHemispheres(result, keys []Quat) {
+	qAlignHemispheres(result, keys)
+}
+
+// QSquadControlPoints computes the inner control quaternion for each key, so
+// that QSquad(result, t, &keys[i], &ctrl[i], &ctrl[i+1], &keys[i+1]) moves from
+// keys[i] to keys[i+1] with an angular velocity that is continuous across
+// keys. The keys should be aligned with QAlignHemispheres first. The end keys
+// are their own control points. result and unitKeys may be the same slice.
+// QSquad takes the shortest path in each of its slerps, which can flip the
+// sign of its result partway through a segment when the control points lie
+// in a different hemisphere from the keys; QSplineEval does not negate, and
+// gives a result that is continuous in sign.
+func QSquadControlPoints(result, unitKeys []Quat) {
+	qSquadControlPoints(result, unitKeys)
+}
+
+/*******/
+
+// QSplineMake sets up result to interpolate unitKeys, the orientations at
+// the given times, reusing result's slices where possible. It aligns the keys'
+// hemispheres and computes their control points. It returns false, leaving
+// result unchanged, if there are no keys or the times are not strictly
+// increasing, and panics if the slices differ in length.
+func QSplineMake(result *QuatSpline, times []float32, unitKeys []Quat) bool {
+	return qSplineMake(result, times, unitKeys)
+}
+
+// QSplineEval samples spline at time, clamped to the times of the first and
+// last keys; a NaN time gives the first key. The control points assume
+// roughly even spacing between keys; strongly uneven spacing gives a visible
+// change in angular velocity at the keys.
+func QSplineEval(result *Quat, spline *QuatSpline, time float32) {
+	qSplineEval(result, spline, time)
+}
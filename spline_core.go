@@ -0,0 +1,143 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "sort"
+
+// QuatSplineOf is a SQUAD spline through a sequence of key orientations.
+// Controls holds the inner control quaternion for each key, as computed by
+// qSquadControlPoints.
+type QuatSplineOf[T Float] struct {
+	Times    []T
+	Keys     []QuatOf[T]
+	Controls []QuatOf[T]
+}
+
+// qAlignHemispheres negates keys as needed so that each has a non-negative
+// dot product with the one before it. q and -q are the same rotation, but
+// interpolating between keys in opposite hemispheres takes the long way
+// round.
+func qAlignHemispheres[T Float](result, keys []QuatOf[T]) {
+	var tmpQ_0 QuatOf[T]
+	if len(result) != len(keys) {
+		panic("vectormath: slice length mismatch")
+	}
+	for i := range keys {
+		qCopy(&tmpQ_0, &keys[i])
+		if i > 0 && qDot(&result[i-1], &tmpQ_0) < 0.0 {
+			qNeg(&tmpQ_0, &tmpQ_0)
+		}
+		qCopy(&result[i], &tmpQ_0)
+	}
+}
+
+// qSquadControlPoints computes
+//
+//	s[i] = q[i] * exp(-(log(q[i]^-1 * q[i+1]) + log(q[i]^-1 * q[i-1])) / 4)
+//
+// for each interior key, which makes the spline's angular velocity
+// continuous across keys. The end keys are their own control points.
+func qSquadControlPoints[T Float](result, unitKeys []QuatOf[T]) {
+	var inv, prevKey, key, tmpQ_0, tmpQ_1 QuatOf[T]
+	n := len(unitKeys)
+	if len(result) != n {
+		panic("vectormath: slice length mismatch")
+	}
+	// The previous key is kept aside in case result and unitKeys are the
+	// same slice.
+	for i := 0; i < n; i++ {
+		qCopy(&key, &unitKeys[i])
+		if i > 0 && i < n-1 {
+			qConj(&inv, &key)
+			qMul(&tmpQ_0, &inv, &unitKeys[i+1])
+			qLog(&tmpQ_0, &tmpQ_0)
+			qMul(&tmpQ_1, &inv, &prevKey)
+			qLog(&tmpQ_1, &tmpQ_1)
+			qAdd(&tmpQ_0, &tmpQ_0, &tmpQ_1)
+			qScalarMul(&tmpQ_0, &tmpQ_0, -0.25)
+			qExp(&tmpQ_0, &tmpQ_0)
+			qMul(&result[i], &key, &tmpQ_0)
+		} else {
+			qCopy(&result[i], &key)
+		}
+		qCopy(&prevKey, &key)
+	}
+}
+
+// qSlerpNoFlip is qSlerp without the negation that takes the shortest
+// path. The control points need not lie in the same hemisphere as their
+// keys, or as each other, so negating inside the SQUAD slerps would flip
+// the sign of the result partway through a segment.
+func qSlerpNoFlip[T Float](result *QuatOf[T], t T, unitQuat0, unitQuat1 *QuatOf[T]) {
+	var tmpQ_0, tmpQ_1 QuatOf[T]
+	var scale0, scale1 T
+	cosAngle := qDot(unitQuat0, unitQuat1)
+	if cosAngle < g_SLERP_TOL {
+		angle := acos(cosAngle)
+		recipSinAngle := 1.0 / sin(angle)
+		scale0 = sin((1.0-t)*angle) * recipSinAngle
+		scale1 = sin(t*angle) * recipSinAngle
+	} else {
+		scale0 = 1.0 - t
+		scale1 = t
+	}
+	qScalarMul(&tmpQ_0, unitQuat0, scale0)
+	qScalarMul(&tmpQ_1, unitQuat1, scale1)
+	qAdd(result, &tmpQ_0, &tmpQ_1)
+}
+
+// qSquadNoFlip is qSquad built on qSlerpNoFlip. Its result is a continuous
+// function of t that starts at unitQuat0 and ends at unitQuat3 exactly,
+// with no change of sign.
+func qSquadNoFlip[T Float](result *QuatOf[T], t T, unitQuat0, unitQuat1, unitQuat2, unitQuat3 *QuatOf[T]) {
+	var tmp0, tmp1 QuatOf[T]
+	qSlerpNoFlip(&tmp0, t, unitQuat0, unitQuat3)
+	qSlerpNoFlip(&tmp1, t, unitQuat1, unitQuat2)
+	qSlerpNoFlip(result, (2.0*t)*(1.0-t), &tmp0, &tmp1)
+}
+
+// qSplineMake copies times and keys into result, aligns the keys'
+// hemispheres and computes the control points. It returns false, leaving
+// result unchanged, if there are no keys or the times are not strictly
+// increasing.
+func qSplineMake[T Float](result *QuatSplineOf[T], times []T, unitKeys []QuatOf[T]) bool {
+	if len(times) != len(unitKeys) {
+		panic("vectormath: slice length mismatch")
+	}
+	if len(times) == 0 {
+		return false
+	}
+	for i := 1; i < len(times); i++ {
+		if !(times[i] > times[i-1]) {
+			return false
+		}
+	}
+	result.Times = append(result.Times[:0], times...)
+	result.Keys = append(result.Keys[:0], unitKeys...)
+	result.Controls = append(result.Controls[:0], unitKeys...)
+	qAlignHemispheres(result.Keys, result.Keys)
+	qSquadControlPoints(result.Controls, result.Keys)
+	return true
+}
+
+// qSplineEval samples the spline at time, which is clamped to the range of
+// the keys; a NaN time gives the first key. Since the keys are aligned and qSquadNoFlip never negates, the
+// result is continuous in sign as well as in rotation.
+func qSplineEval[T Float](result *QuatOf[T], spline *QuatSplineOf[T], time T) {
+	times := spline.Times
+	n := len(times)
+	// Written so that a NaN time also takes this branch.
+	if !(time > times[0]) {
+		qCopy(result, &spline.Keys[0])
+		return
+	}
+	if time >= times[n-1] {
+		qCopy(result, &spline.Keys[n-1])
+		return
+	}
+	// times[i] <= time < times[i+1]
+	i := sort.Search(n, func(j int) bool { return times[j] > time }) - 1
+	t := (time - times[i]) / (times[i+1] - times[i])
+	qSquadNoFlip(result, t, &spline.Keys[i], &spline.Controls[i], &spline.Controls[i+1], &spline.Keys[i+1])
+}
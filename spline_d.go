@@ -0,0 +1,24 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type QuatSplined = QuatSplineOf[float64]
+
+func QdAlignHemispheres(result, keys []Quatd) {
+	qAlignHemispheres(result, keys)
+}
+
+func QdSquadControlPoints(result, unitKeys []Quatd) {
+	qSquadControlPoints(result, unitKeys)
+}
+
+/*******/
+
+func QSplinedMake(result *QuatSplined, times []float64, unitKeys []Quatd) bool {
+	return qSplineMake(result, times, unitKeys)
+}
+
+func QSplinedEval(result *Quatd, spline *QuatSplined, time float64) {
+	qSplineEval(result, spline, time)
+}
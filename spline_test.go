@@ -0,0 +1,134 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomSplined makes a spline through n random keys at times 0, 1, ...,
+// n-1, so neighbouring keys can be up to a half turn apart and given in
+// either hemisphere.
+func randomSplined(rng *rand.Rand, n int) (QuatSplined, []Quatd) {
+	var spline QuatSplined
+	keys := make([]Quatd, n)
+	times := make([]float64, n)
+	for i := range keys {
+		keys[i] = Quatd{X: rng.Float64() - 0.5, Y: rng.Float64() - 0.5, Z: rng.Float64() - 0.5, W: rng.Float64() - 0.5}
+		QdNormalize(&keys[i], &keys[i])
+		times[i] = float64(i)
+	}
+	if !QSplinedMake(&spline, times, keys) {
+		panic("QSplinedMake rejected increasing times")
+	}
+	return spline, keys
+}
+
+func TestQSplinePassesThroughKeys(t *testing.T) {
+	rng := rand.New(rand.NewSource(17))
+	spline, keys := randomSplined(rng, 8)
+	for i := range keys {
+		var got Quatd
+		QSplinedEval(&got, &spline, spline.Times[i])
+		if !qNear(&got, &spline.Keys[i], 1e-12) {
+			t.Errorf("spline at key %d = %v, want %v", i, got, spline.Keys[i])
+		}
+		// Up to the hemisphere alignment, the keys are those given.
+		if d := math.Abs(QdDot(&got, &keys[i])); !near(d, 1.0, 1e-12) {
+			t.Errorf("spline at key %d is not the rotation %v", i, keys[i])
+		}
+	}
+	var got Quatd
+	QSplinedEval(&got, &spline, -5.0)
+	if got != spline.Keys[0] {
+		t.Errorf("spline before the first key = %v, want %v", got, spline.Keys[0])
+	}
+	QSplinedEval(&got, &spline, 100.0)
+	if got != spline.Keys[7] {
+		t.Errorf("spline after the last key = %v, want %v", got, spline.Keys[7])
+	}
+	QSplinedEval(&got, &spline, math.NaN())
+	if got != spline.Keys[0] {
+		t.Errorf("spline at NaN = %v, want %v", got, spline.Keys[0])
+	}
+}
+
+// The output should never change sign, inside a segment or across a key,
+// even when the keys are far apart and the control points fall in the
+// other hemisphere.
+func TestQSplineContinuity(t *testing.T) {
+	const step = 1e-3
+	rng := rand.New(rand.NewSource(18))
+	for trial := 0; trial < 50; trial++ {
+		spline, _ := randomSplined(rng, 10)
+		var prev, cur Quatd
+		QSplinedEval(&prev, &spline, 0.0)
+		for time := step; time <= 9.0; time += step {
+			QSplinedEval(&cur, &spline, time)
+			if d := QdDot(&prev, &cur); d < 0.99 {
+				t.Fatalf("trial %d: spline jumps between %v and %v (dot %v)", trial, time-step, time, d)
+			}
+			prev = cur
+		}
+	}
+}
+
+// Across each interior key the angular velocity, estimated by finite
+// differences, should be continuous.
+func TestQSplineAngularVelocity(t *testing.T) {
+	const h = 1e-5
+	rng := rand.New(rand.NewSource(19))
+	spline, _ := randomSplined(rng, 6)
+	angVel := func(time float64) Vector3d {
+		var q0, q1, diff Quatd
+		QSplinedEval(&q0, &spline, time-h)
+		QSplinedEval(&q1, &spline, time+h)
+		QdConj(&q0, &q0)
+		QdMul(&diff, &q1, &q0)
+		QdLog(&diff, &diff)
+		return Vector3d{X: diff.X / h, Y: diff.Y / h, Z: diff.Z / h}
+	}
+	for i := 1; i < 5; i++ {
+		before := angVel(float64(i) - 3.0*h)
+		after := angVel(float64(i) + 3.0*h)
+		if !v3Near(&before, &after, 1e-3) {
+			t.Errorf("angular velocity jumps at key %d from %v to %v", i, before, after)
+		}
+	}
+}
+
+func TestQSplineMakeRejects(t *testing.T) {
+	var spline QuatSpline
+	keys := []Quat{{W: 1.0}, {W: 1.0}}
+	if QSplineMake(&spline, []float32{0.0, 0.0}, keys) {
+		t.Errorf("QSplineMake accepted repeated times")
+	}
+	if QSplineMake(&spline, nil, nil) {
+		t.Errorf("QSplineMake accepted no keys")
+	}
+	if !QSplineMake(&spline, []float32{2.0}, keys[:1]) {
+		t.Fatalf("QSplineMake rejected a single key")
+	}
+	var got Quat
+	QSplineEval(&got, &spline, 3.0)
+	if got != keys[0] {
+		t.Errorf("single key spline = %v, want %v", got, keys[0])
+	}
+}
+
+func TestQSquadControlPointsInPlace(t *testing.T) {
+	rng := rand.New(rand.NewSource(20))
+	spline, _ := randomSplined(rng, 5)
+	controls := make([]Quatd, 5)
+	QdSquadControlPoints(controls, spline.Keys)
+	inPlace := append([]Quatd(nil), spline.Keys...)
+	QdSquadControlPoints(inPlace, inPlace)
+	for i := range controls {
+		if inPlace[i] != controls[i] {
+			t.Errorf("in place control point %d = %v, want %v", i, inPlace[i], controls[i])
+		}
+	}
+}
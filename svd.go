@@ -0,0 +1,23 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// M3SVD computes the singular value decomposition mat = u * diag(sigma) * v^T,
+// where u and v are rotations. The singular values are sorted by decreasing
+// magnitude, and sigma.Z is negative if mat contains a reflection, so that
+// u and v need not. Rank-deficient matrices, including zero, are handled: u
+// is still a rotation, with its columns for zero singular values chosen
+// orthogonal to the others.
+func M3SVD(u *Matrix3, sigma *Vector3, v *Matrix3, mat *Matrix3) {
+	m3SVD(u, sigma, v, mat)
+}
+
+// M3PolarDecompose factors mat as rotation * stretch, where rotation is the
+// rotation closest to mat and stretch is symmetric. This gives the optimal
+// rotation in shape matching and in the Kabsch algorithm, where mat is the sum
+// of V3Outer(target, source) over pairs of centred points. If mat contains a
+// reflection, stretch has a negative eigenvalue.
+func M3PolarDecompose(rotation, stretch *Matrix3, mat *Matrix3) {
+	m3PolarDecompose(rotation, stretch, mat)
+}
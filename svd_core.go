@@ -0,0 +1,147 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// g_JACOBI_MAX_SWEEPS bounds the Jacobi iterations. Convergence is
+// quadratic, so for 3x3 matrices a handful of sweeps reaches machine
+// precision; the bound only guards against NaN and infinite inputs.
+const g_JACOBI_MAX_SWEEPS = 32
+
+// jacobiRotation returns the cosine and sine of the rotation that zeroes
+// the off-diagonal element gamma of the symmetric 2x2 matrix
+// | alpha gamma; gamma beta |, taking the smaller of the two possible
+// angles.
+func jacobiRotation[T Float](alpha, beta, gamma T) (T, T) {
+	zeta := (beta - alpha) / (2.0 * gamma)
+	t := 1.0 / (abs(zeta) + sqrt(1.0+zeta*zeta))
+	if zeta < 0.0 {
+		t = -t
+	}
+	c := 1.0 / sqrt(1.0+t*t)
+	return c, c * t
+}
+
+// v3Rotate2 applies the plane rotation (c, s) to the pair of vectors
+// (vec0, vec1) in place.
+func v3Rotate2[T Float](vec0, vec1 *Vector3Of[T], c, s T) {
+	var tmpV3_0, tmpV3_1, tmpV3_2 Vector3Of[T]
+	v3ScalarMul(&tmpV3_0, vec0, c)
+	v3ScalarMul(&tmpV3_1, vec1, s)
+	v3Sub(&tmpV3_2, &tmpV3_0, &tmpV3_1)
+	v3ScalarMul(&tmpV3_0, vec0, s)
+	v3ScalarMul(&tmpV3_1, vec1, c)
+	v3Add(vec1, &tmpV3_0, &tmpV3_1)
+	v3Copy(vec0, &tmpV3_2)
+}
+
+// m3SVD factors mat as U * diag(sigma) * V^T, with U and V rotations.
+//
+// It uses one-sided Jacobi: plane rotations are applied to the columns of
+// mat, and accumulated in V, until the columns are mutually orthogonal.
+// Their lengths are then the singular values, and normalizing them gives
+// U. This never forms mat^T * mat, so it keeps full accuracy on the small
+// singular values. The columns of U for zero singular values are completed
+// with cross products, so a rank-deficient mat still gives a rotation.
+//
+// The singular values are sorted by decreasing magnitude. To keep U and V
+// proper rotations, sigma.Z is negative when mat contains a reflection,
+// i.e. when its determinant is negative.
+func m3SVD[T Float](u *Matrix3Of[T], sigma *Vector3Of[T], v *Matrix3Of[T], mat *Matrix3Of[T]) {
+	var w, vc [3]Vector3Of[T]
+	var s [3]T
+	w[0], w[1], w[2] = mat.col0, mat.col1, mat.col2
+	v3MakeXAxis(&vc[0])
+	v3MakeYAxis(&vc[1])
+	v3MakeZAxis(&vc[2])
+	eps := machineEpsilon[T]()
+	for sweep := 0; sweep < g_JACOBI_MAX_SWEEPS; sweep++ {
+		rotated := false
+		for _, pq := range [3][2]int{{0, 1}, {0, 2}, {1, 2}} {
+			p, q := pq[0], pq[1]
+			alpha := w[p].LengthSqr()
+			beta := w[q].LengthSqr()
+			gamma := v3Dot(&w[p], &w[q])
+			if !(abs(gamma) > eps*sqrt(alpha*beta)) {
+				continue
+			}
+			c, sn := jacobiRotation(alpha, beta, gamma)
+			v3Rotate2(&w[p], &w[q], c, sn)
+			v3Rotate2(&vc[p], &vc[q], c, sn)
+			rotated = true
+		}
+		if !rotated {
+			break
+		}
+	}
+
+	for i := range w {
+		s[i] = w[i].Length()
+	}
+	// Sort by decreasing singular value.
+	for i := 0; i < 2; i++ {
+		for j := 2; j > i; j-- {
+			if s[j] > s[j-1] {
+				s[j], s[j-1] = s[j-1], s[j]
+				w[j], w[j-1] = w[j-1], w[j]
+				vc[j], vc[j-1] = vc[j-1], vc[j]
+			}
+		}
+	}
+
+	// Columns whose singular value is negligible have no reliable direction
+	// and are rebuilt to be orthogonal to the others.
+	tol := 16.0 * eps * s[0]
+	switch {
+	case !(s[0] > 0.0):
+		v3MakeXAxis(&w[0])
+		v3MakeYAxis(&w[1])
+		v3MakeZAxis(&w[2])
+	case !(s[1] > tol):
+		v3ScalarDiv(&w[0], &w[0], s[0])
+		v3MakePerpendicular(&w[1], &w[0])
+		v3Cross(&w[2], &w[0], &w[1])
+	case !(s[2] > tol):
+		v3ScalarDiv(&w[0], &w[0], s[0])
+		v3ScalarDiv(&w[1], &w[1], s[1])
+		v3Cross(&w[2], &w[0], &w[1])
+	default:
+		v3ScalarDiv(&w[0], &w[0], s[0])
+		v3ScalarDiv(&w[1], &w[1], s[1])
+		v3ScalarDiv(&w[2], &w[2], s[2])
+	}
+
+	m3MakeFromCols(u, &w[0], &w[1], &w[2])
+	m3MakeFromCols(v, &vc[0], &vc[1], &vc[2])
+	// Negating the same column of U and V leaves the product unchanged;
+	// negating a column of U alone is compensated by negating its singular
+	// value.
+	if v.Determinant() < 0.0 {
+		v3Neg(&v.col2, &v.col2)
+		v3Neg(&u.col2, &u.col2)
+	}
+	if u.Determinant() < 0.0 {
+		v3Neg(&u.col2, &u.col2)
+		s[2] = -s[2]
+	}
+	v3MakeFromElems(sigma, s[0], s[1], s[2])
+}
+
+// m3PolarDecompose factors mat as rotation * stretch, where rotation is the
+// rotation closest to mat and stretch is symmetric. Both come from the SVD:
+// rotation = U * V^T and stretch = V * diag(sigma) * V^T. When mat contains
+// a reflection the closest rotation cannot absorb it, and stretch has a
+// negative eigenvalue.
+func m3PolarDecompose[T Float](rotation, stretch *Matrix3Of[T], mat *Matrix3Of[T]) {
+	var u, v, vt, tmpM3_0, tmpM3_1 Matrix3Of[T]
+	var sigma Vector3Of[T]
+	m3SVD(&u, &sigma, &v, mat)
+	m3Transpose(&vt, &v)
+	m3AppendScale(&tmpM3_0, &v, &sigma)
+	m3Mul(&tmpM3_0, &tmpM3_0, &vt)
+	// Rounding leaves the product very slightly asymmetric.
+	m3Transpose(&tmpM3_1, &tmpM3_0)
+	m3Add(&tmpM3_0, &tmpM3_0, &tmpM3_1)
+	m3Mul(rotation, &u, &vt)
+	m3ScalarMul(stretch, &tmpM3_0, 0.5)
+}
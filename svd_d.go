@@ -0,0 +1,12 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func M3dSVD(u *Matrix3d, sigma *Vector3d, v *Matrix3d, mat *Matrix3d) {
+	m3SVD(u, sigma, v, mat)
+}
+
+func M3dPolarDecompose(rotation, stretch *Matrix3d, mat *Matrix3d) {
+	m3PolarDecompose(rotation, stretch, mat)
+}
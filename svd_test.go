@@ -0,0 +1,215 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randM3d(rng *rand.Rand) Matrix3d {
+	var mat Matrix3d
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 3; row++ {
+			mat.SetElem(col, row, rng.Float64()*4.0-2.0)
+		}
+	}
+	return mat
+}
+
+func randRotationd(rng *rand.Rand) Matrix3d {
+	var axis Vector3d
+	var rot Matrix3d
+	V3dMakeFromElems(&axis, rng.NormFloat64(), rng.NormFloat64(), rng.NormFloat64())
+	V3dNormalize(&axis, &axis)
+	M3dMakeRotationAxis(&rot, (rng.Float64()*2.0-1.0)*3.1, &axis)
+	return rot
+}
+
+// isRotation reports whether mat is orthonormal with determinant 1.
+func isRotation[T Float](mat *Matrix3Of[T], tol T) bool {
+	var matT, prod, ident Matrix3Of[T]
+	m3Transpose(&matT, mat)
+	m3Mul(&prod, &matT, mat)
+	m3MakeIdentity(&ident)
+	return m3Near(&prod, &ident, tol) && near(mat.Determinant(), 1.0, tol)
+}
+
+// svdProduct rebuilds u * diag(sigma) * v^T.
+func svdProduct[T Float](u *Matrix3Of[T], sigma *Vector3Of[T], v *Matrix3Of[T]) Matrix3Of[T] {
+	var vt, result Matrix3Of[T]
+	m3Transpose(&vt, v)
+	m3AppendScale(&result, u, sigma)
+	m3Mul(&result, &result, &vt)
+	return result
+}
+
+// checkSVD checks the properties M3SVD documents for the factors of mat.
+func checkSVD[T Float](t *testing.T, name string, mat, u *Matrix3Of[T], sigma *Vector3Of[T], v *Matrix3Of[T], tol T) {
+	t.Helper()
+	if !isRotation(u, tol) || !isRotation(v, tol) {
+		t.Errorf("%s: u = %v, v = %v, want rotations", name, u.String(), v.String())
+	}
+	if !(sigma.X >= sigma.Y && sigma.Y >= abs(sigma.Z) && sigma.Y >= 0.0) {
+		t.Errorf("%s: sigma = %v, want decreasing magnitudes", name, sigma)
+	}
+	if det := mat.Determinant(); (det < 0.0) != (sigma.Z < 0.0) && abs(det) > tol {
+		t.Errorf("%s: sigma = %v for a determinant of %v", name, sigma, det)
+	}
+	if prod := svdProduct(u, sigma, v); !m3Near(&prod, mat, tol*(1.0+abs(sigma.X))) {
+		t.Errorf("%s: u * diag(sigma) * v^T = %v, want %v", name, prod.String(), mat.String())
+	}
+}
+
+func TestM3SVDRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(24))
+	for i := 0; i < 500; i++ {
+		var u, v Matrix3d
+		var sigma Vector3d
+		mat := randM3d(rng)
+		M3dSVD(&u, &sigma, &v, &mat)
+		checkSVD(t, "random", &mat, &u, &sigma, &v, 1e-12)
+
+		var mat32, u32, v32 Matrix3
+		var sigma32 Vector3
+		M3MakeFromM3d(&mat32, &mat)
+		M3SVD(&u32, &sigma32, &v32, &mat32)
+		checkSVD(t, "random float32", &mat32, &u32, &sigma32, &v32, 2e-5)
+	}
+}
+
+func TestM3SVDKnownFactors(t *testing.T) {
+	// A matrix built from known rotations and singular values gives those
+	// singular values back, to full relative accuracy even when they span
+	// many orders of magnitude.
+	rng := rand.New(rand.NewSource(2))
+	for _, want := range []Vector3d{
+		{X: 3.0, Y: 2.0, Z: 1.0},
+		{X: 5.0, Y: 5.0, Z: 0.5},
+		{X: 1.0, Y: 1.0, Z: 1.0},
+		{X: 4.0, Y: 1.0, Z: -0.25},
+		{X: 1.0, Y: 1e-4, Z: 1e-8},
+	} {
+		var mat, u, v Matrix3d
+		var sigma Vector3d
+		rot0, rot1 := randRotationd(rng), randRotationd(rng)
+		mat = svdProduct(&rot0, &want, &rot1)
+		M3dSVD(&u, &sigma, &v, &mat)
+		checkSVD(t, "known factors", &mat, &u, &sigma, &v, 1e-12)
+		if !near(sigma.X, want.X, 1e-12*want.X) || !near(sigma.Y, want.Y, 1e-10*want.Y) ||
+			!near(sigma.Z, want.Z, 1e-6*abs(want.Z)) {
+			t.Errorf("M3dSVD of %v gives singular values %v, want %v", mat.String(), sigma, want)
+		}
+	}
+}
+
+func TestM3SVDRankDeficient(t *testing.T) {
+	var col0, col1, col2 Vector3d
+	V3dMakeFromElems(&col0, 1.0, 2.0, -0.5)
+	V3dMakeFromElems(&col1, -3.0, 0.25, 1.0)
+	V3dAdd(&col2, &col0, &col1)
+	var rank2, rank1, zero Matrix3d
+	M3dMakeFromCols(&rank2, &col0, &col1, &col2)
+	V3dOuter(&rank1, &col0, &col1)
+	for _, c := range []struct {
+		name string
+		mat  Matrix3d
+		rank int
+	}{{"rank 2", rank2, 2}, {"rank 1", rank1, 1}, {"zero", zero, 0}} {
+		var u, v Matrix3d
+		var sigma Vector3d
+		M3dSVD(&u, &sigma, &v, &c.mat)
+		checkSVD(t, c.name, &c.mat, &u, &sigma, &v, 1e-12)
+		s := [3]float64{sigma.X, sigma.Y, sigma.Z}
+		for i := c.rank; i < 3; i++ {
+			if !near(s[i], 0.0, 1e-12) {
+				t.Errorf("%s: sigma = %v, want %d zero singular values", c.name, sigma, 3-c.rank)
+			}
+		}
+	}
+}
+
+func TestM3PolarDecompose(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for i := 0; i < 200; i++ {
+		var rotation, stretch, prod, stretchT Matrix3d
+		mat := randM3d(rng)
+		M3dPolarDecompose(&rotation, &stretch, &mat)
+		if !isRotation(&rotation, 1e-12) {
+			t.Errorf("rotation = %v, want a rotation", rotation.String())
+		}
+		M3dTranspose(&stretchT, &stretch)
+		if stretchT != stretch {
+			t.Errorf("stretch = %v, want exactly symmetric", stretch.String())
+		}
+		M3dMul(&prod, &rotation, &stretch)
+		if !m3Near(&prod, &mat, 1e-12) {
+			t.Errorf("rotation * stretch = %v, want %v", prod.String(), mat.String())
+		}
+		// stretch is positive semidefinite unless mat is a reflection, in
+		// which case its determinant, the same as mat's, is negative.
+		det := mat.Determinant()
+		if !near(stretch.Determinant(), det, 1e-12) {
+			t.Errorf("stretch has determinant %v, want %v", stretch.Determinant(), det)
+		}
+		if det > 0.0 {
+			for n := 0; n < 4; n++ {
+				var vec, tmp Vector3d
+				V3dMakeFromElems(&vec, rng.NormFloat64(), rng.NormFloat64(), rng.NormFloat64())
+				M3dMulV3d(&tmp, &stretch, &vec)
+				if q := V3dDot(&vec, &tmp); q < -1e-12 {
+					t.Errorf("stretch = %v is not positive semidefinite: %v", stretch.String(), q)
+				}
+			}
+		}
+	}
+}
+
+func TestM3PolarDecomposeKabsch(t *testing.T) {
+	// The rotation part of the cross-covariance of rotated points recovers
+	// the rotation, even with noise on the targets.
+	rng := rand.New(rand.NewSource(4))
+	for i := 0; i < 20; i++ {
+		want := randRotationd(rng)
+		var cov, rotation, stretch Matrix3d
+		for n := 0; n < 10; n++ {
+			var source, target, noise Vector3d
+			var outer Matrix3d
+			V3dMakeFromElems(&source, rng.NormFloat64(), rng.NormFloat64(), rng.NormFloat64())
+			M3dMulV3d(&target, &want, &source)
+			V3dMakeFromElems(&noise, rng.NormFloat64(), rng.NormFloat64(), rng.NormFloat64())
+			V3dScalarMul(&noise, &noise, 1e-9)
+			V3dAdd(&target, &target, &noise)
+			V3dOuter(&outer, &target, &source)
+			M3dAdd(&cov, &cov, &outer)
+		}
+		M3dPolarDecompose(&rotation, &stretch, &cov)
+		if !m3Near(&rotation, &want, 1e-8) {
+			t.Errorf("Kabsch rotation = %v, want %v", rotation.String(), want.String())
+		}
+	}
+
+	// Float32 cross-covariance, with the shape matching use in mind.
+	var axis Vector3
+	var want, cov, rotation, stretch Matrix3
+	V3MakeFromElems(&axis, 0.0, 0.6, 0.8)
+	M3MakeRotationAxis(&want, 2.0, &axis)
+	for _, source := range []Vector3{{X: 1.0}, {Y: 2.0}, {Z: -0.5}, {X: 1.0, Y: 1.0, Z: 1.0}} {
+		var target Vector3
+		var outer Matrix3
+		M3MulV3(&target, &want, &source)
+		V3Outer(&outer, &target, &source)
+		M3Add(&cov, &cov, &outer)
+	}
+	M3PolarDecompose(&rotation, &stretch, &cov)
+	if !m3Near(&rotation, &want, 1e-5) {
+		t.Errorf("M3PolarDecompose rotation = %v, want %v", rotation.String(), want.String())
+	}
+	var rotationT, wantStretch Matrix3
+	M3Transpose(&rotationT, &want)
+	M3Mul(&wantStretch, &rotationT, &cov)
+	if !m3Near(&stretch, &wantStretch, 1e-5) {
+		t.Errorf("M3PolarDecompose stretch = %v, want %v", stretch.String(), wantStretch.String())
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// QSwingTwist splits unitQuat into swing * twist, where twist is a rotation
+// about unitAxis and swing a rotation about an axis perpendicular to it, so
+// the twist is applied first. twist has a non-negative W. If unitQuat turns
+// unitAxis round by exactly pi the split is not unique, and twist is the
+// identity.
+func QSwingTwist(swing, twist *Quat, unitQuat *Quat, unitAxis *Vector3) {
+	qSwingTwist(swing, twist, unitQuat, unitAxis)
+}
+
+// QMakeFromSwingTwist recombines the results of QSwingTwist.
+func QMakeFromSwingTwist(result, swing, twist *Quat) {
+	qMakeFromSwingTwist(result, swing, twist)
+}
+
+// QGetTwistAngle returns the angle, in [-pi, pi], by which twist rotates about
+// unitAxis.
+func QGetTwistAngle(twist *Quat, unitAxis *Vector3) float32 {
+	return qGetTwistAngle(twist, unitAxis)
+}
+
+/*******/
+
+// QClampTwist limits the angle of twist about unitAxis to the range
+// [minRadians, maxRadians]. It reports whether twist was outside the range.
+func QClampTwist(result, twist *Quat, unitAxis *Vector3, minRadians, maxRadians float32) bool {
+	return qClampTwist(result, twist, unitAxis, minRadians, maxRadians)
+}
+
+// QClampSwing limits swing to an elliptical cone around unitTwistAxis.
+// maxRadians1 is the largest swing allowed about unitRefAxis, which must be
+// perpendicular to unitTwistAxis, and maxRadians2 the largest about
+// unitTwistAxis x unitRefAxis. A swing outside the cone is scaled back onto its
+// edge, keeping its direction, and a zero limit forbids any swing about that
+// axis. It reports whether swing was outside the cone.
+func QClampSwing(result, swing *Quat, unitTwistAxis, unitRefAxis *Vector3, maxRadians1, maxRadians2 float32) bool {
+	return qClampSwing(result, swing, unitTwistAxis, unitRefAxis, maxRadians1, maxRadians2)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// qSwingTwist splits unitQuat into swing * twist, where twist rotates about
+// unitAxis and swing rotates about an axis perpendicular to it. The twist
+// is the projection of unitQuat onto rotations about unitAxis, and has a
+// non-negative W. When unitQuat swings unitAxis round by exactly pi the
+// twist is undefined and is taken to be the identity.
+func qSwingTwist[T Float](swing, twist *QuatOf[T], unitQuat *QuatOf[T], unitAxis *Vector3Of[T]) {
+	var tmpV3_0 Vector3Of[T]
+	var tmpQ_0, tmpQ_1 QuatOf[T]
+	v3MakeFromElems(&tmpV3_0, unitQuat.X, unitQuat.Y, unitQuat.Z)
+	v3ScalarMul(&tmpV3_0, unitAxis, v3Dot(&tmpV3_0, unitAxis))
+	qMakeFromV3Scalar(&tmpQ_0, &tmpV3_0, unitQuat.W)
+	if tmpQ_0.W < 0.0 {
+		qNeg(&tmpQ_0, &tmpQ_0)
+	}
+	if tmpQ_0.Norm() < 16.0*machineEpsilon[T]() {
+		qMakeIdentity(&tmpQ_0)
+	} else {
+		qNormalize(&tmpQ_0, &tmpQ_0)
+	}
+	qConj(&tmpQ_1, &tmpQ_0)
+	qMul(swing, unitQuat, &tmpQ_1)
+	qCopy(twist, &tmpQ_0)
+}
+
+// qMakeFromSwingTwist is the inverse of qSwingTwist.
+func qMakeFromSwingTwist[T Float](result, swing, twist *QuatOf[T]) {
+	qMul(result, swing, twist)
+}
+
+// qGetTwistAngle returns the angle in [-pi, pi] by which twist rotates
+// about unitAxis.
+func qGetTwistAngle[T Float](twist *QuatOf[T], unitAxis *Vector3Of[T]) T {
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromElems(&tmpV3_0, twist.X, twist.Y, twist.Z)
+	s := v3Dot(&tmpV3_0, unitAxis)
+	w := twist.W
+	if w < 0.0 {
+		s, w = -s, -w
+	}
+	return 2.0 * atan2(s, w)
+}
+
+// qClampTwist limits the twist about unitAxis to [minRadians, maxRadians].
+// It reports whether any clamping was needed.
+func qClampTwist[T Float](result, twist *QuatOf[T], unitAxis *Vector3Of[T], minRadians, maxRadians T) bool {
+	angle := qGetTwistAngle(twist, unitAxis)
+	clamped := min(max(angle, minRadians), maxRadians)
+	if clamped == angle {
+		qCopy(result, twist)
+		return false
+	}
+	qMakeRotationAxis(result, clamped, unitAxis)
+	return true
+}
+
+// qClampSwing limits swing to an elliptical cone around the twist axis. The
+// swing is treated as a rotation vector in the plane perpendicular to
+// unitTwistAxis; its components about unitRefAxis and about
+// unitTwistAxis x unitRefAxis may be at most maxRadians1 and maxRadians2,
+// and a swing outside the ellipse they describe is scaled back onto it. It
+// reports whether any clamping was needed.
+func qClampSwing[T Float](result, swing *QuatOf[T], unitTwistAxis, unitRefAxis *Vector3Of[T], maxRadians1, maxRadians2 T) bool {
+	var refAxis2, rotVec, tmpV3_0 Vector3Of[T]
+	var tmpQ_0 QuatOf[T]
+	// rotVec is half the rotation vector.
+	qCopy(&tmpQ_0, swing)
+	if tmpQ_0.W < 0.0 {
+		qNeg(&tmpQ_0, &tmpQ_0)
+	}
+	qLog(&tmpQ_0, &tmpQ_0)
+	v3MakeFromElems(&rotVec, tmpQ_0.X, tmpQ_0.Y, tmpQ_0.Z)
+	v3Cross(&refAxis2, unitTwistAxis, unitRefAxis)
+	r1 := 2.0 * v3Dot(&rotVec, unitRefAxis)
+	r2 := 2.0 * v3Dot(&rotVec, &refAxis2)
+	// A zero limit flattens the ellipse to a line segment, so the
+	// corresponding component is clamped to zero and left out of the
+	// ellipse test.
+	clamped := false
+	k := T(0.0)
+	if maxRadians1 > 0.0 {
+		k += (r1 / maxRadians1) * (r1 / maxRadians1)
+	} else if r1 != 0.0 {
+		r1, clamped = 0.0, true
+	}
+	if maxRadians2 > 0.0 {
+		k += (r2 / maxRadians2) * (r2 / maxRadians2)
+	} else if r2 != 0.0 {
+		r2, clamped = 0.0, true
+	}
+	if k > 1.0 {
+		scale := 1.0 / sqrt(k)
+		r1, r2, clamped = r1*scale, r2*scale, true
+	}
+	if !clamped {
+		qCopy(result, swing)
+		return false
+	}
+	v3ScalarMul(&rotVec, unitRefAxis, 0.5*r1)
+	v3ScalarMul(&tmpV3_0, &refAxis2, 0.5*r2)
+	v3Add(&rotVec, &rotVec, &tmpV3_0)
+	qMakeFromV3Scalar(&tmpQ_0, &rotVec, 0.0)
+	qExp(result, &tmpQ_0)
+	return true
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func QdSwingTwist(swing, twist *Quatd, unitQuat *Quatd, unitAxis *Vector3d) {
+	qSwingTwist(swing, twist, unitQuat, unitAxis)
+}
+
+func QdMakeFromSwingTwist(result, swing, twist *Quatd) {
+	qMakeFromSwingTwist(result, swing, twist)
+}
+
+func QdGetTwistAngle(twist *Quatd, unitAxis *Vector3d) float64 {
+	return qGetTwistAngle(twist, unitAxis)
+}
+
+/*******/
+
+func QdClampTwist(result, twist *Quatd, unitAxis *Vector3d, minRadians, maxRadians float64) bool {
+	return qClampTwist(result, twist, unitAxis, minRadians, maxRadians)
+}
+
+func QdClampSwing(result, swing *Quatd, unitTwistAxis, unitRefAxis *Vector3d, maxRadians1, maxRadians2 float64) bool {
+	return qClampSwing(result, swing, unitTwistAxis, unitRefAxis, maxRadians1, maxRadians2)
+}
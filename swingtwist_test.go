@@ -0,0 +1,128 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestQSwingTwist(t *testing.T) {
+	var axis, swingAxis, rotated, gotSwingAxis Vector3
+	var swing, twist, wantSwing, wantTwist, quat, back Quat
+	V3MakeFromElems(&axis, 0.0, 0.6, 0.8)
+	V3MakeFromElems(&swingAxis, 1.0, 0.0, 0.0)
+	QMakeRotationAxis(&wantTwist, 1.1, &axis)
+	QMakeRotationAxis(&wantSwing, -0.7, &swingAxis)
+	QMul(&quat, &wantSwing, &wantTwist)
+
+	QSwingTwist(&swing, &twist, &quat, &axis)
+	if !qNear(&twist, &wantTwist, 1e-6) || !qNear(&swing, &wantSwing, 1e-6) {
+		t.Errorf("QSwingTwist = %v, %v, want %v, %v", swing, twist, wantSwing, wantTwist)
+	}
+	if angle := QGetTwistAngle(&twist, &axis); !near(angle, 1.1, 1e-6) {
+		t.Errorf("QGetTwistAngle = %v, want 1.1", angle)
+	}
+	QMakeFromSwingTwist(&back, &swing, &twist)
+	if !qNear(&back, &quat, 1e-6) {
+		t.Errorf("QMakeFromSwingTwist = %v, want %v", back, quat)
+	}
+
+	// The swing moves the axis the same way the whole rotation does, and
+	// its own axis is perpendicular to the twist axis.
+	QRotate(&rotated, &quat, &axis)
+	QRotate(&gotSwingAxis, &swing, &axis)
+	if !v3Near(&gotSwingAxis, &rotated, 1e-6) {
+		t.Errorf("swing moves the axis to %v, the rotation to %v", gotSwingAxis, rotated)
+	}
+	if d := swing.X*axis.X + swing.Y*axis.Y + swing.Z*axis.Z; !near(d, 0.0, 1e-6) {
+		t.Errorf("swing %v has a component about the twist axis", swing)
+	}
+
+	// -quat is the same rotation and gives the same twist.
+	QNeg(&quat, &quat)
+	QSwingTwist(&swing, &twist, &quat, &axis)
+	if twist.W < 0.0 || !qNear(&twist, &wantTwist, 1e-6) {
+		t.Errorf("QSwingTwist(-quat) twist = %v, want %v", twist, wantTwist)
+	}
+}
+
+func TestQSwingTwistHalfTurn(t *testing.T) {
+	var halfTurn, ident, swing, twist Quat
+	var twistAxis Vector3
+	V3MakeFromElems(&twistAxis, 0.0, 0.0, 1.0)
+	// A half turn about X swings Z round by pi, leaving the twist undefined.
+	QMakeRotationX(&halfTurn, g_PI)
+	QSwingTwist(&swing, &twist, &halfTurn, &twistAxis)
+	QMakeIdentity(&ident)
+	if !qNear(&twist, &ident, 1e-6) || !qNear(&swing, &halfTurn, 1e-6) {
+		t.Errorf("QSwingTwist of a half turn = %v, %v, want all swing", swing, twist)
+	}
+}
+
+func TestQClampTwist(t *testing.T) {
+	var axis Vector3
+	var twist, got, want Quat
+	V3MakeFromElems(&axis, 1.0, 0.0, 0.0)
+	QMakeRotationAxis(&twist, 1.5, &axis)
+	if QClampTwist(&got, &twist, &axis, -1.0, 2.0) || got != twist {
+		t.Errorf("QClampTwist changed %v, which is within range", twist)
+	}
+	if !QClampTwist(&got, &twist, &axis, -1.0, 1.0) {
+		t.Errorf("QClampTwist did not clamp a 1.5 rad twist to 1")
+	}
+	QMakeRotationAxis(&want, 1.0, &axis)
+	if !qNear(&got, &want, 1e-6) {
+		t.Errorf("QClampTwist = %v, want %v", got, want)
+	}
+	QMakeRotationAxis(&twist, -2.5, &axis)
+	QClampTwist(&got, &twist, &axis, -1.0, 1.0)
+	if angle := QGetTwistAngle(&got, &axis); !near(angle, -1.0, 1e-6) {
+		t.Errorf("QClampTwist(-2.5 rad) has angle %v, want -1", angle)
+	}
+}
+
+func TestQClampSwing(t *testing.T) {
+	var twistAxis, refAxis, refAxis2, swingAxis Vector3
+	var swing, got, want Quat
+	V3MakeFromElems(&twistAxis, 0.0, 0.0, 1.0)
+	V3MakeFromElems(&refAxis, 1.0, 0.0, 0.0)
+	V3Cross(&refAxis2, &twistAxis, &refAxis)
+
+	// Within the ellipse nothing changes.
+	QMakeRotationAxis(&swing, 0.3, &refAxis)
+	if QClampSwing(&got, &swing, &twistAxis, &refAxis, 0.5, 0.2) || got != swing {
+		t.Errorf("QClampSwing changed %v, which is within the cone", swing)
+	}
+	// Along each reference axis the limit is the matching radius.
+	QMakeRotationAxis(&swing, 0.9, &refAxis)
+	if !QClampSwing(&got, &swing, &twistAxis, &refAxis, 0.5, 0.2) {
+		t.Errorf("QClampSwing did not clamp %v", swing)
+	}
+	QMakeRotationAxis(&want, 0.5, &refAxis)
+	if !qNear(&got, &want, 1e-6) {
+		t.Errorf("QClampSwing about the first axis = %v, want %v", got, want)
+	}
+	QMakeRotationAxis(&swing, -0.9, &refAxis2)
+	QClampSwing(&got, &swing, &twistAxis, &refAxis, 0.5, 0.2)
+	QMakeRotationAxis(&want, -0.2, &refAxis2)
+	if !qNear(&got, &want, 1e-6) {
+		t.Errorf("QClampSwing about the second axis = %v, want %v", got, want)
+	}
+
+	// A diagonal swing is scaled back onto the ellipse, keeping its direction.
+	V3MakeFromElems(&swingAxis, 0.6, 0.8, 0.0)
+	QMakeRotationAxis(&swing, 1.0, &swingAxis)
+	QClampSwing(&got, &swing, &twistAxis, &refAxis, 0.5, 0.2)
+	var gotAxis Vector3
+	angle := QGetAxisAngle(&gotAxis, &got)
+	r1, r2 := angle*V3Dot(&gotAxis, &refAxis), angle*V3Dot(&gotAxis, &refAxis2)
+	if !near((r1/0.5)*(r1/0.5)+(r2/0.2)*(r2/0.2), 1.0, 1e-5) || !v3Near(&gotAxis, &swingAxis, 1e-5) {
+		t.Errorf("QClampSwing of a diagonal swing = %v, angle %v about %v", got, angle, gotAxis)
+	}
+
+	// A zero limit forbids swinging about that axis.
+	QClampSwing(&got, &swing, &twistAxis, &refAxis, 0.0, 2.0)
+	QMakeRotationAxis(&want, 0.8, &refAxis2)
+	if !qNear(&got, &want, 1e-6) {
+		t.Errorf("QClampSwing with a zero limit = %v, want %v", got, want)
+	}
+}
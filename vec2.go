@@ -0,0 +1,204 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Vector2 = Vector2Of[float32]
+
+type Point2 = Point2Of[float32]
+
+func V2Copy(result, vec *Vector2) {
+	v2Copy(result, vec)
+}
+
+func V2MakeFromElems(result *Vector2, x, y float32) {
+	v2MakeFromElems(result, x, y)
+}
+
+func V2MakeFromP2(result *Vector2, pnt *Point2) {
+	v2MakeFromP2(result, pnt)
+}
+
+func V2MakeFromScalar(result *Vector2, scalar float32) {
+	v2MakeFromScalar(result, scalar)
+}
+
+// V2MakeFromV3 drops the Z element of vec.
+func V2MakeFromV3(result *Vector2, vec *Vector3) {
+	v2MakeFromV3(result, vec)
+}
+
+func V3MakeFromV2Scalar(result *Vector3, xy *Vector2, z float32) {
+	v3MakeFromV2Scalar(result, xy, z)
+}
+
+func V2MakeXAxis(result *Vector2) {
+	v2MakeXAxis(result)
+}
+
+func V2MakeYAxis(result *Vector2) {
+	v2MakeYAxis(result)
+}
+
+func V2Lerp(result *Vector2, t float32, vec0, vec1 *Vector2) {
+	v2Lerp(result, t, vec0, vec1)
+}
+
+func V2Add(result, vec0, vec1 *Vector2) {
+	v2Add(result, vec0, vec1)
+}
+
+func V2Sub(result, vec0, vec1 *Vector2) {
+	v2Sub(result, vec0, vec1)
+}
+
+func V2AddP2(result *Point2, vec0 *Vector2, pnt1 *Point2) {
+	v2AddP2(result, vec0, pnt1)
+}
+
+func V2ScalarMul(result, vec *Vector2, scalar float32) {
+	v2ScalarMul(result, vec, scalar)
+}
+
+func V2ScalarDiv(result, vec *Vector2, scalar float32) {
+	v2ScalarDiv(result, vec, scalar)
+}
+
+func V2Neg(result, vec *Vector2) {
+	v2Neg(result, vec)
+}
+
+func V2MulPerElem(result, vec0, vec1 *Vector2) {
+	v2MulPerElem(result, vec0, vec1)
+}
+
+func V2DivPerElem(result, vec0, vec1 *Vector2) {
+	v2DivPerElem(result, vec0, vec1)
+}
+
+func V2RecipPerElem(result, vec *Vector2) {
+	v2RecipPerElem(result, vec)
+}
+
+func V2SqrtPerElem(result, vec *Vector2) {
+	v2SqrtPerElem(result, vec)
+}
+
+func V2RsqrtPerElem(result, vec *Vector2) {
+	v2RsqrtPerElem(result, vec)
+}
+
+func V2AbsPerElem(result, vec *Vector2) {
+	v2AbsPerElem(result, vec)
+}
+
+func V2CopySignPerElem(result, vec0, vec1 *Vector2) {
+	v2CopySignPerElem(result, vec0, vec1)
+}
+
+func V2MaxPerElem(result, vec0, vec1 *Vector2) {
+	v2MaxPerElem(result, vec0, vec1)
+}
+
+func V2MinPerElem(result, vec0, vec1 *Vector2) {
+	v2MinPerElem(result, vec0, vec1)
+}
+
+func V2Dot(vec0, vec1 *Vector2) float32 {
+	return v2Dot(vec0, vec1)
+}
+
+// V2PerpDot returns vec0.X*vec1.Y - vec0.Y*vec1.X, the Z element of the cross
+// product of vec0 and vec1 extended to 3D. It is positive when vec1 is
+// counterclockwise from vec0.
+func V2PerpDot(vec0, vec1 *Vector2) float32 {
+	return v2PerpDot(vec0, vec1)
+}
+
+// V2Perp rotates vec a quarter turn counterclockwise, giving (-vec.Y, vec.X).
+func V2Perp(result, vec *Vector2) {
+	v2Perp(result, vec)
+}
+
+func V2Normalize(result, vec *Vector2) {
+	v2Normalize(result, vec)
+}
+
+func V2Select(result, vec0, vec1 *Vector2, select1 int) {
+	v2Select(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func P2Copy(result, pnt *Point2) {
+	p2Copy(result, pnt)
+}
+
+func P2MakeFromElems(result *Point2, x, y float32) {
+	p2MakeFromElems(result, x, y)
+}
+
+func P2MakeFromV2(result *Point2, vec *Vector2) {
+	p2MakeFromV2(result, vec)
+}
+
+func P2MakeFromScalar(result *Point2, scalar float32) {
+	p2MakeFromScalar(result, scalar)
+}
+
+// P2MakeFromP3 drops the Z element of pnt.
+func P2MakeFromP3(result *Point2, pnt *Point3) {
+	p2MakeFromP3(result, pnt)
+}
+
+func P3MakeFromP2Scalar(result *Point3, xy *Point2, z float32) {
+	p3MakeFromP2Scalar(result, xy, z)
+}
+
+func P2Lerp(result *Point2, t float32, pnt0, pnt1 *Point2) {
+	p2Lerp(result, t, pnt0, pnt1)
+}
+
+func P2Sub(result *Vector2, pnt0, pnt1 *Point2) {
+	p2Sub(result, pnt0, pnt1)
+}
+
+func P2AddV2(result, pnt0 *Point2, vec1 *Vector2) {
+	p2AddV2(result, pnt0, vec1)
+}
+
+func P2SubV2(result, pnt0 *Point2, vec1 *Vector2) {
+	p2SubV2(result, pnt0, vec1)
+}
+
+func P2MulPerElem(result, pnt0, pnt1 *Point2) {
+	p2MulPerElem(result, pnt0, pnt1)
+}
+
+func P2DivPerElem(result, pnt0, pnt1 *Point2) {
+	p2DivPerElem(result, pnt0, pnt1)
+}
+
+func P2AbsPerElem(result, pnt *Point2) {
+	p2AbsPerElem(result, pnt)
+}
+
+func P2MaxPerElem(result, pnt0, pnt1 *Point2) {
+	p2MaxPerElem(result, pnt0, pnt1)
+}
+
+func P2MinPerElem(result, pnt0, pnt1 *Point2) {
+	p2MinPerElem(result, pnt0, pnt1)
+}
+
+func P2Scale(result, pnt *Point2, scaleVal float32) {
+	p2Scale(result, pnt, scaleVal)
+}
+
+func P2NonUniformScale(result, pnt *Point2, scaleVec *Vector2) {
+	p2NonUniformScale(result, pnt, scaleVec)
+}
+
+func P2Select(result, pnt0, pnt1 *Point2, select1 int) {
+	p2Select(result, pnt0, pnt1, select1)
+}
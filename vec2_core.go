@@ -0,0 +1,600 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+// Vector2Of and Point2Of have no padding, unlike their 3D counterparts, as
+// there is no 16-byte layout to keep.
+type Vector2Of[T Float] struct {
+	X, Y T
+}
+
+type Point2Of[T Float] struct {
+	X, Y T
+}
+
+func v2Copy[T Float](result, vec *Vector2Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+}
+
+func v2MakeFromElems[T Float](result *Vector2Of[T], x, y T) {
+	result.X = x
+	result.Y = y
+}
+
+func v2MakeFromP2[T Float](result *Vector2Of[T], pnt *Point2Of[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+}
+
+func v2MakeFromScalar[T Float](result *Vector2Of[T], scalar T) {
+	result.X = scalar
+	result.Y = scalar
+}
+
+// v2MakeFromV3 drops the Z element.
+func v2MakeFromV3[T Float](result *Vector2Of[T], vec *Vector3Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+}
+
+func v3MakeFromV2Scalar[T Float](result *Vector3Of[T], xy *Vector2Of[T], z T) {
+	v3MakeFromElems(result, xy.X, xy.Y, z)
+}
+
+func v2MakeXAxis[T Float](result *Vector2Of[T]) {
+	v2MakeFromElems(result, 1.0, 0.0)
+}
+
+func v2MakeYAxis[T Float](result *Vector2Of[T]) {
+	v2MakeFromElems(result, 0.0, 1.0)
+}
+
+func v2Lerp[T Float](result *Vector2Of[T], t T, vec0, vec1 *Vector2Of[T]) {
+	var tmpV2_0, tmpV2_1 Vector2Of[T]
+	v2Sub(&tmpV2_0, vec1, vec0)
+	v2ScalarMul(&tmpV2_1, &tmpV2_0, t)
+	v2Add(result, vec0, &tmpV2_1)
+}
+
+func (v *Vector2Of[T]) SetX(x T) {
+	v.X = x
+}
+
+func (v *Vector2Of[T]) SetY(y T) {
+	v.Y = y
+}
+
+func (v *Vector2Of[T]) SetElem(index int, value T) {
+	switch index {
+	case 0:
+		v.X = value
+	case 1:
+		v.Y = value
+	}
+}
+
+func (v *Vector2Of[T]) GetElem(index int) T {
+	switch index {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	}
+	return 0
+}
+
+func v2Add[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	result.X = vec0.X + vec1.X
+	result.Y = vec0.Y + vec1.Y
+}
+
+func v2Sub[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	result.X = vec0.X - vec1.X
+	result.Y = vec0.Y - vec1.Y
+}
+
+func v2AddP2[T Float](result *Point2Of[T], vec0 *Vector2Of[T], pnt1 *Point2Of[T]) {
+	result.X = vec0.X + pnt1.X
+	result.Y = vec0.Y + pnt1.Y
+}
+
+func v2ScalarMul[T Float](result, vec *Vector2Of[T], scalar T) {
+	result.X = vec.X * scalar
+	result.Y = vec.Y * scalar
+}
+
+func v2ScalarDiv[T Float](result, vec *Vector2Of[T], scalar T) {
+	result.X = vec.X / scalar
+	result.Y = vec.Y / scalar
+}
+
+func v2Neg[T Float](result, vec *Vector2Of[T]) {
+	result.X = -vec.X
+	result.Y = -vec.Y
+}
+
+func v2MulPerElem[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	result.X = vec0.X * vec1.X
+	result.Y = vec0.Y * vec1.Y
+}
+
+func v2DivPerElem[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	result.X = vec0.X / vec1.X
+	result.Y = vec0.Y / vec1.Y
+}
+
+func v2RecipPerElem[T Float](result, vec *Vector2Of[T]) {
+	result.X = 1.0 / vec.X
+	result.Y = 1.0 / vec.Y
+}
+
+func v2SqrtPerElem[T Float](result, vec *Vector2Of[T]) {
+	result.X = sqrt(vec.X)
+	result.Y = sqrt(vec.Y)
+}
+
+func v2RsqrtPerElem[T Float](result, vec *Vector2Of[T]) {
+	result.X = 1.0 / sqrt(vec.X)
+	result.Y = 1.0 / sqrt(vec.Y)
+}
+
+func v2AbsPerElem[T Float](result, vec *Vector2Of[T]) {
+	result.X = abs(vec.X)
+	result.Y = abs(vec.Y)
+}
+
+func v2CopySignPerElem[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	if vec1.X < 0.0 {
+		result.X = -abs(vec0.X)
+	} else {
+		result.X = abs(vec0.X)
+	}
+	if vec1.Y < 0.0 {
+		result.Y = -abs(vec0.Y)
+	} else {
+		result.Y = abs(vec0.Y)
+	}
+}
+
+func v2MaxPerElem[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	result.X = max(vec0.X, vec1.X)
+	result.Y = max(vec0.Y, vec1.Y)
+}
+
+func (v Vector2Of[T]) MaxElem() T {
+	return max(v.X, v.Y)
+}
+
+func v2MinPerElem[T Float](result, vec0, vec1 *Vector2Of[T]) {
+	result.X = min(vec0.X, vec1.X)
+	result.Y = min(vec0.Y, vec1.Y)
+}
+
+func (v Vector2Of[T]) MinElem() T {
+	return min(v.X, v.Y)
+}
+
+func (v Vector2Of[T]) Sum() T {
+	return v.X + v.Y
+}
+
+func v2Dot[T Float](vec0, vec1 *Vector2Of[T]) T {
+	result := vec0.X * vec1.X
+	result += vec0.Y * vec1.Y
+	return result
+}
+
+func (v Vector2Of[T]) Dot(vec1 *Vector2Of[T]) T {
+	result := v.X * vec1.X
+	result += v.Y * vec1.Y
+	return result
+}
+
+// v2PerpDot is the Z element of the cross product of vec0 and vec1 taken as
+// 3D vectors, i.e. the dot product of v2Perp(vec0) and vec1. It is positive
+// when vec1 is counterclockwise from vec0.
+func v2PerpDot[T Float](vec0, vec1 *Vector2Of[T]) T {
+	return vec0.X*vec1.Y - vec0.Y*vec1.X
+}
+
+// v2Perp rotates vec a quarter turn counterclockwise.
+func v2Perp[T Float](result, vec *Vector2Of[T]) {
+	v2MakeFromElems(result, -vec.Y, vec.X)
+}
+
+func (v Vector2Of[T]) LengthSqr() T {
+	result := v.X * v.X
+	result += v.Y * v.Y
+	return result
+}
+
+func (v Vector2Of[T]) Length() T {
+	return sqrt(v.LengthSqr())
+}
+
+func v2Normalize[T Float](result, vec *Vector2Of[T]) {
+	lenSqr := vec.LengthSqr()
+	lenInv := 1.0 / sqrt(lenSqr)
+	result.X = vec.X * lenInv
+	result.Y = vec.Y * lenInv
+}
+
+func v2Select[T Float](result, vec0, vec1 *Vector2Of[T], select1 int) {
+	if select1 != 0 {
+		result.X = vec1.X
+		result.Y = vec1.Y
+	} else {
+		result.X = vec0.X
+		result.Y = vec0.Y
+	}
+}
+
+func (v *Vector2Of[T]) String() string {
+	return fmt.Sprintf("( %f %f )\n", v.X, v.Y)
+}
+
+func (v Vector2Of[T]) Add(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2Add(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) Sub(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2Sub(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) AddP2(pnt1 Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2AddV2(&result, &pnt1, &v)
+	return result
+}
+
+func (v Vector2Of[T]) Scale(scalar T) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2ScalarMul(&result, &v, scalar)
+	return result
+}
+
+func (v Vector2Of[T]) Div(scalar T) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2ScalarDiv(&result, &v, scalar)
+	return result
+}
+
+func (v Vector2Of[T]) Neg() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2Neg(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) MulPerElem(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2MulPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) DivPerElem(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2DivPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) CopySignPerElem(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2CopySignPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) MaxPerElem(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2MaxPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) MinPerElem(vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2MinPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) RecipPerElem() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2RecipPerElem(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) SqrtPerElem() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2SqrtPerElem(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) RsqrtPerElem() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2RsqrtPerElem(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) AbsPerElem() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2AbsPerElem(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) Normalize() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2Normalize(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) Lerp(t T, vec1 Vector2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	v2Lerp(&result, t, &v, &vec1)
+	return result
+}
+
+func (v Vector2Of[T]) Perp() Vector2Of[T] {
+	var result Vector2Of[T]
+	v2Perp(&result, &v)
+	return result
+}
+
+func (v Vector2Of[T]) PerpDot(vec1 *Vector2Of[T]) T {
+	return v2PerpDot(&v, vec1)
+}
+
+/*******/
+
+func p2Copy[T Float](result, pnt *Point2Of[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+}
+
+func p2MakeFromElems[T Float](result *Point2Of[T], x, y T) {
+	result.X = x
+	result.Y = y
+}
+
+func p2MakeFromV2[T Float](result *Point2Of[T], vec *Vector2Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+}
+
+func p2MakeFromScalar[T Float](result *Point2Of[T], scalar T) {
+	result.X = scalar
+	result.Y = scalar
+}
+
+// p2MakeFromP3 drops the Z element.
+func p2MakeFromP3[T Float](result *Point2Of[T], pnt *Point3Of[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+}
+
+func p3MakeFromP2Scalar[T Float](result *Point3Of[T], xy *Point2Of[T], z T) {
+	p3MakeFromElems(result, xy.X, xy.Y, z)
+}
+
+func p2Lerp[T Float](result *Point2Of[T], t T, pnt0, pnt1 *Point2Of[T]) {
+	var tmpV2_0, tmpV2_1 Vector2Of[T]
+	p2Sub(&tmpV2_0, pnt1, pnt0)
+	v2ScalarMul(&tmpV2_1, &tmpV2_0, t)
+	p2AddV2(result, pnt0, &tmpV2_1)
+}
+
+func (p *Point2Of[T]) SetX(x T) {
+	p.X = x
+}
+
+func (p *Point2Of[T]) SetY(y T) {
+	p.Y = y
+}
+
+func (p *Point2Of[T]) SetElem(index int, value T) {
+	switch index {
+	case 0:
+		p.X = value
+	case 1:
+		p.Y = value
+	}
+}
+
+func (p *Point2Of[T]) GetElem(index int) T {
+	switch index {
+	case 0:
+		return p.X
+	case 1:
+		return p.Y
+	}
+	return 0
+}
+
+func p2Sub[T Float](result *Vector2Of[T], pnt0, pnt1 *Point2Of[T]) {
+	result.X = pnt0.X - pnt1.X
+	result.Y = pnt0.Y - pnt1.Y
+}
+
+func p2AddV2[T Float](result, pnt0 *Point2Of[T], vec1 *Vector2Of[T]) {
+	result.X = pnt0.X + vec1.X
+	result.Y = pnt0.Y + vec1.Y
+}
+
+func p2SubV2[T Float](result, pnt0 *Point2Of[T], vec1 *Vector2Of[T]) {
+	result.X = pnt0.X - vec1.X
+	result.Y = pnt0.Y - vec1.Y
+}
+
+func p2MulPerElem[T Float](result, pnt0, pnt1 *Point2Of[T]) {
+	result.X = pnt0.X * pnt1.X
+	result.Y = pnt0.Y * pnt1.Y
+}
+
+func p2DivPerElem[T Float](result, pnt0, pnt1 *Point2Of[T]) {
+	result.X = pnt0.X / pnt1.X
+	result.Y = pnt0.Y / pnt1.Y
+}
+
+func p2AbsPerElem[T Float](result, pnt *Point2Of[T]) {
+	result.X = abs(pnt.X)
+	result.Y = abs(pnt.Y)
+}
+
+func p2MaxPerElem[T Float](result, pnt0, pnt1 *Point2Of[T]) {
+	result.X = max(pnt0.X, pnt1.X)
+	result.Y = max(pnt0.Y, pnt1.Y)
+}
+
+func (p Point2Of[T]) MaxElem() T {
+	return max(p.X, p.Y)
+}
+
+func p2MinPerElem[T Float](result, pnt0, pnt1 *Point2Of[T]) {
+	result.X = min(pnt0.X, pnt1.X)
+	result.Y = min(pnt0.Y, pnt1.Y)
+}
+
+func (p Point2Of[T]) MinElem() T {
+	return min(p.X, p.Y)
+}
+
+func (p Point2Of[T]) Sum() T {
+	return p.X + p.Y
+}
+
+func p2Scale[T Float](result, pnt *Point2Of[T], scaleVal T) {
+	result.X = pnt.X * scaleVal
+	result.Y = pnt.Y * scaleVal
+}
+
+func p2NonUniformScale[T Float](result, pnt *Point2Of[T], scaleVec *Vector2Of[T]) {
+	result.X = pnt.X * scaleVec.X
+	result.Y = pnt.Y * scaleVec.Y
+}
+
+func (p Point2Of[T]) Projection(unitVec *Vector2Of[T]) T {
+	result := p.X * unitVec.X
+	result += p.Y * unitVec.Y
+	return result
+}
+
+func (p Point2Of[T]) DistSqrFromOrigin() T {
+	var tmpV2_0 Vector2Of[T]
+	v2MakeFromP2(&tmpV2_0, &p)
+	return tmpV2_0.LengthSqr()
+}
+
+func (p Point2Of[T]) DistFromOrigin() T {
+	var tmpV2_0 Vector2Of[T]
+	v2MakeFromP2(&tmpV2_0, &p)
+	return tmpV2_0.Length()
+}
+
+func (p Point2Of[T]) DistSqr(pnt1 *Point2Of[T]) T {
+	var tmpV2_0 Vector2Of[T]
+	p2Sub(&tmpV2_0, pnt1, &p)
+	return tmpV2_0.LengthSqr()
+}
+
+func (p Point2Of[T]) Dist(pnt1 *Point2Of[T]) T {
+	var tmpV2_0 Vector2Of[T]
+	p2Sub(&tmpV2_0, pnt1, &p)
+	return tmpV2_0.Length()
+}
+
+func p2Select[T Float](result, pnt0, pnt1 *Point2Of[T], select1 int) {
+	if select1 != 0 {
+		result.X = pnt1.X
+		result.Y = pnt1.Y
+	} else {
+		result.X = pnt0.X
+		result.Y = pnt0.Y
+	}
+}
+
+func (p *Point2Of[T]) String() string {
+	return fmt.Sprintf("( %f %f )\n", p.X, p.Y)
+}
+
+func (p Point2Of[T]) Sub(pnt1 Point2Of[T]) Vector2Of[T] {
+	var result Vector2Of[T]
+	p2Sub(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point2Of[T]) AddV2(vec1 Vector2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2AddV2(&result, &p, &vec1)
+	return result
+}
+
+func (p Point2Of[T]) SubV2(vec1 Vector2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2SubV2(&result, &p, &vec1)
+	return result
+}
+
+func (p Point2Of[T]) MulPerElem(pnt1 Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2MulPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point2Of[T]) DivPerElem(pnt1 Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2DivPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point2Of[T]) MaxPerElem(pnt1 Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2MaxPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point2Of[T]) MinPerElem(pnt1 Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2MinPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point2Of[T]) AbsPerElem() Point2Of[T] {
+	var result Point2Of[T]
+	p2AbsPerElem(&result, &p)
+	return result
+}
+
+func (p Point2Of[T]) Scale(scaleVal T) Point2Of[T] {
+	var result Point2Of[T]
+	p2Scale(&result, &p, scaleVal)
+	return result
+}
+
+func (p Point2Of[T]) NonUniformScale(scaleVec Vector2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2NonUniformScale(&result, &p, &scaleVec)
+	return result
+}
+
+func (p Point2Of[T]) Lerp(t T, pnt1 Point2Of[T]) Point2Of[T] {
+	var result Point2Of[T]
+	p2Lerp(&result, t, &p, &pnt1)
+	return result
+}
+
+/*******/
+
+func v2Convert[T, U Float](result *Vector2Of[T], vec *Vector2Of[U]) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+}
+
+func p2Convert[T, U Float](result *Point2Of[T], pnt *Point2Of[U]) {
+	result.X = T(pnt.X)
+	result.Y = T(pnt.Y)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Vector2d = Vector2Of[float64]
+
+type Point2d = Point2Of[float64]
+
+func V2dCopy(result, vec *Vector2d) {
+	v2Copy(result, vec)
+}
+
+func V2dMakeFromElems(result *Vector2d, x, y float64) {
+	v2MakeFromElems(result, x, y)
+}
+
+func V2dMakeFromP2d(result *Vector2d, pnt *Point2d) {
+	v2MakeFromP2(result, pnt)
+}
+
+func V2dMakeFromScalar(result *Vector2d, scalar float64) {
+	v2MakeFromScalar(result, scalar)
+}
+
+func V2dMakeFromV3d(result *Vector2d, vec *Vector3d) {
+	v2MakeFromV3(result, vec)
+}
+
+func V3dMakeFromV2dScalar(result *Vector3d, xy *Vector2d, z float64) {
+	v3MakeFromV2Scalar(result, xy, z)
+}
+
+func V2dMakeXAxis(result *Vector2d) {
+	v2MakeXAxis(result)
+}
+
+func V2dMakeYAxis(result *Vector2d) {
+	v2MakeYAxis(result)
+}
+
+func V2dLerp(result *Vector2d, t float64, vec0, vec1 *Vector2d) {
+	v2Lerp(result, t, vec0, vec1)
+}
+
+func V2dAdd(result, vec0, vec1 *Vector2d) {
+	v2Add(result, vec0, vec1)
+}
+
+func V2dSub(result, vec0, vec1 *Vector2d) {
+	v2Sub(result, vec0, vec1)
+}
+
+func V2dAddP2d(result *Point2d, vec0 *Vector2d, pnt1 *Point2d) {
+	v2AddP2(result, vec0, pnt1)
+}
+
+func V2dScalarMul(result, vec *Vector2d, scalar float64) {
+	v2ScalarMul(result, vec, scalar)
+}
+
+func V2dScalarDiv(result, vec *Vector2d, scalar float64) {
+	v2ScalarDiv(result, vec, scalar)
+}
+
+func V2dNeg(result, vec *Vector2d) {
+	v2Neg(result, vec)
+}
+
+func V2dMulPerElem(result, vec0, vec1 *Vector2d) {
+	v2MulPerElem(result, vec0, vec1)
+}
+
+func V2dDivPerElem(result, vec0, vec1 *Vector2d) {
+	v2DivPerElem(result, vec0, vec1)
+}
+
+func V2dRecipPerElem(result, vec *Vector2d) {
+	v2RecipPerElem(result, vec)
+}
+
+func V2dSqrtPerElem(result, vec *Vector2d) {
+	v2SqrtPerElem(result, vec)
+}
+
+func V2dRsqrtPerElem(result, vec *Vector2d) {
+	v2RsqrtPerElem(result, vec)
+}
+
+func V2dAbsPerElem(result, vec *Vector2d) {
+	v2AbsPerElem(result, vec)
+}
+
+func V2dCopySignPerElem(result, vec0, vec1 *Vector2d) {
+	v2CopySignPerElem(result, vec0, vec1)
+}
+
+func V2dMaxPerElem(result, vec0, vec1 *Vector2d) {
+	v2MaxPerElem(result, vec0, vec1)
+}
+
+func V2dMinPerElem(result, vec0, vec1 *Vector2d) {
+	v2MinPerElem(result, vec0, vec1)
+}
+
+func V2dDot(vec0, vec1 *Vector2d) float64 {
+	return v2Dot(vec0, vec1)
+}
+
+func V2dPerpDot(vec0, vec1 *Vector2d) float64 {
+	return v2PerpDot(vec0, vec1)
+}
+
+func V2dPerp(result, vec *Vector2d) {
+	v2Perp(result, vec)
+}
+
+func V2dNormalize(result, vec *Vector2d) {
+	v2Normalize(result, vec)
+}
+
+func V2dSelect(result, vec0, vec1 *Vector2d, select1 int) {
+	v2Select(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func P2dCopy(result, pnt *Point2d) {
+	p2Copy(result, pnt)
+}
+
+func P2dMakeFromElems(result *Point2d, x, y float64) {
+	p2MakeFromElems(result, x, y)
+}
+
+func P2dMakeFromV2d(result *Point2d, vec *Vector2d) {
+	p2MakeFromV2(result, vec)
+}
+
+func P2dMakeFromScalar(result *Point2d, scalar float64) {
+	p2MakeFromScalar(result, scalar)
+}
+
+func P2dMakeFromP3d(result *Point2d, pnt *Point3d) {
+	p2MakeFromP3(result, pnt)
+}
+
+func P3dMakeFromP2dScalar(result *Point3d, xy *Point2d, z float64) {
+	p3MakeFromP2Scalar(result, xy, z)
+}
+
+func P2dLerp(result *Point2d, t float64, pnt0, pnt1 *Point2d) {
+	p2Lerp(result, t, pnt0, pnt1)
+}
+
+func P2dSub(result *Vector2d, pnt0, pnt1 *Point2d) {
+	p2Sub(result, pnt0, pnt1)
+}
+
+func P2dAddV2d(result, pnt0 *Point2d, vec1 *Vector2d) {
+	p2AddV2(result, pnt0, vec1)
+}
+
+func P2dSubV2d(result, pnt0 *Point2d, vec1 *Vector2d) {
+	p2SubV2(result, pnt0, vec1)
+}
+
+func P2dMulPerElem(result, pnt0, pnt1 *Point2d) {
+	p2MulPerElem(result, pnt0, pnt1)
+}
+
+func P2dDivPerElem(result, pnt0, pnt1 *Point2d) {
+	p2DivPerElem(result, pnt0, pnt1)
+}
+
+func P2dAbsPerElem(result, pnt *Point2d) {
+	p2AbsPerElem(result, pnt)
+}
+
+func P2dMaxPerElem(result, pnt0, pnt1 *Point2d) {
+	p2MaxPerElem(result, pnt0, pnt1)
+}
+
+func P2dMinPerElem(result, pnt0, pnt1 *Point2d) {
+	p2MinPerElem(result, pnt0, pnt1)
+}
+
+func P2dScale(result, pnt *Point2d, scaleVal float64) {
+	p2Scale(result, pnt, scaleVal)
+}
+
+func P2dNonUniformScale(result, pnt *Point2d, scaleVec *Vector2d) {
+	p2NonUniformScale(result, pnt, scaleVec)
+}
+
+func P2dSelect(result, pnt0, pnt1 *Point2d, select1 int) {
+	p2Select(result, pnt0, pnt1, select1)
+}
+
+/*******/
+
+func V2dMakeFromV2(result *Vector2d, vec *Vector2) {
+	v2Convert(result, vec)
+}
+
+func V2MakeFromV2d(result *Vector2, vec *Vector2d) {
+	v2Convert(result, vec)
+}
+
+func P2dMakeFromP2(result *Point2d, pnt *Point2) {
+	p2Convert(result, pnt)
+}
+
+func P2MakeFromP2d(result *Point2, pnt *Point2d) {
+	p2Convert(result, pnt)
+}
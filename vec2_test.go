@@ -0,0 +1,121 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestV2Perp(t *testing.T) {
+	var xAxis, yAxis, perp, vec0, vec1 Vector2
+	V2MakeXAxis(&xAxis)
+	V2MakeYAxis(&yAxis)
+	V2Perp(&perp, &xAxis)
+	if perp != yAxis {
+		t.Errorf("V2Perp(%v) = %v, want %v", xAxis, perp, yAxis)
+	}
+	if got := V2PerpDot(&xAxis, &yAxis); got != 1.0 {
+		t.Errorf("V2PerpDot(x, y) = %v, want 1", got)
+	}
+	if got := V2PerpDot(&yAxis, &xAxis); got != -1.0 {
+		t.Errorf("V2PerpDot(y, x) = %v, want -1", got)
+	}
+
+	// PerpDot is the Z element of the 3D cross product, and Perp(vec) is
+	// orthogonal to vec.
+	V2MakeFromElems(&vec0, 1.5, -2.0)
+	V2MakeFromElems(&vec1, 0.25, 3.0)
+	var vec0z, vec1z, cross Vector3
+	V3MakeFromV2Scalar(&vec0z, &vec0, 0.0)
+	V3MakeFromV2Scalar(&vec1z, &vec1, 0.0)
+	V3Cross(&cross, &vec0z, &vec1z)
+	if got := V2PerpDot(&vec0, &vec1); got != cross.Z {
+		t.Errorf("V2PerpDot(%v, %v) = %v, want %v", vec0, vec1, got, cross.Z)
+	}
+	V2Perp(&perp, &vec0)
+	if got := V2Dot(&perp, &vec0); got != 0.0 {
+		t.Errorf("V2Dot(V2Perp(vec), vec) = %v, want 0", got)
+	}
+	if got := V2Dot(&perp, &vec1); got != V2PerpDot(&vec0, &vec1) {
+		t.Errorf("V2Dot(V2Perp(vec0), vec1) = %v, want V2PerpDot %v", got, V2PerpDot(&vec0, &vec1))
+	}
+}
+
+func TestV2ConvertV3(t *testing.T) {
+	var vec2 Vector2
+	var vec3, back Vector3
+	var pnt2 Point2
+	var pnt3 Point3
+	V3MakeFromElems(&vec3, 1.0, -2.0, 3.0)
+	V2MakeFromV3(&vec2, &vec3)
+	if vec2 != (Vector2{X: 1.0, Y: -2.0}) {
+		t.Errorf("V2MakeFromV3(%v) = %v", vec3, vec2)
+	}
+	V3MakeFromV2Scalar(&back, &vec2, 3.0)
+	if back != vec3 {
+		t.Errorf("V3MakeFromV2Scalar(%v, 3) = %v, want %v", vec2, back, vec3)
+	}
+	P3MakeFromElems(&pnt3, 4.0, 5.0, 6.0)
+	P2MakeFromP3(&pnt2, &pnt3)
+	if pnt2 != (Point2{X: 4.0, Y: 5.0}) {
+		t.Errorf("P2MakeFromP3(%v) = %v", pnt3, pnt2)
+	}
+
+	var vec2d Vector2d
+	V2dMakeFromV2(&vec2d, &vec2)
+	if vec2d != (Vector2d{X: 1.0, Y: -2.0}) {
+		t.Errorf("V2dMakeFromV2(%v) = %v", vec2, vec2d)
+	}
+}
+
+func TestV2ValueMethods(t *testing.T) {
+	var vec0, vec1, tmp, want Vector2
+	V2MakeFromElems(&vec0, 3.0, -1.0)
+	V2MakeFromElems(&vec1, 0.5, 2.0)
+
+	got := vec0.Add(vec1.Scale(2.0)).Perp().Normalize()
+	V2ScalarMul(&tmp, &vec1, 2.0)
+	V2Add(&tmp, &vec0, &tmp)
+	V2Perp(&tmp, &tmp)
+	V2Normalize(&want, &tmp)
+	if !v2Near(&got, &want, 1e-6) {
+		t.Errorf("value chain = %v, pointer functions give %v", got, want)
+	}
+	if got, want := vec0.Dot(&vec1), V2Dot(&vec0, &vec1); got != want {
+		t.Errorf("vec0.Dot(&vec1) = %v, want %v", got, want)
+	}
+	if got, want := vec0.PerpDot(&vec1), V2PerpDot(&vec0, &vec1); got != want {
+		t.Errorf("vec0.PerpDot(&vec1) = %v, want %v", got, want)
+	}
+	got = vec0.Lerp(0.25, vec1)
+	V2Lerp(&want, 0.25, &vec0, &vec1)
+	if got != want {
+		t.Errorf("vec0.Lerp(0.25, vec1) = %v, want %v", got, want)
+	}
+	if got := vec0.Sub(vec0); got != (Vector2{}) {
+		t.Errorf("vec0.Sub(vec0) = %v, want zero", got)
+	}
+}
+
+func TestP2ValueMethods(t *testing.T) {
+	var pnt0, pnt1 Point2
+	var vec Vector2
+	P2MakeFromElems(&pnt0, 1.0, 1.0)
+	P2MakeFromElems(&pnt1, 4.0, 5.0)
+	V2MakeFromElems(&vec, 3.0, 4.0)
+	if got := pnt0.Dist(&pnt1); got != 5.0 {
+		t.Errorf("pnt0.Dist(&pnt1) = %v, want 5", got)
+	}
+	if got := pnt0.DistSqr(&pnt1); got != 25.0 {
+		t.Errorf("pnt0.DistSqr(&pnt1) = %v, want 25", got)
+	}
+	if got := pnt0.AddV2(vec); got != pnt1 {
+		t.Errorf("pnt0.AddV2(vec) = %v, want %v", got, pnt1)
+	}
+	if got := pnt1.Sub(pnt0); got != vec {
+		t.Errorf("pnt1.Sub(pnt0) = %v, want %v", got, vec)
+	}
+	unitVec := vec.Normalize()
+	if got := pnt1.Projection(&unitVec); !near(got, 6.4, 1e-6) {
+		t.Errorf("pnt1.Projection = %v, want 6.4", got)
+	}
+}
@@ -4,845 +4,358 @@
 
 package vectormath
 
-import "fmt"
-
-const g_SLERP_TOL = 0.999
-
 func V3Copy(result *Vector3, vec *Vector3) {
-	result.X = vec.X
-	result.Y = vec.Y
-	result.Z = vec.Z
+	v3Copy(result, vec)
 }
 
 func V3MakeFromElems(result *Vector3, x, y, z float32) {
-	result.X = x
-	result.Y = y
-	result.Z = z
+	v3MakeFromElems(result, x, y, z)
 }
 
 func V3MakeFromP3(result *Vector3, pnt *Point3) {
-	result.X = pnt.X
-	result.Y = pnt.Y
-	result.Z = pnt.Z
+	v3MakeFromP3(result, pnt)
 }
 
 func V3MakeFromScalar(result *Vector3, scalar float32) {
-	result.X = scalar
-	result.Y = scalar
-	result.Z = scalar
+	v3MakeFromScalar(result, scalar)
 }
 
 func V3MakeXAxis(result *Vector3) {
-	V3MakeFromElems(result, 1.0, 0.0, 0.0)
+	v3MakeXAxis(result)
 }
 
 func V3MakeYAxis(result *Vector3) {
-	V3MakeFromElems(result, 0.0, 1.0, 0.0)
+	v3MakeYAxis(result)
 }
 
 func V3MakeZAxis(result *Vector3) {
-	V3MakeFromElems(result, 0.0, 0.0, 1.0)
+	v3MakeZAxis(result)
 }
 
 func V3Lerp(result *Vector3, t float32, vec0, vec1 *Vector3) {
-	var tmpV3_0, tmpV3_1 Vector3
-	V3Sub(&tmpV3_0, vec1, vec0)
-	V3ScalarMul(&tmpV3_1, &tmpV3_0, t)
-	V3Add(result, vec0, &tmpV3_1)
+	v3Lerp(result, t, vec0, vec1)
 }
 
 func V3Slerp(result *Vector3, t float32, unitVec0, unitVec1 *Vector3) {
-	var tmpV3_0, tmpV3_1 Vector3
-	var scale0, scale1 float32
-	cosAngle := V3Dot(unitVec0, unitVec1)
-	if cosAngle < g_SLERP_TOL {
-		angle := acos(cosAngle)
-		recipSinAngle := 1.0 / sin(angle)
-		scale0 = (sin(((1.0 - t) * angle)) * recipSinAngle)
-		scale1 = (sin((t * angle)) * recipSinAngle)
-	} else {
-		scale0 = 1.0 - t
-		scale1 = t
-	}
-	V3ScalarMul(&tmpV3_0, unitVec0, scale0)
-	V3ScalarMul(&tmpV3_1, unitVec1, scale1)
-	V3Add(result, &tmpV3_0, &tmpV3_1)
-}
-
-func (v *Vector3) SetX(x float32) {
-	v.X = x
-}
-
-func (v *Vector3) SetY(y float32) {
-	v.Y = y
-}
-
-func (v *Vector3) SetZ(z float32) {
-	v.Z = z
-}
-
-func (v *Vector3) SetElem(index int, value float32) {
-	switch index {
-	case 0:
-		v.X = value
-	case 1:
-		v.Y = value
-	case 2:
-		v.Z = value
-	}
-}
-
-func (v *Vector3) GetElem(index int) float32 {
-	switch index {
-	case 0:
-		return v.X
-	case 1:
-		return v.Y
-	case 2:
-		return v.Z
-	}
-	return 0
+	v3Slerp(result, t, unitVec0, unitVec1)
 }
 
 func V3Add(result, vec0, vec1 *Vector3) {
-	result.X = vec0.X + vec1.X
-	result.Y = vec0.Y + vec1.Y
-	result.Z = vec0.Z + vec1.Z
+	v3Add(result, vec0, vec1)
 }
 
 func V3Sub(result, vec0, vec1 *Vector3) {
-	result.X = vec0.X - vec1.X
-	result.Y = vec0.Y - vec1.Y
-	result.Z = vec0.Z - vec1.Z
+	v3Sub(result, vec0, vec1)
 }
 
 func V3AddP3(result, vec0 *Vector3, pnt1 *Point3) {
-	result.X = vec0.X + pnt1.X
-	result.Y = vec0.Y + pnt1.Y
-	result.Z = vec0.Z + pnt1.Z
+	v3AddP3(result, vec0, pnt1)
 }
 
 func V3ScalarMul(result, vec *Vector3, scalar float32) {
-	result.X = vec.X * scalar
-	result.Y = vec.Y * scalar
-	result.Z = vec.Z * scalar
+	v3ScalarMul(result, vec, scalar)
 }
 
 func V3ScalarDiv(result, vec *Vector3, scalar float32) {
-	result.X = vec.X / scalar
-	result.Y = vec.Y / scalar
-	result.Z = vec.Z / scalar
+	v3ScalarDiv(result, vec, scalar)
 }
 
 func V3Neg(result, vec *Vector3) {
-	result.X = -vec.X
-	result.Y = -vec.Y
-	result.Z = -vec.Z
+	v3Neg(result, vec)
 }
 
 func V3MulPerElem(result, vec0, vec1 *Vector3) {
-	result.X = vec0.X * vec1.X
-	result.Y = vec0.Y * vec1.Y
-	result.Z = vec0.Z * vec1.Z
+	v3MulPerElem(result, vec0, vec1)
 }
 
 func V3DivPerElem(result, vec0, vec1 *Vector3) {
-	result.X = vec0.X / vec1.X
-	result.Y = vec0.Y / vec1.Y
-	result.Z = vec0.Z / vec1.Z
+	v3DivPerElem(result, vec0, vec1)
 }
 
 func V3RecipPerElem(result, vec *Vector3) {
-	result.X = 1.0 / vec.X
-	result.Y = 1.0 / vec.Y
-	result.Z = 1.0 / vec.Z
+	v3RecipPerElem(result, vec)
+}
+
+// V3RecipPerElemFast is V3RecipPerElem computed, on amd64, with the SSE
+// estimate instruction refined by a Newton-Raphson step. The relative error
+// is at most 3e-7, and the results for zero, infinite and denormal
+// elements are unspecified. Elsewhere it is the same as V3RecipPerElem.
+func V3RecipPerElemFast(result, vec *Vector3) {
+	v3RecipFastF32(result, vec)
 }
 
 func V3SqrtPerElem(result, vec *Vector3) {
-	result.X = sqrt(vec.X)
-	result.Y = sqrt(vec.Y)
-	result.Z = sqrt(vec.Z)
+	v3SqrtPerElem(result, vec)
 }
 
 func V3RsqrtPerElem(result, vec *Vector3) {
-	result.X = 1.0 / sqrt(vec.X)
-	result.Y = 1.0 / sqrt(vec.Y)
-	result.Z = 1.0 / sqrt(vec.Z)
+	v3RsqrtPerElem(result, vec)
+}
+
+// V3RsqrtPerElemFast is V3RsqrtPerElem computed, on amd64, with the SSE
+// estimate instruction refined by a Newton-Raphson step. The relative error
+// is at most 3e-7, and the results for zero, infinite, negative and denormal
+// elements are unspecified. Elsewhere it is the same as V3RsqrtPerElem.
+func V3RsqrtPerElemFast(result, vec *Vector3) {
+	v3RsqrtFastF32(result, vec)
 }
 
 func V3AbsPerElem(result, vec *Vector3) {
-	result.X = abs(vec.X)
-	result.Y = abs(vec.Y)
-	result.Z = abs(vec.Z)
+	v3AbsPerElem(result, vec)
 }
 
 func V3CopySignPerElem(result, vec0, vec1 *Vector3) {
-	if vec1.X < 0.0 {
-		result.X = -abs(vec0.X)
-	} else {
-		result.X = abs(vec0.X)
-	}
-	if vec1.Y < 0.0 {
-		result.Y = -abs(vec0.Y)
-	} else {
-		result.Y = abs(vec0.Y)
-	}
-	if vec1.Z < 0.0 {
-		result.Z = -abs(vec0.Z)
-	} else {
-		result.Z = abs(vec0.Z)
-	}
+	v3CopySignPerElem(result, vec0, vec1)
 }
 
 func V3MaxPerElem(result, vec0, vec1 *Vector3) {
-	result.X = max(vec0.X, vec1.X)
-	result.Y = max(vec0.Y, vec1.Y)
-	result.Z = max(vec0.Z, vec1.Z)
-}
-
-func (v *Vector3) MaxElem() float32 {
-	var result float32
-	result = max(v.X, v.Y)
-	result = max(v.Z, result)
-	return result
+	v3MaxPerElem(result, vec0, vec1)
 }
 
 func V3MinPerElem(result, vec0, vec1 *Vector3) {
-	result.X = min(vec0.X, vec1.X)
-	result.Y = min(vec0.Y, vec1.Y)
-	result.Z = min(vec0.Z, vec1.Z)
-}
-
-func (v *Vector3) MinElem() float32 {
-	var result float32
-	result = min(v.X, v.Y)
-	result = min(v.Z, result)
-	return result
-}
-
-func (v *Vector3) Sum() float32 {
-	var result float32
-	result = v.X + v.Y + v.Z
-	return result
+	v3MinPerElem(result, vec0, vec1)
 }
 
 func V3Dot(vec0, vec1 *Vector3) float32 {
-	result := vec0.X * vec1.X
-	result += vec0.Y * vec1.Y
-	result += vec0.Z * vec1.Z
-	return result
-}
-
-func (v *Vector3) Dot(vec1 *Vector3) float32 {
-	result := v.X * vec1.X
-	result += v.Y * vec1.Y
-	result += v.Z * vec1.Z
-	return result
-}
-
-func (v *Vector3) LengthSqr() float32 {
-	result := v.X * v.X
-	result += v.Y * v.Y
-	result += v.Z * v.Z
-	return result
-}
-
-func (v *Vector3) Length() float32 {
-	return sqrt(v.LengthSqr())
+	return v3Dot(vec0, vec1)
 }
 
 func V3Normalize(result, vec *Vector3) {
-	lenSqr := vec.LengthSqr()
-	lenInv := 1.0 / sqrt(lenSqr)
-	result.X = vec.X * lenInv
-	result.Y = vec.Y * lenInv
-	result.Z = vec.Z * lenInv
+	v3Normalize(result, vec)
 }
 
 func V3Cross(result, vec0, vec1 *Vector3) {
-	tmpX := vec0.Y*vec1.Z - vec0.Z*vec1.Y
-	tmpY := vec0.Z*vec1.X - vec0.X*vec1.Z
-	tmpZ := vec0.X*vec1.Y - vec0.Y*vec1.X
-	V3MakeFromElems(result, tmpX, tmpY, tmpZ)
+	v3CrossF32(result, vec0, vec1)
 }
 
 func V3Select(result, vec0, vec1 *Vector3, select1 int) {
-	if select1 != 0 {
-		result.X = vec1.X
-		result.Y = vec1.Y
-		result.Z = vec1.Z
-	} else {
-		result.X = vec0.X
-		result.Y = vec0.Y
-		result.Z = vec0.Z
-	}
-}
-
-func (v *Vector3) String() string {
-	return fmt.Sprintf("( %f %f %f )\n", v.X, v.Y, v.Z)
+	v3Select(result, vec0, vec1, select1)
 }
 
 /*******/
 
 func V4Copy(result, vec *Vector4) {
-	result.X = vec.X
-	result.Y = vec.Y
-	result.Z = vec.Z
-	result.W = vec.W
+	v4Copy(result, vec)
 }
 
 func V4MakeFromElems(result *Vector4, x, y, z, w float32) {
-	result.X = x
-	result.Y = y
-	result.Z = z
-	result.W = w
+	v4MakeFromElems(result, x, y, z, w)
 }
 
 func V4MakeFromV3Scalar(result *Vector4, xyz *Vector3, w float32) {
-	result.SetXYZ(xyz)
-	result.SetW(w)
+	v4MakeFromV3Scalar(result, xyz, w)
 }
 
 func V4MakeFromV3(result *Vector4, vec *Vector3) {
-	result.X = vec.X
-	result.Y = vec.Y
-	result.Z = vec.Z
-	result.W = 0.0
+	v4MakeFromV3(result, vec)
 }
 
 func V4MakeFromP3(result *Vector4, pnt *Point3) {
-	result.X = pnt.X
-	result.Y = pnt.Y
-	result.Z = pnt.Z
-	result.W = 1.0
+	v4MakeFromP3(result, pnt)
 }
 
 func V4MakeFromQ(result *Vector4, quat *Quat) {
-	result.X = quat.X
-	result.Y = quat.Y
-	result.Z = quat.Z
-	result.W = quat.W
+	v4MakeFromQ(result, quat)
 }
 
 func V4MakeFromScalar(result *Vector4, scalar float32) {
-	result.X = scalar
-	result.Y = scalar
-	result.Z = scalar
-	result.W = scalar
+	v4MakeFromScalar(result, scalar)
 }
 
 func V4MakeXAxis(result *Vector4) {
-	V4MakeFromElems(result, 1.0, 0.0, 0.0, 0.0)
+	v4MakeXAxis(result)
 }
 
 func V4MakeYAxis(result *Vector4) {
-	V4MakeFromElems(result, 0.0, 1.0, 0.0, 0.0)
+	v4MakeYAxis(result)
 }
 
 func V4MakeZAxis(result *Vector4) {
-	V4MakeFromElems(result, 0.0, 0.0, 1.0, 0.0)
+	v4MakeZAxis(result)
 }
 
 func V4MakeWAxis(result *Vector4) {
-	V4MakeFromElems(result, 0.0, 0.0, 0.0, 1.0)
+	v4MakeWAxis(result)
 }
 
 func V4Lerp(result *Vector4, t float32, vec0, vec1 *Vector4) {
-	var tmpV4_0, tmpV4_1 Vector4
-	V4Sub(&tmpV4_0, vec1, vec0)
-	V4ScalarMul(&tmpV4_1, &tmpV4_0, t)
-	V4Add(result, vec0, &tmpV4_1)
+	v4Lerp(result, t, vec0, vec1)
 }
 
 func V4Slerp(result *Vector4, t float32, unitVec0, unitVec1 *Vector4) {
-	var tmpV4_0, tmpV4_1 Vector4
-	var scale0, scale1 float32
-	cosAngle := V4Dot(unitVec0, unitVec1)
-	if cosAngle < g_SLERP_TOL {
-		angle := acos(cosAngle)
-		recipSinAngle := (1.0 / sin(angle))
-		scale0 = (sin(((1.0 - t) * angle)) * recipSinAngle)
-		scale1 = (sin((t * angle)) * recipSinAngle)
-	} else {
-		scale0 = (1.0 - t)
-		scale1 = t
-	}
-	V4ScalarMul(&tmpV4_0, unitVec0, scale0)
-	V4ScalarMul(&tmpV4_1, unitVec1, scale1)
-	V4Add(result, &tmpV4_0, &tmpV4_1)
-}
-
-func (v *Vector4) SetXYZ(vec *Vector3) {
-	v.X = vec.X
-	v.Y = vec.Y
-	v.Z = vec.Z
+	v4Slerp(result, t, unitVec0, unitVec1)
 }
 
 func V4GetXYZ(result *Vector3, vec *Vector4) {
-	V3MakeFromElems(result, vec.X, vec.Y, vec.Z)
-}
-
-func (v *Vector4) SetX(x float32) {
-	v.X = x
-}
-
-func (v *Vector4) SetY(y float32) {
-	v.Y = y
-}
-
-func (v *Vector4) SetZ(z float32) {
-	v.Z = z
-}
-
-func (v *Vector4) SetW(w float32) {
-	v.W = w
-}
-
-func (v *Vector4) SetElem(index int, value float32) {
-	switch index {
-	case 0:
-		v.X = value
-	case 1:
-		v.Y = value
-	case 2:
-		v.Z = value
-	case 3:
-		v.W = value
-	}
-}
-
-func (v *Vector4) GetElem(index int) float32 {
-	switch index {
-	case 0:
-		return v.X
-	case 1:
-		return v.Y
-	case 2:
-		return v.Z
-	case 3:
-		return v.W
-	}
-	return 0
+	v4GetXYZ(result, vec)
 }
 
 func V4Add(result, vec0, vec1 *Vector4) {
-	result.X = vec0.X + vec1.X
-	result.Y = vec0.Y + vec1.Y
-	result.Z = vec0.Z + vec1.Z
-	result.W = vec0.W + vec1.W
+	v4Add(result, vec0, vec1)
 }
 
 func V4Sub(result, vec0, vec1 *Vector4) {
-	result.X = vec0.X - vec1.X
-	result.Y = vec0.Y - vec1.Y
-	result.Z = vec0.Z - vec1.Z
-	result.W = vec0.W - vec1.W
+	v4Sub(result, vec0, vec1)
 }
 
 func V4ScalarMul(result, vec *Vector4, scalar float32) {
-	result.X = vec.X * scalar
-	result.Y = vec.Y * scalar
-	result.Z = vec.Z * scalar
-	result.W = vec.W * scalar
+	v4ScalarMul(result, vec, scalar)
 }
 
 func V4ScalarDiv(result, vec *Vector4, scalar float32) {
-	result.X = vec.X / scalar
-	result.Y = vec.Y / scalar
-	result.Z = vec.Z / scalar
-	result.W = vec.W / scalar
+	v4ScalarDiv(result, vec, scalar)
 }
 
 func V4Neg(result, vec *Vector4) {
-	result.X = -vec.X
-	result.Y = -vec.Y
-	result.Z = -vec.Z
-	result.W = -vec.W
+	v4Neg(result, vec)
 }
 
 func V4MulPerElem(result, vec0, vec1 *Vector4) {
-	result.X = vec0.X * vec1.X
-	result.Y = vec0.Y * vec1.Y
-	result.Z = vec0.Z * vec1.Z
-	result.W = vec0.W * vec1.W
+	v4MulPerElem(result, vec0, vec1)
 }
 
 func V4DivPerElem(result, vec0, vec1 *Vector4) {
-	result.X = vec0.X / vec1.X
-	result.Y = vec0.Y / vec1.Y
-	result.Z = vec0.Z / vec1.Z
-	result.W = vec0.W / vec1.W
+	v4DivPerElem(result, vec0, vec1)
 }
 
 func V4RecipPerElem(result, vec *Vector4) {
-	result.X = 1.0 / vec.X
-	result.Y = 1.0 / vec.Y
-	result.Z = 1.0 / vec.Z
-	result.W = 1.0 / vec.W
+	v4RecipPerElem(result, vec)
+}
+
+// V4RecipPerElemFast is the Vector4 counterpart of V3RecipPerElemFast.
+func V4RecipPerElemFast(result, vec *Vector4) {
+	v4RecipFastF32(result, vec)
 }
 
 func V4SqrtPerElem(result, vec *Vector4) {
-	result.X = sqrt(vec.X)
-	result.Y = sqrt(vec.Y)
-	result.Z = sqrt(vec.Z)
-	result.W = sqrt(vec.W)
+	v4SqrtPerElem(result, vec)
 }
 
 func V4RsqrtPerElem(result, vec *Vector4) {
-	result.X = 1.0 / sqrt(vec.X)
-	result.Y = 1.0 / sqrt(vec.Y)
-	result.Z = 1.0 / sqrt(vec.Z)
-	result.W = 1.0 / sqrt(vec.W)
+	v4RsqrtPerElem(result, vec)
+}
+
+// V4RsqrtPerElemFast is the Vector4 counterpart of V3RsqrtPerElemFast.
+func V4RsqrtPerElemFast(result, vec *Vector4) {
+	v4RsqrtFastF32(result, vec)
 }
 
 func V4AbsPerElem(result, vec *Vector4) {
-	result.X = abs(vec.X)
-	result.Y = abs(vec.Y)
-	result.Z = abs(vec.Z)
-	result.W = abs(vec.W)
+	v4AbsPerElem(result, vec)
 }
 
 func V4CopySignPerElem(result, vec0, vec1 *Vector4) {
-	if vec1.X < 0.0 {
-		result.X = -abs(vec0.X)
-	} else {
-		result.X = abs(vec0.X)
-	}
-	if vec1.Y < 0.0 {
-		result.Y = -abs(vec0.Y)
-	} else {
-		result.Y = abs(vec0.Y)
-	}
-	if vec1.Z < 0.0 {
-		result.Z = -abs(vec0.Z)
-	} else {
-		result.Z = abs(vec0.Z)
-	}
-	if vec1.W < 0.0 {
-		result.W = -abs(vec0.W)
-	} else {
-		result.W = abs(vec0.W)
-	}
+	v4CopySignPerElem(result, vec0, vec1)
 }
 
 func V4MaxPerElem(result, vec0, vec1 *Vector4) {
-	result.X = max(vec0.X, vec1.X)
-	result.Y = max(vec0.Y, vec1.Y)
-	result.Z = max(vec0.Z, vec1.Z)
-	result.W = max(vec0.W, vec1.W)
-}
-
-func (v *Vector4) MaxElem() float32 {
-	var result float32
-	result = max(v.X, v.Y)
-	result = max(v.Z, result)
-	result = max(v.W, result)
-	return result
+	v4MaxPerElem(result, vec0, vec1)
 }
 
 func V4MinPerElem(result, vec0, vec1 *Vector4) {
-	result.X = min(vec0.X, vec1.X)
-	result.Y = min(vec0.Y, vec1.Y)
-	result.Z = min(vec0.Z, vec1.Z)
-	result.W = min(vec0.W, vec1.W)
-}
-
-func (v *Vector4) MinElem() float32 {
-	var result float32
-	result = min(v.X, v.Y)
-	result = min(v.Z, result)
-	result = min(v.W, result)
-	return result
-}
-
-func (v *Vector4) Sum() float32 {
-	var result float32
-	result = v.X + v.Y + v.Z + v.W
-	return result
+	v4MinPerElem(result, vec0, vec1)
 }
 
 func V4Dot(vec0, vec1 *Vector4) float32 {
-	result := vec0.X * vec1.X
-	result += vec0.Y * vec1.Y
-	result += vec0.Z * vec1.Z
-	result += vec0.W * vec1.W
-	return result
-}
-
-func (v *Vector4) Dot(vec1 *Vector4) float32 {
-	result := v.X * vec1.X
-	result += v.Y * vec1.Y
-	result += v.Z * vec1.Z
-	result += v.W * vec1.W
-	return result
-}
-
-func (v *Vector4) LengthSqr() float32 {
-	result := v.X * v.X
-	result += v.Y * v.Y
-	result += v.Z * v.Z
-	result += v.W * v.W
-	return result
-}
-
-func (v *Vector4) Length() float32 {
-	return sqrt(v.LengthSqr())
+	return v4Dot(vec0, vec1)
 }
 
 func V4Normalize(result, vec *Vector4) {
-	lenSqr := vec.LengthSqr()
-	lenInv := 1.0 / sqrt(lenSqr)
-	result.X = vec.X * lenInv
-	result.Y = vec.Y * lenInv
-	result.Z = vec.Z * lenInv
-	result.W = vec.W * lenInv
+	v4Normalize(result, vec)
 }
 
 func V4Select(result, vec0, vec1 *Vector4, select1 int) {
-	if select1 != 0 {
-		result.X = vec1.X
-		result.Y = vec1.Y
-		result.Z = vec1.Z
-		result.W = vec1.W
-	} else {
-		result.X = vec0.X
-		result.Y = vec0.Y
-		result.Z = vec0.Z
-		result.W = vec0.W
-	}
-}
-
-func (v *Vector4) String() string {
-	return fmt.Sprintf("( %f %f %f %f )", v.X, v.Y, v.Z, v.W)
+	v4Select(result, vec0, vec1, select1)
 }
 
 /*******/
 
 func P3Copy(result, pnt *Point3) {
-	result.X = pnt.X
-	result.Y = pnt.Y
-	result.Z = pnt.Z
+	p3Copy(result, pnt)
 }
 
 func P3MakeFromElems(result *Point3, x, y, z float32) {
-	result.X = x
-	result.Y = y
-	result.Z = z
+	p3MakeFromElems(result, x, y, z)
 }
 
 func P3MakeFromV3(result *Point3, vec *Vector3) {
-	result.X = vec.X
-	result.Y = vec.Y
-	result.Z = vec.Z
+	p3MakeFromV3(result, vec)
 }
 
 func P3MakeFromScalar(result *Point3, scalar float32) {
-	result.X = scalar
-	result.Y = scalar
-	result.Z = scalar
+	p3MakeFromScalar(result, scalar)
 }
 
 func P3Lerp(result *Point3, t float32, pnt0, pnt1 *Point3) {
-	var tmpV3_0, tmpV3_1 Vector3
-	P3Sub(&tmpV3_0, pnt1, pnt0)
-	V3ScalarMul(&tmpV3_1, &tmpV3_0, t)
-	P3AddV3(result, pnt0, &tmpV3_1)
-}
-
-func (p *Point3) SetX(x float32) {
-	p.X = x
-}
-
-func (p *Point3) SetY(y float32) {
-	p.Y = y
-}
-
-func (p *Point3) SetZ(z float32) {
-	p.Z = z
-}
-
-func (p *Point3) SetElem(index int, value float32) {
-	switch index {
-	case 0:
-		p.X = value
-	case 1:
-		p.Y = value
-	case 2:
-		p.Z = value
-	}
-}
-
-func (p *Point3) GetElem(index int) float32 {
-	switch index {
-	case 0:
-		return p.X
-	case 1:
-		return p.Y
-	case 2:
-		return p.Z
-	}
-	return 0
+	p3Lerp(result, t, pnt0, pnt1)
 }
 
 func P3Sub(result *Vector3, pnt0, pnt1 *Point3) {
-	result.X = pnt0.X - pnt1.X
-	result.Y = pnt0.Y - pnt1.Y
-	result.Z = pnt0.Z - pnt1.Z
+	p3Sub(result, pnt0, pnt1)
 }
 
 func P3AddV3(result, pnt0 *Point3, vec1 *Vector3) {
-	result.X = pnt0.X + vec1.X
-	result.Y = pnt0.Y + vec1.Y
-	result.Z = pnt0.Z + vec1.Z
+	p3AddV3(result, pnt0, vec1)
 }
 
 func P3SubV3(result, pnt0 *Point3, vec1 *Vector3) {
-	result.X = pnt0.X - vec1.X
-	result.Y = pnt0.Y - vec1.Y
-	result.Z = pnt0.Z - vec1.Z
+	p3SubV3(result, pnt0, vec1)
 }
 
 func P3MulPerElem(result, pnt0, pnt1 *Point3) {
-	result.X = pnt0.X * pnt1.X
-	result.Y = pnt0.Y * pnt1.Y
-	result.Z = pnt0.Z * pnt1.Z
+	p3MulPerElem(result, pnt0, pnt1)
 }
 
 func P3DivPerElem(result, pnt0, pnt1 *Point3) {
-	result.X = pnt0.X / pnt1.X
-	result.Y = pnt0.Y / pnt1.Y
-	result.Z = pnt0.Z / pnt1.Z
+	p3DivPerElem(result, pnt0, pnt1)
 }
 
 func P3RecipPerElem(result, pnt *Point3) {
-	result.X = 1.0 / pnt.X
-	result.Y = 1.0 / pnt.Y
-	result.Z = 1.0 / pnt.Z
+	p3RecipPerElem(result, pnt)
+}
+
+// P3RecipPerElemFast is the Point3 counterpart of V3RecipPerElemFast.
+func P3RecipPerElemFast(result, pnt *Point3) {
+	p3RecipFastF32(result, pnt)
 }
 
 func P3SqrtPerElem(result, pnt *Point3) {
-	result.X = sqrt(pnt.X)
-	result.Y = sqrt(pnt.Y)
-	result.Z = sqrt(pnt.Z)
+	p3SqrtPerElem(result, pnt)
 }
 
 func P3RsqrtPerElem(result, pnt *Point3) {
-	result.X = 1.0 / sqrt(pnt.X)
-	result.Y = 1.0 / sqrt(pnt.Y)
-	result.Z = 1.0 / sqrt(pnt.Z)
+	p3RsqrtPerElem(result, pnt)
+}
+
+// P3RsqrtPerElemFast is the Point3 counterpart of V3RsqrtPerElemFast.
+func P3RsqrtPerElemFast(result, pnt *Point3) {
+	p3RsqrtFastF32(result, pnt)
 }
 
 func P3AbsPerElem(result, pnt *Point3) {
-	result.X = abs(pnt.X)
-	result.Y = abs(pnt.Y)
-	result.Z = abs(pnt.Z)
+	p3AbsPerElem(result, pnt)
 }
 
 func P3CopySignPerElem(result, pnt0, pnt1 *Point3) {
-	if pnt1.X < 0.0 {
-		result.X = -abs(pnt0.X)
-	} else {
-		result.X = abs(pnt0.X)
-	}
-	if pnt1.Y < 0.0 {
-		result.Y = -abs(pnt0.Y)
-	} else {
-		result.Y = abs(pnt0.Y)
-	}
-	if pnt1.Z < 0.0 {
-		result.Z = -abs(pnt0.Z)
-	} else {
-		result.Z = abs(pnt0.Z)
-	}
+	p3CopySignPerElem(result, pnt0, pnt1)
 }
 
 func P3MaxPerElem(result, pnt0, pnt1 *Point3) {
-	result.X = max(pnt0.X, pnt1.X)
-	result.Y = max(pnt0.Y, pnt1.Y)
-	result.Z = max(pnt0.Z, pnt1.Z)
-}
-
-func (p *Point3) MaxElem() float32 {
-	var result float32
-	result = max(p.X, p.Y)
-	result = max(p.Z, result)
-	return result
+	p3MaxPerElem(result, pnt0, pnt1)
 }
 
 func P3MinPerElem(result, pnt0, pnt1 *Point3) {
-	result.X = min(pnt0.X, pnt1.X)
-	result.Y = min(pnt0.Y, pnt1.Y)
-	result.Z = min(pnt0.Z, pnt1.Z)
-}
-
-func (p *Point3) MinElem() float32 {
-	var result float32
-	result = min(p.X, p.Y)
-	result = min(p.Z, result)
-	return result
-}
-
-func (p *Point3) Sum() float32 {
-	var result float32
-	result = p.X + p.Y + p.Z
-	return result
+	p3MinPerElem(result, pnt0, pnt1)
 }
 
 func P3Scale(result, pnt *Point3, scaleVal float32) {
-	var tmpP3_0 Point3
-	P3MakeFromScalar(&tmpP3_0, scaleVal)
-	P3MulPerElem(result, pnt, &tmpP3_0)
+	p3Scale(result, pnt, scaleVal)
 }
 
 func P3NonUniformScale(result, pnt *Point3, scaleVec *Vector3) {
-	var tmpP3_0 Point3
-	P3MakeFromV3(&tmpP3_0, scaleVec)
-	P3MulPerElem(result, pnt, &tmpP3_0)
-}
-
-func (p *Point3) Projection(unitVec *Vector3) float32 {
-	result := p.X * unitVec.X
-	result += p.Y * unitVec.Y
-	result += p.Z * unitVec.Z
-	return result
-}
-
-func (p *Point3) DistSqrFromOrigin() float32 {
-	var tmpV3_0 Vector3
-	V3MakeFromP3(&tmpV3_0, p)
-	return tmpV3_0.LengthSqr()
-}
-
-func (p *Point3) DistFromOrigin() float32 {
-	var tmpV3_0 Vector3
-	V3MakeFromP3(&tmpV3_0, p)
-	return tmpV3_0.Length()
-}
-
-func (p *Point3) DistSqr(pnt1 *Point3) float32 {
-	var tmpV3_0 Vector3
-	P3Sub(&tmpV3_0, pnt1, p)
-	return tmpV3_0.LengthSqr()
-}
-
-func (p *Point3) Dist(pnt1 *Point3) float32 {
-	var tmpV3_0 Vector3
-	P3Sub(&tmpV3_0, pnt1, p)
-	return tmpV3_0.Length()
+	p3NonUniformScale(result, pnt, scaleVec)
 }
 
 func P3Select(result, pnt0, pnt1 *Point3, select1 int) {
-	if select1 != 0 {
-		result.X = pnt1.X
-		result.Y = pnt1.Y
-		result.Z = pnt1.Z
-	} else {
-		result.X = pnt0.X
-		result.Y = pnt0.Y
-		result.Z = pnt0.Z
-	}
-}
-
-func (p *Point3) String() string {
-	return fmt.Sprintf("( %f %f %f )", p.X, p.Y, p.Z)
+	p3Select(result, pnt0, pnt1, select1)
 }
@@ -0,0 +1,351 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func V3dCopy(result *Vector3d, vec *Vector3d) {
+	v3Copy(result, vec)
+}
+
+func V3dMakeFromElems(result *Vector3d, x, y, z float64) {
+	v3MakeFromElems(result, x, y, z)
+}
+
+func V3dMakeFromP3d(result *Vector3d, pnt *Point3d) {
+	v3MakeFromP3(result, pnt)
+}
+
+func V3dMakeFromScalar(result *Vector3d, scalar float64) {
+	v3MakeFromScalar(result, scalar)
+}
+
+func V3dMakeXAxis(result *Vector3d) {
+	v3MakeXAxis(result)
+}
+
+func V3dMakeYAxis(result *Vector3d) {
+	v3MakeYAxis(result)
+}
+
+func V3dMakeZAxis(result *Vector3d) {
+	v3MakeZAxis(result)
+}
+
+func V3dLerp(result *Vector3d, t float64, vec0, vec1 *Vector3d) {
+	v3Lerp(result, t, vec0, vec1)
+}
+
+func V3dSlerp(result *Vector3d, t float64, unitVec0, unitVec1 *Vector3d) {
+	v3Slerp(result, t, unitVec0, unitVec1)
+}
+
+func V3dAdd(result, vec0, vec1 *Vector3d) {
+	v3Add(result, vec0, vec1)
+}
+
+func V3dSub(result, vec0, vec1 *Vector3d) {
+	v3Sub(result, vec0, vec1)
+}
+
+func V3dAddP3d(result, vec0 *Vector3d, pnt1 *Point3d) {
+	v3AddP3(result, vec0, pnt1)
+}
+
+func V3dScalarMul(result, vec *Vector3d, scalar float64) {
+	v3ScalarMul(result, vec, scalar)
+}
+
+func V3dScalarDiv(result, vec *Vector3d, scalar float64) {
+	v3ScalarDiv(result, vec, scalar)
+}
+
+func V3dNeg(result, vec *Vector3d) {
+	v3Neg(result, vec)
+}
+
+func V3dMulPerElem(result, vec0, vec1 *Vector3d) {
+	v3MulPerElem(result, vec0, vec1)
+}
+
+func V3dDivPerElem(result, vec0, vec1 *Vector3d) {
+	v3DivPerElem(result, vec0, vec1)
+}
+
+func V3dRecipPerElem(result, vec *Vector3d) {
+	v3RecipPerElem(result, vec)
+}
+
+func V3dSqrtPerElem(result, vec *Vector3d) {
+	v3SqrtPerElem(result, vec)
+}
+
+func V3dRsqrtPerElem(result, vec *Vector3d) {
+	v3RsqrtPerElem(result, vec)
+}
+
+func V3dAbsPerElem(result, vec *Vector3d) {
+	v3AbsPerElem(result, vec)
+}
+
+func V3dCopySignPerElem(result, vec0, vec1 *Vector3d) {
+	v3CopySignPerElem(result, vec0, vec1)
+}
+
+func V3dMaxPerElem(result, vec0, vec1 *Vector3d) {
+	v3MaxPerElem(result, vec0, vec1)
+}
+
+func V3dMinPerElem(result, vec0, vec1 *Vector3d) {
+	v3MinPerElem(result, vec0, vec1)
+}
+
+func V3dDot(vec0, vec1 *Vector3d) float64 {
+	return v3Dot(vec0, vec1)
+}
+
+func V3dNormalize(result, vec *Vector3d) {
+	v3Normalize(result, vec)
+}
+
+func V3dCross(result, vec0, vec1 *Vector3d) {
+	v3Cross(result, vec0, vec1)
+}
+
+func V3dSelect(result, vec0, vec1 *Vector3d, select1 int) {
+	v3Select(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func V4dCopy(result, vec *Vector4d) {
+	v4Copy(result, vec)
+}
+
+func V4dMakeFromElems(result *Vector4d, x, y, z, w float64) {
+	v4MakeFromElems(result, x, y, z, w)
+}
+
+func V4dMakeFromV3dScalar(result *Vector4d, xyz *Vector3d, w float64) {
+	v4MakeFromV3Scalar(result, xyz, w)
+}
+
+func V4dMakeFromV3d(result *Vector4d, vec *Vector3d) {
+	v4MakeFromV3(result, vec)
+}
+
+func V4dMakeFromP3d(result *Vector4d, pnt *Point3d) {
+	v4MakeFromP3(result, pnt)
+}
+
+func V4dMakeFromQd(result *Vector4d, quat *Quatd) {
+	v4MakeFromQ(result, quat)
+}
+
+func V4dMakeFromScalar(result *Vector4d, scalar float64) {
+	v4MakeFromScalar(result, scalar)
+}
+
+func V4dMakeXAxis(result *Vector4d) {
+	v4MakeXAxis(result)
+}
+
+func V4dMakeYAxis(result *Vector4d) {
+	v4MakeYAxis(result)
+}
+
+func V4dMakeZAxis(result *Vector4d) {
+	v4MakeZAxis(result)
+}
+
+func V4dMakeWAxis(result *Vector4d) {
+	v4MakeWAxis(result)
+}
+
+func V4dLerp(result *Vector4d, t float64, vec0, vec1 *Vector4d) {
+	v4Lerp(result, t, vec0, vec1)
+}
+
+func V4dSlerp(result *Vector4d, t float64, unitVec0, unitVec1 *Vector4d) {
+	v4Slerp(result, t, unitVec0, unitVec1)
+}
+
+func V4dGetXYZ(result *Vector3d, vec *Vector4d) {
+	v4GetXYZ(result, vec)
+}
+
+func V4dAdd(result, vec0, vec1 *Vector4d) {
+	v4Add(result, vec0, vec1)
+}
+
+func V4dSub(result, vec0, vec1 *Vector4d) {
+	v4Sub(result, vec0, vec1)
+}
+
+func V4dScalarMul(result, vec *Vector4d, scalar float64) {
+	v4ScalarMul(result, vec, scalar)
+}
+
+func V4dScalarDiv(result, vec *Vector4d, scalar float64) {
+	v4ScalarDiv(result, vec, scalar)
+}
+
+func V4dNeg(result, vec *Vector4d) {
+	v4Neg(result, vec)
+}
+
+func V4dMulPerElem(result, vec0, vec1 *Vector4d) {
+	v4MulPerElem(result, vec0, vec1)
+}
+
+func V4dDivPerElem(result, vec0, vec1 *Vector4d) {
+	v4DivPerElem(result, vec0, vec1)
+}
+
+func V4dRecipPerElem(result, vec *Vector4d) {
+	v4RecipPerElem(result, vec)
+}
+
+func V4dSqrtPerElem(result, vec *Vector4d) {
+	v4SqrtPerElem(result, vec)
+}
+
+func V4dRsqrtPerElem(result, vec *Vector4d) {
+	v4RsqrtPerElem(result, vec)
+}
+
+func V4dAbsPerElem(result, vec *Vector4d) {
+	v4AbsPerElem(result, vec)
+}
+
+func V4dCopySignPerElem(result, vec0, vec1 *Vector4d) {
+	v4CopySignPerElem(result, vec0, vec1)
+}
+
+func V4dMaxPerElem(result, vec0, vec1 *Vector4d) {
+	v4MaxPerElem(result, vec0, vec1)
+}
+
+func V4dMinPerElem(result, vec0, vec1 *Vector4d) {
+	v4MinPerElem(result, vec0, vec1)
+}
+
+func V4dDot(vec0, vec1 *Vector4d) float64 {
+	return v4Dot(vec0, vec1)
+}
+
+func V4dNormalize(result, vec *Vector4d) {
+	v4Normalize(result, vec)
+}
+
+func V4dSelect(result, vec0, vec1 *Vector4d, select1 int) {
+	v4Select(result, vec0, vec1, select1)
+}
+
+/*******/
+
+func P3dCopy(result, pnt *Point3d) {
+	p3Copy(result, pnt)
+}
+
+func P3dMakeFromElems(result *Point3d, x, y, z float64) {
+	p3MakeFromElems(result, x, y, z)
+}
+
+func P3dMakeFromV3d(result *Point3d, vec *Vector3d) {
+	p3MakeFromV3(result, vec)
+}
+
+func P3dMakeFromScalar(result *Point3d, scalar float64) {
+	p3MakeFromScalar(result, scalar)
+}
+
+func P3dLerp(result *Point3d, t float64, pnt0, pnt1 *Point3d) {
+	p3Lerp(result, t, pnt0, pnt1)
+}
+
+func P3dSub(result *Vector3d, pnt0, pnt1 *Point3d) {
+	p3Sub(result, pnt0, pnt1)
+}
+
+func P3dAddV3d(result, pnt0 *Point3d, vec1 *Vector3d) {
+	p3AddV3(result, pnt0, vec1)
+}
+
+func P3dSubV3d(result, pnt0 *Point3d, vec1 *Vector3d) {
+	p3SubV3(result, pnt0, vec1)
+}
+
+func P3dMulPerElem(result, pnt0, pnt1 *Point3d) {
+	p3MulPerElem(result, pnt0, pnt1)
+}
+
+func P3dDivPerElem(result, pnt0, pnt1 *Point3d) {
+	p3DivPerElem(result, pnt0, pnt1)
+}
+
+func P3dRecipPerElem(result, pnt *Point3d) {
+	p3RecipPerElem(result, pnt)
+}
+
+func P3dSqrtPerElem(result, pnt *Point3d) {
+	p3SqrtPerElem(result, pnt)
+}
+
+func P3dRsqrtPerElem(result, pnt *Point3d) {
+	p3RsqrtPerElem(result, pnt)
+}
+
+func P3dAbsPerElem(result, pnt *Point3d) {
+	p3AbsPerElem(result, pnt)
+}
+
+func P3dCopySignPerElem(result, pnt0, pnt1 *Point3d) {
+	p3CopySignPerElem(result, pnt0, pnt1)
+}
+
+func P3dMaxPerElem(result, pnt0, pnt1 *Point3d) {
+	p3MaxPerElem(result, pnt0, pnt1)
+}
+
+func P3dMinPerElem(result, pnt0, pnt1 *Point3d) {
+	p3MinPerElem(result, pnt0, pnt1)
+}
+
+func P3dScale(result, pnt *Point3d, scaleVal float64) {
+	p3Scale(result, pnt, scaleVal)
+}
+
+func P3dNonUniformScale(result, pnt *Point3d, scaleVec *Vector3d) {
+	p3NonUniformScale(result, pnt, scaleVec)
+}
+
+func P3dSelect(result, pnt0, pnt1 *Point3d, select1 int) {
+	p3Select(result, pnt0, pnt1, select1)
+}
+
+/*******/
+
+func V3dMakeFromV3(result *Vector3d, vec *Vector3) {
+	v3Convert(result, vec)
+}
+
+func V3MakeFromV3d(result *Vector3, vec *Vector3d) {
+	v3Convert(result, vec)
+}
+
+func V4dMakeFromV4(result *Vector4d, vec *Vector4) {
+	v4Convert(result, vec)
+}
+
+func V4MakeFromV4d(result *Vector4, vec *Vector4d) {
+	v4Convert(result, vec)
+}
+
+func P3dMakeFromP3(result *Point3d, pnt *Point3) {
+	p3Convert(result, pnt)
+}
+
+func P3MakeFromP3d(result *Point3, pnt *Point3d) {
+	p3Convert(result, pnt)
+}
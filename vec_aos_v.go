@@ -0,0 +1,352 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+func (v Vector3Of[T]) Add(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3Add(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) Sub(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3Sub(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) AddP3(pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3AddV3(&result, &pnt1, &v)
+	return result
+}
+
+func (v Vector3Of[T]) Scale(scalar T) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3ScalarMul(&result, &v, scalar)
+	return result
+}
+
+func (v Vector3Of[T]) Div(scalar T) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3ScalarDiv(&result, &v, scalar)
+	return result
+}
+
+func (v Vector3Of[T]) Neg() Vector3Of[T] {
+	var result Vector3Of[T]
+	v3Neg(&result, &v)
+	return result
+}
+
+func (v Vector3Of[T]) MulPerElem(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3MulPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) DivPerElem(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3DivPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) CopySignPerElem(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3CopySignPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) MaxPerElem(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3MaxPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) MinPerElem(vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3MinPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) RecipPerElem() Vector3Of[T] {
+	var result Vector3Of[T]
+	v3RecipPerElem(&result, &v)
+	return result
+}
+
+func (v Vector3Of[T]) SqrtPerElem() Vector3Of[T] {
+	var result Vector3Of[T]
+	v3SqrtPerElem(&result, &v)
+	return result
+}
+
+func (v Vector3Of[T]) RsqrtPerElem() Vector3Of[T] {
+	var result Vector3Of[T]
+	v3RsqrtPerElem(&result, &v)
+	return result
+}
+
+func (v Vector3Of[T]) AbsPerElem() Vector3Of[T] {
+	var result Vector3Of[T]
+	v3AbsPerElem(&result, &v)
+	return result
+}
+
+func (v Vector3Of[T]) Normalize() Vector3Of[T] {
+	lenInv := 1.0 / sqrt(v.LengthSqr())
+	return Vector3Of[T]{X: v.X * lenInv, Y: v.Y * lenInv, Z: v.Z * lenInv}
+}
+
+func (v Vector3Of[T]) Lerp(t T, vec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3Lerp(&result, t, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) Slerp(t T, unitVec1 Vector3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3Slerp(&result, t, &v, &unitVec1)
+	return result
+}
+
+func (v Vector3Of[T]) Cross(vec1 Vector3Of[T]) Vector3Of[T] {
+	return Vector3Of[T]{
+		X: v.Y*vec1.Z - v.Z*vec1.Y,
+		Y: v.Z*vec1.X - v.X*vec1.Z,
+		Z: v.X*vec1.Y - v.Y*vec1.X,
+	}
+}
+
+func (v Vector3Of[T]) Outer(vec1 Vector3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	v3Outer(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector3Of[T]) RowMul(mat Matrix3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	v3RowMul(&result, &v, &mat)
+	return result
+}
+
+func (v Vector3Of[T]) CrossMatrix() Matrix3Of[T] {
+	var result Matrix3Of[T]
+	v3CrossMatrix(&result, &v)
+	return result
+}
+
+func (v Vector3Of[T]) CrossMatrixMul(mat Matrix3Of[T]) Matrix3Of[T] {
+	var result Matrix3Of[T]
+	v3CrossMatrixMul(&result, &v, &mat)
+	return result
+}
+
+/*******/
+
+func (v Vector4Of[T]) Add(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4Add(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) Sub(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4Sub(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) Scale(scalar T) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4ScalarMul(&result, &v, scalar)
+	return result
+}
+
+func (v Vector4Of[T]) Div(scalar T) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4ScalarDiv(&result, &v, scalar)
+	return result
+}
+
+func (v Vector4Of[T]) Neg() Vector4Of[T] {
+	var result Vector4Of[T]
+	v4Neg(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) MulPerElem(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4MulPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) DivPerElem(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4DivPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) CopySignPerElem(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4CopySignPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) MaxPerElem(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4MaxPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) MinPerElem(vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4MinPerElem(&result, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) RecipPerElem() Vector4Of[T] {
+	var result Vector4Of[T]
+	v4RecipPerElem(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) SqrtPerElem() Vector4Of[T] {
+	var result Vector4Of[T]
+	v4SqrtPerElem(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) RsqrtPerElem() Vector4Of[T] {
+	var result Vector4Of[T]
+	v4RsqrtPerElem(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) AbsPerElem() Vector4Of[T] {
+	var result Vector4Of[T]
+	v4AbsPerElem(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) Normalize() Vector4Of[T] {
+	var result Vector4Of[T]
+	v4Normalize(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) Lerp(t T, vec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4Lerp(&result, t, &v, &vec1)
+	return result
+}
+
+func (v Vector4Of[T]) Slerp(t T, unitVec1 Vector4Of[T]) Vector4Of[T] {
+	var result Vector4Of[T]
+	v4Slerp(&result, t, &v, &unitVec1)
+	return result
+}
+
+func (v Vector4Of[T]) XYZ() Vector3Of[T] {
+	var result Vector3Of[T]
+	v4GetXYZ(&result, &v)
+	return result
+}
+
+func (v Vector4Of[T]) Outer(vec1 Vector4Of[T]) Matrix4Of[T] {
+	var result Matrix4Of[T]
+	v4Outer(&result, &v, &vec1)
+	return result
+}
+
+/*******/
+
+func (p Point3Of[T]) Sub(pnt1 Point3Of[T]) Vector3Of[T] {
+	var result Vector3Of[T]
+	p3Sub(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point3Of[T]) AddV3(vec1 Vector3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3AddV3(&result, &p, &vec1)
+	return result
+}
+
+func (p Point3Of[T]) SubV3(vec1 Vector3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3SubV3(&result, &p, &vec1)
+	return result
+}
+
+func (p Point3Of[T]) MulPerElem(pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3MulPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point3Of[T]) DivPerElem(pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3DivPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point3Of[T]) CopySignPerElem(pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3CopySignPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point3Of[T]) MaxPerElem(pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3MaxPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point3Of[T]) MinPerElem(pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3MinPerElem(&result, &p, &pnt1)
+	return result
+}
+
+func (p Point3Of[T]) RecipPerElem() Point3Of[T] {
+	var result Point3Of[T]
+	p3RecipPerElem(&result, &p)
+	return result
+}
+
+func (p Point3Of[T]) SqrtPerElem() Point3Of[T] {
+	var result Point3Of[T]
+	p3SqrtPerElem(&result, &p)
+	return result
+}
+
+func (p Point3Of[T]) RsqrtPerElem() Point3Of[T] {
+	var result Point3Of[T]
+	p3RsqrtPerElem(&result, &p)
+	return result
+}
+
+func (p Point3Of[T]) AbsPerElem() Point3Of[T] {
+	var result Point3Of[T]
+	p3AbsPerElem(&result, &p)
+	return result
+}
+
+func (p Point3Of[T]) Scale(scaleVal T) Point3Of[T] {
+	var result Point3Of[T]
+	p3Scale(&result, &p, scaleVal)
+	return result
+}
+
+func (p Point3Of[T]) NonUniformScale(scaleVec Vector3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3NonUniformScale(&result, &p, &scaleVec)
+	return result
+}
+
+func (p Point3Of[T]) Lerp(t T, pnt1 Point3Of[T]) Point3Of[T] {
+	var result Point3Of[T]
+	p3Lerp(&result, t, &p, &pnt1)
+	return result
+}
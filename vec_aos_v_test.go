@@ -0,0 +1,108 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "testing"
+
+func TestV3ValueMethods(t *testing.T) {
+	var vec0, vec1, vec2, tmp, want Vector3
+	V3MakeFromElems(&vec0, 1.0, 2.0, 3.0)
+	V3MakeFromElems(&vec1, -4.0, 0.5, 2.0)
+	V3MakeFromElems(&vec2, 0.0, 1.0, -1.0)
+
+	got := vec0.Add(vec1.Scale(2.0)).Cross(vec2).Normalize()
+	V3ScalarMul(&tmp, &vec1, 2.0)
+	V3Add(&tmp, &vec0, &tmp)
+	V3Cross(&tmp, &tmp, &vec2)
+	V3Normalize(&want, &tmp)
+	if !v3Near(&got, &want, 1e-6) {
+		t.Errorf("value chain = %v, pointer functions give %v", got, want)
+	}
+	if got, want := vec0.Dot(&vec1), V3Dot(&vec0, &vec1); got != want {
+		t.Errorf("vec0.Dot(&vec1) = %v, want %v", got, want)
+	}
+	if got := vec0.Sub(vec0); got != (Vector3{}) {
+		t.Errorf("vec0.Sub(vec0) = %v, want zero", got)
+	}
+}
+
+// The methods the pointer API already had keep their pointer arguments, so
+// that code written against it still compiles.
+var (
+	_ func(*Vector3) float32 = Vector3{}.Dot
+	_ func(*Vector4) float32 = Vector4{}.Dot
+	_ func(*Quat) float32    = Quat{}.Dot
+	_ func(*Vector3) float32 = Point3{}.Projection
+	_ func(*Point3) float32  = Point3{}.DistSqr
+	_ func(*Point3) float32  = Point3{}.Dist
+)
+
+func TestP3ValueMethods(t *testing.T) {
+	var pnt0, pnt1 Point3
+	var vec Vector3
+	P3MakeFromElems(&pnt0, 1.0, 1.0, 1.0)
+	P3MakeFromElems(&pnt1, 4.0, 5.0, 1.0)
+	V3MakeFromElems(&vec, 3.0, 4.0, 0.0)
+	if got := pnt0.Dist(&pnt1); got != 5.0 {
+		t.Errorf("pnt0.Dist(&pnt1) = %v, want 5", got)
+	}
+	if got := pnt0.AddV3(vec); got != pnt1 {
+		t.Errorf("pnt0.AddV3(vec) = %v, want %v", got, pnt1)
+	}
+	if got := pnt1.Sub(pnt0); got != vec {
+		t.Errorf("pnt1.Sub(pnt0) = %v, want %v", got, vec)
+	}
+	if got := pnt0.DistSqr(&pnt1); got != 25.0 {
+		t.Errorf("pnt0.DistSqr(&pnt1) = %v, want 25", got)
+	}
+	unitVec := vec.Normalize()
+	if got := pnt1.Projection(&unitVec); !near(got, 6.4, 1e-6) {
+		t.Errorf("pnt1.Projection = %v, want 6.4", got)
+	}
+}
+
+func TestV3ValueMethodsDoNotAllocate(t *testing.T) {
+	var vec0, vec1 Vector3
+	V3MakeFromElems(&vec0, 1.0, 2.0, 3.0)
+	V3MakeFromElems(&vec1, -4.0, 0.5, 2.0)
+	allocs := testing.AllocsPerRun(100, func() {
+		vec0 = vec0.Add(vec1.Scale(0.5)).Cross(vec1).Normalize()
+	})
+	if allocs != 0 {
+		t.Errorf("value chain allocates %v times per run", allocs)
+	}
+}
+
+// Each value benchmark has a pointer counterpart. Neither allocates. The
+// vector methods are inlined, as building with -gcflags=-m shows, and run
+// at the same speed as the pointer functions. The matrix and quaternion
+// methods in mat_aos_v_test.go and quat_aos_v_test.go exceed the inlining
+// budget and pay for copying their arguments.
+
+var benchV3 Vector3
+
+func BenchmarkV3ValueChain(b *testing.B) {
+	var vec0, vec1 Vector3
+	V3MakeFromElems(&vec0, 1.0, 2.0, 3.0)
+	V3MakeFromElems(&vec1, -4.0, 0.5, 2.0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		vec0 = vec0.Add(vec1.Scale(0.5)).Cross(vec1).Normalize()
+	}
+	benchV3 = vec0
+}
+
+func BenchmarkV3PointerChain(b *testing.B) {
+	var vec0, vec1, tmp Vector3
+	V3MakeFromElems(&vec0, 1.0, 2.0, 3.0)
+	V3MakeFromElems(&vec1, -4.0, 0.5, 2.0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		V3ScalarMul(&tmp, &vec1, 0.5)
+		V3Add(&tmp, &vec0, &tmp)
+		V3Cross(&tmp, &tmp, &vec1)
+		V3Normalize(&vec0, &tmp)
+	}
+	benchV3 = vec0
+}
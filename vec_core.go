@@ -0,0 +1,886 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import "fmt"
+
+const g_SLERP_TOL = 0.999
+
+func v3Copy[T Float](result *Vector3Of[T], vec *Vector3Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+}
+
+func v3MakeFromElems[T Float](result *Vector3Of[T], x, y, z T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+}
+
+func v3MakeFromP3[T Float](result *Vector3Of[T], pnt *Point3Of[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+	result.Z = pnt.Z
+}
+
+func v3MakeFromScalar[T Float](result *Vector3Of[T], scalar T) {
+	result.X = scalar
+	result.Y = scalar
+	result.Z = scalar
+}
+
+func v3MakeXAxis[T Float](result *Vector3Of[T]) {
+	v3MakeFromElems(result, 1.0, 0.0, 0.0)
+}
+
+func v3MakeYAxis[T Float](result *Vector3Of[T]) {
+	v3MakeFromElems(result, 0.0, 1.0, 0.0)
+}
+
+func v3MakeZAxis[T Float](result *Vector3Of[T]) {
+	v3MakeFromElems(result, 0.0, 0.0, 1.0)
+}
+
+// v3MakePerpendicular sets result to a unit vector perpendicular to vec,
+// using the coordinate axis least aligned with vec for stability.
+func v3MakePerpendicular[T Float](result, vec *Vector3Of[T]) {
+	var axis Vector3Of[T]
+	x, y, z := abs(vec.X), abs(vec.Y), abs(vec.Z)
+	switch {
+	case x <= y && x <= z:
+		v3MakeXAxis(&axis)
+	case y <= z:
+		v3MakeYAxis(&axis)
+	default:
+		v3MakeZAxis(&axis)
+	}
+	v3Cross(&axis, vec, &axis)
+	v3Normalize(result, &axis)
+}
+
+func v3Lerp[T Float](result *Vector3Of[T], t T, vec0, vec1 *Vector3Of[T]) {
+	var tmpV3_0, tmpV3_1 Vector3Of[T]
+	v3Sub(&tmpV3_0, vec1, vec0)
+	v3ScalarMul(&tmpV3_1, &tmpV3_0, t)
+	v3Add(result, vec0, &tmpV3_1)
+}
+
+func v3Slerp[T Float](result *Vector3Of[T], t T, unitVec0, unitVec1 *Vector3Of[T]) {
+	var tmpV3_0, tmpV3_1 Vector3Of[T]
+	var scale0, scale1 T
+	cosAngle := v3Dot(unitVec0, unitVec1)
+	if cosAngle < g_SLERP_TOL {
+		angle := acos(cosAngle)
+		recipSinAngle := 1.0 / sin(angle)
+		scale0 = (sin(((1.0 - t) * angle)) * recipSinAngle)
+		scale1 = (sin((t * angle)) * recipSinAngle)
+	} else {
+		scale0 = 1.0 - t
+		scale1 = t
+	}
+	v3ScalarMul(&tmpV3_0, unitVec0, scale0)
+	v3ScalarMul(&tmpV3_1, unitVec1, scale1)
+	v3Add(result, &tmpV3_0, &tmpV3_1)
+}
+
+func (v *Vector3Of[T]) SetX(x T) {
+	v.X = x
+}
+
+func (v *Vector3Of[T]) SetY(y T) {
+	v.Y = y
+}
+
+func (v *Vector3Of[T]) SetZ(z T) {
+	v.Z = z
+}
+
+func (v *Vector3Of[T]) SetElem(index int, value T) {
+	switch index {
+	case 0:
+		v.X = value
+	case 1:
+		v.Y = value
+	case 2:
+		v.Z = value
+	}
+}
+
+func (v *Vector3Of[T]) GetElem(index int) T {
+	switch index {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	case 2:
+		return v.Z
+	}
+	return 0
+}
+
+func v3Add[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	result.X = vec0.X + vec1.X
+	result.Y = vec0.Y + vec1.Y
+	result.Z = vec0.Z + vec1.Z
+}
+
+func v3Sub[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	result.X = vec0.X - vec1.X
+	result.Y = vec0.Y - vec1.Y
+	result.Z = vec0.Z - vec1.Z
+}
+
+func v3AddP3[T Float](result, vec0 *Vector3Of[T], pnt1 *Point3Of[T]) {
+	result.X = vec0.X + pnt1.X
+	result.Y = vec0.Y + pnt1.Y
+	result.Z = vec0.Z + pnt1.Z
+}
+
+func v3ScalarMul[T Float](result, vec *Vector3Of[T], scalar T) {
+	result.X = vec.X * scalar
+	result.Y = vec.Y * scalar
+	result.Z = vec.Z * scalar
+}
+
+func v3ScalarDiv[T Float](result, vec *Vector3Of[T], scalar T) {
+	result.X = vec.X / scalar
+	result.Y = vec.Y / scalar
+	result.Z = vec.Z / scalar
+}
+
+func v3Neg[T Float](result, vec *Vector3Of[T]) {
+	result.X = -vec.X
+	result.Y = -vec.Y
+	result.Z = -vec.Z
+}
+
+func v3MulPerElem[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	result.X = vec0.X * vec1.X
+	result.Y = vec0.Y * vec1.Y
+	result.Z = vec0.Z * vec1.Z
+}
+
+func v3DivPerElem[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	result.X = vec0.X / vec1.X
+	result.Y = vec0.Y / vec1.Y
+	result.Z = vec0.Z / vec1.Z
+}
+
+func v3RecipPerElem[T Float](result, vec *Vector3Of[T]) {
+	result.X = 1.0 / vec.X
+	result.Y = 1.0 / vec.Y
+	result.Z = 1.0 / vec.Z
+}
+
+func v3SqrtPerElem[T Float](result, vec *Vector3Of[T]) {
+	result.X = sqrt(vec.X)
+	result.Y = sqrt(vec.Y)
+	result.Z = sqrt(vec.Z)
+}
+
+func v3RsqrtPerElem[T Float](result, vec *Vector3Of[T]) {
+	result.X = 1.0 / sqrt(vec.X)
+	result.Y = 1.0 / sqrt(vec.Y)
+	result.Z = 1.0 / sqrt(vec.Z)
+}
+
+func v3AbsPerElem[T Float](result, vec *Vector3Of[T]) {
+	result.X = abs(vec.X)
+	result.Y = abs(vec.Y)
+	result.Z = abs(vec.Z)
+}
+
+func v3CopySignPerElem[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	if vec1.X < 0.0 {
+		result.X = -abs(vec0.X)
+	} else {
+		result.X = abs(vec0.X)
+	}
+	if vec1.Y < 0.0 {
+		result.Y = -abs(vec0.Y)
+	} else {
+		result.Y = abs(vec0.Y)
+	}
+	if vec1.Z < 0.0 {
+		result.Z = -abs(vec0.Z)
+	} else {
+		result.Z = abs(vec0.Z)
+	}
+}
+
+func v3MaxPerElem[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	result.X = max(vec0.X, vec1.X)
+	result.Y = max(vec0.Y, vec1.Y)
+	result.Z = max(vec0.Z, vec1.Z)
+}
+
+func (v Vector3Of[T]) MaxElem() T {
+	var result T
+	result = max(v.X, v.Y)
+	result = max(v.Z, result)
+	return result
+}
+
+func v3MinPerElem[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	result.X = min(vec0.X, vec1.X)
+	result.Y = min(vec0.Y, vec1.Y)
+	result.Z = min(vec0.Z, vec1.Z)
+}
+
+func (v Vector3Of[T]) MinElem() T {
+	var result T
+	result = min(v.X, v.Y)
+	result = min(v.Z, result)
+	return result
+}
+
+func (v Vector3Of[T]) Sum() T {
+	var result T
+	result = v.X + v.Y + v.Z
+	return result
+}
+
+func v3Dot[T Float](vec0, vec1 *Vector3Of[T]) T {
+	result := vec0.X * vec1.X
+	result += vec0.Y * vec1.Y
+	result += vec0.Z * vec1.Z
+	return result
+}
+
+func (v Vector3Of[T]) Dot(vec1 *Vector3Of[T]) T {
+	result := v.X * vec1.X
+	result += v.Y * vec1.Y
+	result += v.Z * vec1.Z
+	return result
+}
+
+func (v Vector3Of[T]) LengthSqr() T {
+	result := v.X * v.X
+	result += v.Y * v.Y
+	result += v.Z * v.Z
+	return result
+}
+
+func (v Vector3Of[T]) Length() T {
+	return sqrt(v.LengthSqr())
+}
+
+func v3Normalize[T Float](result, vec *Vector3Of[T]) {
+	lenSqr := vec.LengthSqr()
+	lenInv := 1.0 / sqrt(lenSqr)
+	result.X = vec.X * lenInv
+	result.Y = vec.Y * lenInv
+	result.Z = vec.Z * lenInv
+}
+
+func v3Cross[T Float](result, vec0, vec1 *Vector3Of[T]) {
+	tmpX := vec0.Y*vec1.Z - vec0.Z*vec1.Y
+	tmpY := vec0.Z*vec1.X - vec0.X*vec1.Z
+	tmpZ := vec0.X*vec1.Y - vec0.Y*vec1.X
+	v3MakeFromElems(result, tmpX, tmpY, tmpZ)
+}
+
+func v3Select[T Float](result, vec0, vec1 *Vector3Of[T], select1 int) {
+	if select1 != 0 {
+		result.X = vec1.X
+		result.Y = vec1.Y
+		result.Z = vec1.Z
+	} else {
+		result.X = vec0.X
+		result.Y = vec0.Y
+		result.Z = vec0.Z
+	}
+}
+
+func (v *Vector3Of[T]) String() string {
+	return fmt.Sprintf("( %f %f %f )\n", v.X, v.Y, v.Z)
+}
+
+/*******/
+
+func v4Copy[T Float](result, vec *Vector4Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+	result.W = vec.W
+}
+
+func v4MakeFromElems[T Float](result *Vector4Of[T], x, y, z, w T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+	result.W = w
+}
+
+func v4MakeFromV3Scalar[T Float](result *Vector4Of[T], xyz *Vector3Of[T], w T) {
+	result.SetXYZ(xyz)
+	result.SetW(w)
+}
+
+func v4MakeFromV3[T Float](result *Vector4Of[T], vec *Vector3Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+	result.W = 0.0
+}
+
+func v4MakeFromP3[T Float](result *Vector4Of[T], pnt *Point3Of[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+	result.Z = pnt.Z
+	result.W = 1.0
+}
+
+func v4MakeFromQ[T Float](result *Vector4Of[T], quat *QuatOf[T]) {
+	result.X = quat.X
+	result.Y = quat.Y
+	result.Z = quat.Z
+	result.W = quat.W
+}
+
+func v4MakeFromScalar[T Float](result *Vector4Of[T], scalar T) {
+	result.X = scalar
+	result.Y = scalar
+	result.Z = scalar
+	result.W = scalar
+}
+
+func v4MakeXAxis[T Float](result *Vector4Of[T]) {
+	v4MakeFromElems(result, 1.0, 0.0, 0.0, 0.0)
+}
+
+func v4MakeYAxis[T Float](result *Vector4Of[T]) {
+	v4MakeFromElems(result, 0.0, 1.0, 0.0, 0.0)
+}
+
+func v4MakeZAxis[T Float](result *Vector4Of[T]) {
+	v4MakeFromElems(result, 0.0, 0.0, 1.0, 0.0)
+}
+
+func v4MakeWAxis[T Float](result *Vector4Of[T]) {
+	v4MakeFromElems(result, 0.0, 0.0, 0.0, 1.0)
+}
+
+func v4Lerp[T Float](result *Vector4Of[T], t T, vec0, vec1 *Vector4Of[T]) {
+	var tmpV4_0, tmpV4_1 Vector4Of[T]
+	v4Sub(&tmpV4_0, vec1, vec0)
+	v4ScalarMul(&tmpV4_1, &tmpV4_0, t)
+	v4Add(result, vec0, &tmpV4_1)
+}
+
+func v4Slerp[T Float](result *Vector4Of[T], t T, unitVec0, unitVec1 *Vector4Of[T]) {
+	var tmpV4_0, tmpV4_1 Vector4Of[T]
+	var scale0, scale1 T
+	cosAngle := v4Dot(unitVec0, unitVec1)
+	if cosAngle < g_SLERP_TOL {
+		angle := acos(cosAngle)
+		recipSinAngle := (1.0 / sin(angle))
+		scale0 = (sin(((1.0 - t) * angle)) * recipSinAngle)
+		scale1 = (sin((t * angle)) * recipSinAngle)
+	} else {
+		scale0 = (1.0 - t)
+		scale1 = t
+	}
+	v4ScalarMul(&tmpV4_0, unitVec0, scale0)
+	v4ScalarMul(&tmpV4_1, unitVec1, scale1)
+	v4Add(result, &tmpV4_0, &tmpV4_1)
+}
+
+func (v *Vector4Of[T]) SetXYZ(vec *Vector3Of[T]) {
+	v.X = vec.X
+	v.Y = vec.Y
+	v.Z = vec.Z
+}
+
+func v4GetXYZ[T Float](result *Vector3Of[T], vec *Vector4Of[T]) {
+	v3MakeFromElems(result, vec.X, vec.Y, vec.Z)
+}
+
+func (v *Vector4Of[T]) SetX(x T) {
+	v.X = x
+}
+
+func (v *Vector4Of[T]) SetY(y T) {
+	v.Y = y
+}
+
+func (v *Vector4Of[T]) SetZ(z T) {
+	v.Z = z
+}
+
+func (v *Vector4Of[T]) SetW(w T) {
+	v.W = w
+}
+
+func (v *Vector4Of[T]) SetElem(index int, value T) {
+	switch index {
+	case 0:
+		v.X = value
+	case 1:
+		v.Y = value
+	case 2:
+		v.Z = value
+	case 3:
+		v.W = value
+	}
+}
+
+func (v *Vector4Of[T]) GetElem(index int) T {
+	switch index {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	case 2:
+		return v.Z
+	case 3:
+		return v.W
+	}
+	return 0
+}
+
+func v4Add[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	result.X = vec0.X + vec1.X
+	result.Y = vec0.Y + vec1.Y
+	result.Z = vec0.Z + vec1.Z
+	result.W = vec0.W + vec1.W
+}
+
+func v4Sub[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	result.X = vec0.X - vec1.X
+	result.Y = vec0.Y - vec1.Y
+	result.Z = vec0.Z - vec1.Z
+	result.W = vec0.W - vec1.W
+}
+
+func v4ScalarMul[T Float](result, vec *Vector4Of[T], scalar T) {
+	result.X = vec.X * scalar
+	result.Y = vec.Y * scalar
+	result.Z = vec.Z * scalar
+	result.W = vec.W * scalar
+}
+
+func v4ScalarDiv[T Float](result, vec *Vector4Of[T], scalar T) {
+	result.X = vec.X / scalar
+	result.Y = vec.Y / scalar
+	result.Z = vec.Z / scalar
+	result.W = vec.W / scalar
+}
+
+func v4Neg[T Float](result, vec *Vector4Of[T]) {
+	result.X = -vec.X
+	result.Y = -vec.Y
+	result.Z = -vec.Z
+	result.W = -vec.W
+}
+
+func v4MulPerElem[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	result.X = vec0.X * vec1.X
+	result.Y = vec0.Y * vec1.Y
+	result.Z = vec0.Z * vec1.Z
+	result.W = vec0.W * vec1.W
+}
+
+func v4DivPerElem[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	result.X = vec0.X / vec1.X
+	result.Y = vec0.Y / vec1.Y
+	result.Z = vec0.Z / vec1.Z
+	result.W = vec0.W / vec1.W
+}
+
+func v4RecipPerElem[T Float](result, vec *Vector4Of[T]) {
+	result.X = 1.0 / vec.X
+	result.Y = 1.0 / vec.Y
+	result.Z = 1.0 / vec.Z
+	result.W = 1.0 / vec.W
+}
+
+func v4SqrtPerElem[T Float](result, vec *Vector4Of[T]) {
+	result.X = sqrt(vec.X)
+	result.Y = sqrt(vec.Y)
+	result.Z = sqrt(vec.Z)
+	result.W = sqrt(vec.W)
+}
+
+func v4RsqrtPerElem[T Float](result, vec *Vector4Of[T]) {
+	result.X = 1.0 / sqrt(vec.X)
+	result.Y = 1.0 / sqrt(vec.Y)
+	result.Z = 1.0 / sqrt(vec.Z)
+	result.W = 1.0 / sqrt(vec.W)
+}
+
+func v4AbsPerElem[T Float](result, vec *Vector4Of[T]) {
+	result.X = abs(vec.X)
+	result.Y = abs(vec.Y)
+	result.Z = abs(vec.Z)
+	result.W = abs(vec.W)
+}
+
+func v4CopySignPerElem[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	if vec1.X < 0.0 {
+		result.X = -abs(vec0.X)
+	} else {
+		result.X = abs(vec0.X)
+	}
+	if vec1.Y < 0.0 {
+		result.Y = -abs(vec0.Y)
+	} else {
+		result.Y = abs(vec0.Y)
+	}
+	if vec1.Z < 0.0 {
+		result.Z = -abs(vec0.Z)
+	} else {
+		result.Z = abs(vec0.Z)
+	}
+	if vec1.W < 0.0 {
+		result.W = -abs(vec0.W)
+	} else {
+		result.W = abs(vec0.W)
+	}
+}
+
+func v4MaxPerElem[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	result.X = max(vec0.X, vec1.X)
+	result.Y = max(vec0.Y, vec1.Y)
+	result.Z = max(vec0.Z, vec1.Z)
+	result.W = max(vec0.W, vec1.W)
+}
+
+func (v Vector4Of[T]) MaxElem() T {
+	var result T
+	result = max(v.X, v.Y)
+	result = max(v.Z, result)
+	result = max(v.W, result)
+	return result
+}
+
+func v4MinPerElem[T Float](result, vec0, vec1 *Vector4Of[T]) {
+	result.X = min(vec0.X, vec1.X)
+	result.Y = min(vec0.Y, vec1.Y)
+	result.Z = min(vec0.Z, vec1.Z)
+	result.W = min(vec0.W, vec1.W)
+}
+
+func (v Vector4Of[T]) MinElem() T {
+	var result T
+	result = min(v.X, v.Y)
+	result = min(v.Z, result)
+	result = min(v.W, result)
+	return result
+}
+
+func (v Vector4Of[T]) Sum() T {
+	var result T
+	result = v.X + v.Y + v.Z + v.W
+	return result
+}
+
+func v4Dot[T Float](vec0, vec1 *Vector4Of[T]) T {
+	result := vec0.X * vec1.X
+	result += vec0.Y * vec1.Y
+	result += vec0.Z * vec1.Z
+	result += vec0.W * vec1.W
+	return result
+}
+
+func (v Vector4Of[T]) Dot(vec1 *Vector4Of[T]) T {
+	result := v.X * vec1.X
+	result += v.Y * vec1.Y
+	result += v.Z * vec1.Z
+	result += v.W * vec1.W
+	return result
+}
+
+func (v Vector4Of[T]) LengthSqr() T {
+	result := v.X * v.X
+	result += v.Y * v.Y
+	result += v.Z * v.Z
+	result += v.W * v.W
+	return result
+}
+
+func (v Vector4Of[T]) Length() T {
+	return sqrt(v.LengthSqr())
+}
+
+func v4Normalize[T Float](result, vec *Vector4Of[T]) {
+	lenSqr := vec.LengthSqr()
+	lenInv := 1.0 / sqrt(lenSqr)
+	result.X = vec.X * lenInv
+	result.Y = vec.Y * lenInv
+	result.Z = vec.Z * lenInv
+	result.W = vec.W * lenInv
+}
+
+func v4Select[T Float](result, vec0, vec1 *Vector4Of[T], select1 int) {
+	if select1 != 0 {
+		result.X = vec1.X
+		result.Y = vec1.Y
+		result.Z = vec1.Z
+		result.W = vec1.W
+	} else {
+		result.X = vec0.X
+		result.Y = vec0.Y
+		result.Z = vec0.Z
+		result.W = vec0.W
+	}
+}
+
+func (v *Vector4Of[T]) String() string {
+	return fmt.Sprintf("( %f %f %f %f )", v.X, v.Y, v.Z, v.W)
+}
+
+/*******/
+
+func p3Copy[T Float](result, pnt *Point3Of[T]) {
+	result.X = pnt.X
+	result.Y = pnt.Y
+	result.Z = pnt.Z
+}
+
+func p3MakeFromElems[T Float](result *Point3Of[T], x, y, z T) {
+	result.X = x
+	result.Y = y
+	result.Z = z
+}
+
+func p3MakeFromV3[T Float](result *Point3Of[T], vec *Vector3Of[T]) {
+	result.X = vec.X
+	result.Y = vec.Y
+	result.Z = vec.Z
+}
+
+func p3MakeFromScalar[T Float](result *Point3Of[T], scalar T) {
+	result.X = scalar
+	result.Y = scalar
+	result.Z = scalar
+}
+
+func p3Lerp[T Float](result *Point3Of[T], t T, pnt0, pnt1 *Point3Of[T]) {
+	var tmpV3_0, tmpV3_1 Vector3Of[T]
+	p3Sub(&tmpV3_0, pnt1, pnt0)
+	v3ScalarMul(&tmpV3_1, &tmpV3_0, t)
+	p3AddV3(result, pnt0, &tmpV3_1)
+}
+
+func (p *Point3Of[T]) SetX(x T) {
+	p.X = x
+}
+
+func (p *Point3Of[T]) SetY(y T) {
+	p.Y = y
+}
+
+func (p *Point3Of[T]) SetZ(z T) {
+	p.Z = z
+}
+
+func (p *Point3Of[T]) SetElem(index int, value T) {
+	switch index {
+	case 0:
+		p.X = value
+	case 1:
+		p.Y = value
+	case 2:
+		p.Z = value
+	}
+}
+
+func (p *Point3Of[T]) GetElem(index int) T {
+	switch index {
+	case 0:
+		return p.X
+	case 1:
+		return p.Y
+	case 2:
+		return p.Z
+	}
+	return 0
+}
+
+func p3Sub[T Float](result *Vector3Of[T], pnt0, pnt1 *Point3Of[T]) {
+	result.X = pnt0.X - pnt1.X
+	result.Y = pnt0.Y - pnt1.Y
+	result.Z = pnt0.Z - pnt1.Z
+}
+
+func p3AddV3[T Float](result, pnt0 *Point3Of[T], vec1 *Vector3Of[T]) {
+	result.X = pnt0.X + vec1.X
+	result.Y = pnt0.Y + vec1.Y
+	result.Z = pnt0.Z + vec1.Z
+}
+
+func p3SubV3[T Float](result, pnt0 *Point3Of[T], vec1 *Vector3Of[T]) {
+	result.X = pnt0.X - vec1.X
+	result.Y = pnt0.Y - vec1.Y
+	result.Z = pnt0.Z - vec1.Z
+}
+
+func p3MulPerElem[T Float](result, pnt0, pnt1 *Point3Of[T]) {
+	result.X = pnt0.X * pnt1.X
+	result.Y = pnt0.Y * pnt1.Y
+	result.Z = pnt0.Z * pnt1.Z
+}
+
+func p3DivPerElem[T Float](result, pnt0, pnt1 *Point3Of[T]) {
+	result.X = pnt0.X / pnt1.X
+	result.Y = pnt0.Y / pnt1.Y
+	result.Z = pnt0.Z / pnt1.Z
+}
+
+func p3RecipPerElem[T Float](result, pnt *Point3Of[T]) {
+	result.X = 1.0 / pnt.X
+	result.Y = 1.0 / pnt.Y
+	result.Z = 1.0 / pnt.Z
+}
+
+func p3SqrtPerElem[T Float](result, pnt *Point3Of[T]) {
+	result.X = sqrt(pnt.X)
+	result.Y = sqrt(pnt.Y)
+	result.Z = sqrt(pnt.Z)
+}
+
+func p3RsqrtPerElem[T Float](result, pnt *Point3Of[T]) {
+	result.X = 1.0 / sqrt(pnt.X)
+	result.Y = 1.0 / sqrt(pnt.Y)
+	result.Z = 1.0 / sqrt(pnt.Z)
+}
+
+func p3AbsPerElem[T Float](result, pnt *Point3Of[T]) {
+	result.X = abs(pnt.X)
+	result.Y = abs(pnt.Y)
+	result.Z = abs(pnt.Z)
+}
+
+func p3CopySignPerElem[T Float](result, pnt0, pnt1 *Point3Of[T]) {
+	if pnt1.X < 0.0 {
+		result.X = -abs(pnt0.X)
+	} else {
+		result.X = abs(pnt0.X)
+	}
+	if pnt1.Y < 0.0 {
+		result.Y = -abs(pnt0.Y)
+	} else {
+		result.Y = abs(pnt0.Y)
+	}
+	if pnt1.Z < 0.0 {
+		result.Z = -abs(pnt0.Z)
+	} else {
+		result.Z = abs(pnt0.Z)
+	}
+}
+
+func p3MaxPerElem[T Float](result, pnt0, pnt1 *Point3Of[T]) {
+	result.X = max(pnt0.X, pnt1.X)
+	result.Y = max(pnt0.Y, pnt1.Y)
+	result.Z = max(pnt0.Z, pnt1.Z)
+}
+
+func (p Point3Of[T]) MaxElem() T {
+	var result T
+	result = max(p.X, p.Y)
+	result = max(p.Z, result)
+	return result
+}
+
+func p3MinPerElem[T Float](result, pnt0, pnt1 *Point3Of[T]) {
+	result.X = min(pnt0.X, pnt1.X)
+	result.Y = min(pnt0.Y, pnt1.Y)
+	result.Z = min(pnt0.Z, pnt1.Z)
+}
+
+func (p Point3Of[T]) MinElem() T {
+	var result T
+	result = min(p.X, p.Y)
+	result = min(p.Z, result)
+	return result
+}
+
+func (p Point3Of[T]) Sum() T {
+	var result T
+	result = p.X + p.Y + p.Z
+	return result
+}
+
+func p3Scale[T Float](result, pnt *Point3Of[T], scaleVal T) {
+	var tmpP3_0 Point3Of[T]
+	p3MakeFromScalar(&tmpP3_0, scaleVal)
+	p3MulPerElem(result, pnt, &tmpP3_0)
+}
+
+func p3NonUniformScale[T Float](result, pnt *Point3Of[T], scaleVec *Vector3Of[T]) {
+	var tmpP3_0 Point3Of[T]
+	p3MakeFromV3(&tmpP3_0, scaleVec)
+	p3MulPerElem(result, pnt, &tmpP3_0)
+}
+
+func (p Point3Of[T]) Projection(unitVec *Vector3Of[T]) T {
+	result := p.X * unitVec.X
+	result += p.Y * unitVec.Y
+	result += p.Z * unitVec.Z
+	return result
+}
+
+func (p Point3Of[T]) DistSqrFromOrigin() T {
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromP3(&tmpV3_0, &p)
+	return tmpV3_0.LengthSqr()
+}
+
+func (p Point3Of[T]) DistFromOrigin() T {
+	var tmpV3_0 Vector3Of[T]
+	v3MakeFromP3(&tmpV3_0, &p)
+	return tmpV3_0.Length()
+}
+
+func (p Point3Of[T]) DistSqr(pnt1 *Point3Of[T]) T {
+	var tmpV3_0 Vector3Of[T]
+	p3Sub(&tmpV3_0, pnt1, &p)
+	return tmpV3_0.LengthSqr()
+}
+
+func (p Point3Of[T]) Dist(pnt1 *Point3Of[T]) T {
+	var tmpV3_0 Vector3Of[T]
+	p3Sub(&tmpV3_0, pnt1, &p)
+	return tmpV3_0.Length()
+}
+
+func p3Select[T Float](result, pnt0, pnt1 *Point3Of[T], select1 int) {
+	if select1 != 0 {
+		result.X = pnt1.X
+		result.Y = pnt1.Y
+		result.Z = pnt1.Z
+	} else {
+		result.X = pnt0.X
+		result.Y = pnt0.Y
+		result.Z = pnt0.Z
+	}
+}
+
+func (p *Point3Of[T]) String() string {
+	return fmt.Sprintf("( %f %f %f )", p.X, p.Y, p.Z)
+}
+
+/*******/
+
+func v3Convert[T, U Float](result *Vector3Of[T], vec *Vector3Of[U]) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+	result.Z = T(vec.Z)
+}
+
+func v4Convert[T, U Float](result *Vector4Of[T], vec *Vector4Of[U]) {
+	result.X = T(vec.X)
+	result.Y = T(vec.Y)
+	result.Z = T(vec.Z)
+	result.W = T(vec.W)
+}
+
+func p3Convert[T, U Float](result *Point3Of[T], pnt *Point3Of[U]) {
+	result.X = T(pnt.X)
+	result.Y = T(pnt.Y)
+	result.Z = T(pnt.Z)
+}
@@ -4,30 +4,53 @@
 
 package vectormath
 
-type Vector3 struct {
-	X, Y, Z, _ float32
+// Float is the set of element types the generic types and the generic core
+// in vec_core.go, mat_core.go and quat_core.go are instantiated with.
+// Vector3, Vector3d and the other named types are aliases for these
+// instantiations, e.g. Vector3Of[float32] and Vector3Of[float64], so their
+// methods are documented on the generic types.
+type Float interface {
+	~float32 | ~float64
 }
 
-type Vector4 struct {
-	X, Y, Z, W float32
+type Vector3Of[T Float] struct {
+	X, Y, Z, _ T
 }
 
-type Point3 struct {
-	X, Y, Z, _ float32
+type Vector4Of[T Float] struct {
+	X, Y, Z, W T
 }
 
-type Quat struct {
-	X, Y, Z, W float32
+type Point3Of[T Float] struct {
+	X, Y, Z, _ T
 }
 
-type Matrix3 struct {
-	col0, col1, col2 Vector3
+type QuatOf[T Float] struct {
+	X, Y, Z, W T
 }
 
-type Matrix4 struct {
-	col0, col1, col2, col3 Vector4
+type Matrix3Of[T Float] struct {
+	col0, col1, col2 Vector3Of[T]
 }
 
-type Transform3 struct {
-	col0, col1, col2, col3 Vector3
+type Matrix4Of[T Float] struct {
+	col0, col1, col2, col3 Vector4Of[T]
 }
+
+type Transform3Of[T Float] struct {
+	col0, col1, col2, col3 Vector3Of[T]
+}
+
+type Vector3 = Vector3Of[float32]
+
+type Vector4 = Vector4Of[float32]
+
+type Point3 = Point3Of[float32]
+
+type Quat = QuatOf[float32]
+
+type Matrix3 = Matrix3Of[float32]
+
+type Matrix4 = Matrix4Of[float32]
+
+type Transform3 = Transform3Of[float32]
@@ -0,0 +1,19 @@
+// Copyright (c) 2006, 2007 Sony Computer Entertainment Inc.
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Vector3d = Vector3Of[float64]
+
+type Vector4d = Vector4Of[float64]
+
+type Point3d = Point3Of[float64]
+
+type Quatd = QuatOf[float64]
+
+type Matrix3d = Matrix3Of[float64]
+
+type Matrix4d = Matrix4Of[float64]
+
+type Transform3d = Transform3Of[float64]
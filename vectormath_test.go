@@ -0,0 +1,62 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// Comparison helpers shared by the tests. They are generic so that the
+// float32 and float64 tests can use the same checks; a NaN is never near
+// anything.
+
+func near[T Float](a, b, tol T) bool {
+	return abs(a-b) <= tol
+}
+
+func v2Near[T Float](vec0, vec1 *Vector2Of[T], tol T) bool {
+	return near(vec0.X, vec1.X, tol) && near(vec0.Y, vec1.Y, tol)
+}
+
+func p2Near[T Float](pnt0, pnt1 *Point2Of[T], tol T) bool {
+	return near(pnt0.X, pnt1.X, tol) && near(pnt0.Y, pnt1.Y, tol)
+}
+
+func v3Near[T Float](vec0, vec1 *Vector3Of[T], tol T) bool {
+	return near(vec0.X, vec1.X, tol) && near(vec0.Y, vec1.Y, tol) && near(vec0.Z, vec1.Z, tol)
+}
+
+func v4Near[T Float](vec0, vec1 *Vector4Of[T], tol T) bool {
+	return near(vec0.X, vec1.X, tol) && near(vec0.Y, vec1.Y, tol) &&
+		near(vec0.Z, vec1.Z, tol) && near(vec0.W, vec1.W, tol)
+}
+
+func p3Near[T Float](pnt0, pnt1 *Point3Of[T], tol T) bool {
+	return near(pnt0.X, pnt1.X, tol) && near(pnt0.Y, pnt1.Y, tol) && near(pnt0.Z, pnt1.Z, tol)
+}
+
+func qNear[T Float](quat0, quat1 *QuatOf[T], tol T) bool {
+	return near(quat0.X, quat1.X, tol) && near(quat0.Y, quat1.Y, tol) &&
+		near(quat0.Z, quat1.Z, tol) && near(quat0.W, quat1.W, tol)
+}
+
+func m2Near[T Float](mat0, mat1 *Matrix2Of[T], tol T) bool {
+	return v2Near(&mat0.col0, &mat1.col0, tol) && v2Near(&mat0.col1, &mat1.col1, tol)
+}
+
+func m3Near[T Float](mat0, mat1 *Matrix3Of[T], tol T) bool {
+	return v3Near(&mat0.col0, &mat1.col0, tol) && v3Near(&mat0.col1, &mat1.col1, tol) &&
+		v3Near(&mat0.col2, &mat1.col2, tol)
+}
+
+func m4Near[T Float](mat0, mat1 *Matrix4Of[T], tol T) bool {
+	return v4Near(&mat0.col0, &mat1.col0, tol) && v4Near(&mat0.col1, &mat1.col1, tol) &&
+		v4Near(&mat0.col2, &mat1.col2, tol) && v4Near(&mat0.col3, &mat1.col3, tol)
+}
+
+func t3Near[T Float](tfrm0, tfrm1 *Transform3Of[T], tol T) bool {
+	return v3Near(&tfrm0.col0, &tfrm1.col0, tol) && v3Near(&tfrm0.col1, &tfrm1.col1, tol) &&
+		v3Near(&tfrm0.col2, &tfrm1.col2, tol) && v3Near(&tfrm0.col3, &tfrm1.col3, tol)
+}
+
+func t2Near[T Float](tfrm0, tfrm1 *Transform2Of[T], tol T) bool {
+	return v2Near(&tfrm0.col0, &tfrm1.col0, tol) && v2Near(&tfrm0.col1, &tfrm1.col1, tol) &&
+		v2Near(&tfrm0.col2, &tfrm1.col2, tol)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+// Viewport describes the window rectangle and depth range that normalized
+// device coordinates are mapped to.
+type Viewport = ViewportOf[float32]
+
+func ViewportMake(result *Viewport, x, y, width, height, minDepth, maxDepth float32) {
+	viewportMake(result, x, y, width, height, minDepth, maxDepth)
+}
+
+// M4MakeViewport makes the matrix taking normalized device coordinates, for a
+// projection built for clip, to the window coordinates of vp.
+func M4MakeViewport(result *Matrix4, vp *Viewport, clip ClipSpace) {
+	m4MakeViewport(result, vp, clip)
+}
+
+/*******/
+
+// P3Project transforms pnt by viewProj and then into the window coordinates
+// of vp, with z the window depth. It returns false, leaving result unchanged,
+// if pnt is on or behind the plane of the eye, which has no projection.
+// Window y increases upwards unless viewProj was built with ClipFlipY; with
+// a top-left origin for an unflipped projection, use vp.Y+vp.Height-y.
+func P3Project(result, pnt *Point3, viewProj *Matrix4, vp *Viewport, clip ClipSpace) bool {
+	return p3Project(result, pnt, viewProj, vp, clip)
+}
+
+// P3Unproject maps window coordinates and depth back through viewProj,
+// inverting P3Project. It returns false, leaving result unchanged, if viewProj
+// is singular or win is at or too near infinity to locate: where the
+// homogeneous w is lost in rounding error, as on or a few ulps short of the
+// far plane of an infinite projection, or where the point would overflow.
+// It inverts viewProj on each call; see P3UnprojectWithInverse.
+func P3Unproject(result, win *Point3, viewProj *Matrix4, vp *Viewport, clip ClipSpace) bool {
+	return p3Unproject(result, win, viewProj, vp, clip)
+}
+
+// P3UnprojectWithInverse is P3Unproject given the inverse of the
+// view-projection matrix, for unprojecting many points.
+func P3UnprojectWithInverse(result, win *Point3, invViewProj *Matrix4, vp *Viewport, clip ClipSpace) bool {
+	return p3UnprojectWithInverse(result, win, invViewProj, vp, clip)
+}
+
+// RayMakeFromViewport builds a picking ray through the window position
+// (winX, winY), such as the mouse position, in the same window coordinates as
+// P3Project. The ray starts on the near plane, and its direction is unit
+// length, so hit distances are measured from the near plane. It returns false,
+// leaving result unchanged, if viewProj is singular.
+func RayMakeFromViewport(result *Ray, winX, winY float32, viewProj *Matrix4, vp *Viewport, clip ClipSpace) bool {
+	return rayMakeFromViewport(result, winX, winY, viewProj, vp, clip)
+}
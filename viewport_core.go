@@ -0,0 +1,139 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"fmt"
+	"math"
+)
+
+// ViewportOf maps normalized device coordinates to window coordinates: x and
+// y from [-1, 1] to [X, X+Width] and [Y, Y+Height], and depth from the
+// clip space's depth range to [MinDepth, MaxDepth]. Window y points the
+// same way as clip-space y.
+type ViewportOf[T Float] struct {
+	X, Y, Width, Height T
+	MinDepth, MaxDepth  T
+}
+
+func viewportMake[T Float](result *ViewportOf[T], x, y, width, height, minDepth, maxDepth T) {
+	result.X = x
+	result.Y = y
+	result.Width = width
+	result.Height = height
+	result.MinDepth = minDepth
+	result.MaxDepth = maxDepth
+}
+
+// viewportNDCDepthMin returns the depth, in normalized device coordinates,
+// that maps to MinDepth. MaxDepth always corresponds to 1.
+func viewportNDCDepthMin[T Float](clip ClipSpace) T {
+	if clip&ClipDepthZeroToOne != 0 {
+		return 0.0
+	}
+	return -1.0
+}
+
+// m4MakeViewport makes the matrix taking normalized device coordinates to
+// window coordinates, so that the window coordinates of a point are
+// m4MakeViewport * viewProj * pnt divided by w.
+func m4MakeViewport[T Float](result *Matrix4Of[T], vp *ViewportOf[T], clip ClipSpace) {
+	zMin := viewportNDCDepthMin[T](clip)
+	halfW := 0.5 * vp.Width
+	halfH := 0.5 * vp.Height
+	scaleZ := (vp.MaxDepth - vp.MinDepth) / (1.0 - zMin)
+	v4MakeFromElems(&result.col0, halfW, 0.0, 0.0, 0.0)
+	v4MakeFromElems(&result.col1, 0.0, halfH, 0.0, 0.0)
+	v4MakeFromElems(&result.col2, 0.0, 0.0, scaleZ, 0.0)
+	v4MakeFromElems(&result.col3, vp.X+halfW, vp.Y+halfH, vp.MinDepth-zMin*scaleZ, 1.0)
+}
+
+// p3Project returns false, leaving result unchanged, if pnt is on or
+// behind the plane through the eye, where w <= 0.
+func p3Project[T Float](result, pnt *Point3Of[T], viewProj *Matrix4Of[T], vp *ViewportOf[T], clip ClipSpace) bool {
+	var tmpV4_0 Vector4Of[T]
+	m4MulP3(&tmpV4_0, viewProj, pnt)
+	if !(tmpV4_0.W > 0.0) {
+		return false
+	}
+	wInv := 1.0 / tmpV4_0.W
+	zMin := viewportNDCDepthMin[T](clip)
+	x := vp.X + (tmpV4_0.X*wInv+1.0)*0.5*vp.Width
+	y := vp.Y + (tmpV4_0.Y*wInv+1.0)*0.5*vp.Height
+	z := vp.MinDepth + (tmpV4_0.Z*wInv-zMin)/(1.0-zMin)*(vp.MaxDepth-vp.MinDepth)
+	p3MakeFromElems(result, x, y, z)
+	return true
+}
+
+// p3UnprojectWithInverse is p3Unproject given the inverse of viewProj.
+func p3UnprojectWithInverse[T Float](result, win *Point3Of[T], invViewProj *Matrix4Of[T], vp *ViewportOf[T], clip ClipSpace) bool {
+	var ndc, tmpV4_0 Vector4Of[T]
+	zMin := viewportNDCDepthMin[T](clip)
+	x := (win.X-vp.X)/vp.Width*2.0 - 1.0
+	y := (win.Y-vp.Y)/vp.Height*2.0 - 1.0
+	z := zMin + (win.Z-vp.MinDepth)/(vp.MaxDepth-vp.MinDepth)*(1.0-zMin)
+	v4MakeFromElems(&ndc, x, y, z, 1.0)
+	m4MulV4(&tmpV4_0, invViewProj, &ndc)
+	// w is a sum of four products, so it is only known to within a few ulps
+	// of the sum of their magnitudes. Anything smaller, such as w on the far
+	// plane of an infinite projection, is treated as zero. The comparison
+	// also fails for an infinite or NaN w.
+	wScale := abs(invViewProj.col0.W*x) + abs(invViewProj.col1.W*y) +
+		abs(invViewProj.col2.W*z) + abs(invViewProj.col3.W)
+	if !(abs(tmpV4_0.W) > 4.0*machineEpsilon[T]()*wScale) {
+		return false
+	}
+	// With reverse Z, w can be exact but so small that the point overflows.
+	wInv := 1.0 / tmpV4_0.W
+	x, y, z = tmpV4_0.X*wInv, tmpV4_0.Y*wInv, tmpV4_0.Z*wInv
+	if math.IsInf(float64(x), 0) || math.IsInf(float64(y), 0) || math.IsInf(float64(z), 0) {
+		return false
+	}
+	p3MakeFromElems(result, x, y, z)
+	return true
+}
+
+// p3Unproject inverts p3Project. It returns false, leaving result
+// unchanged, if viewProj is singular or win maps to a point at or too near
+// infinity to locate: one where w is lost in rounding error, such as on the
+// far plane of an infinite projection, or whose coordinates overflow.
+func p3Unproject[T Float](result, win *Point3Of[T], viewProj *Matrix4Of[T], vp *ViewportOf[T], clip ClipSpace) bool {
+	var inv Matrix4Of[T]
+	m4Inverse(&inv, viewProj)
+	return p3UnprojectWithInverse(result, win, &inv, vp, clip)
+}
+
+// rayMakeFromViewport builds the ray through window position (winX, winY),
+// starting on the near plane. The second point is unprojected from halfway
+// through the depth range rather than from the far plane, which may be at
+// infinity. Direction is unit length.
+func rayMakeFromViewport[T Float](result *RayOf[T], winX, winY T, viewProj *Matrix4Of[T], vp *ViewportOf[T], clip ClipSpace) bool {
+	var inv Matrix4Of[T]
+	var pnt0, pnt1, win Point3Of[T]
+	var tmpV3_0 Vector3Of[T]
+	zNear, zFar := vp.MinDepth, vp.MaxDepth
+	if clip&ClipReverseZ != 0 {
+		zNear, zFar = zFar, zNear
+	}
+	m4Inverse(&inv, viewProj)
+	p3MakeFromElems(&win, winX, winY, zNear)
+	if !p3UnprojectWithInverse(&pnt0, &win, &inv, vp, clip) {
+		return false
+	}
+	p3MakeFromElems(&win, winX, winY, 0.5*(zNear+zFar))
+	if !p3UnprojectWithInverse(&pnt1, &win, &inv, vp, clip) {
+		return false
+	}
+	p3Sub(&tmpV3_0, &pnt1, &pnt0)
+	if !(tmpV3_0.LengthSqr() > 0.0) {
+		return false
+	}
+	v3Normalize(&result.Direction, &tmpV3_0)
+	p3Copy(&result.Origin, &pnt0)
+	return true
+}
+
+func (vp *ViewportOf[T]) String() string {
+	return fmt.Sprintf("( %f %f %f %f ) depth [ %f %f ]\n", vp.X, vp.Y, vp.Width, vp.Height, vp.MinDepth, vp.MaxDepth)
+}
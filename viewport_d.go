@@ -0,0 +1,32 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+type Viewportd = ViewportOf[float64]
+
+func ViewportdMake(result *Viewportd, x, y, width, height, minDepth, maxDepth float64) {
+	viewportMake(result, x, y, width, height, minDepth, maxDepth)
+}
+
+func M4dMakeViewport(result *Matrix4d, vp *Viewportd, clip ClipSpace) {
+	m4MakeViewport(result, vp, clip)
+}
+
+/*******/
+
+func P3dProject(result, pnt *Point3d, viewProj *Matrix4d, vp *Viewportd, clip ClipSpace) bool {
+	return p3Project(result, pnt, viewProj, vp, clip)
+}
+
+func P3dUnproject(result, win *Point3d, viewProj *Matrix4d, vp *Viewportd, clip ClipSpace) bool {
+	return p3Unproject(result, win, viewProj, vp, clip)
+}
+
+func P3dUnprojectWithInverse(result, win *Point3d, invViewProj *Matrix4d, vp *Viewportd, clip ClipSpace) bool {
+	return p3UnprojectWithInverse(result, win, invViewProj, vp, clip)
+}
+
+func RaydMakeFromViewport(result *Rayd, winX, winY float64, viewProj *Matrix4d, vp *Viewportd, clip ClipSpace) bool {
+	return rayMakeFromViewport(result, winX, winY, viewProj, vp, clip)
+}
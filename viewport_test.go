@@ -0,0 +1,171 @@
+// Copyright (c) 2012 James Helferty
+// All rights reserved.
+
+package vectormath
+
+import (
+	"math"
+	"testing"
+)
+
+// The camera for the viewport tests looks down and to the side, so that
+// no axis of the view lines up with the world's.
+var (
+	testCameraEye    = Point3{X: 1.0, Y: 2.0, Z: 10.0}
+	testCameraTarget = Point3{X: 0.5, Y: -1.0, Z: 0.0}
+)
+
+func TestViewportProjectRoundTrip(t *testing.T) {
+	var vp Viewport
+	ViewportMake(&vp, 10.0, 20.0, 640.0, 400.0, 0.0, 1.0)
+	pnts := []Point3{{X: 0.5, Y: -1.0, Z: 0.0}, {X: 2.0, Y: 1.0, Z: 5.0}, {X: -3.0, Y: 0.0, Z: -20.0}}
+	for _, clip := range []ClipSpace{ClipOpenGL, ClipDirect3D, ClipVulkan | ClipReverseZ, ClipLeftHanded} {
+		viewProj := makeTestViewProj(testCameraEye, testCameraTarget, 1.0, 1.6, 0.5, 100.0, clip)
+		var viewportMat, full Matrix4
+		M4MakeViewport(&viewportMat, &vp, clip)
+		M4Mul(&full, &viewportMat, &viewProj)
+		for _, pnt := range pnts {
+			var win, back Point3
+			var winH Vector4
+			if !P3Project(&win, &pnt, &viewProj, &vp, clip) {
+				t.Errorf("clip %#x: P3Project(%v) failed", clip, pnt)
+				continue
+			}
+			// M4MakeViewport agrees with P3Project.
+			M4MulP3(&winH, &full, &pnt)
+			want := Point3{X: winH.X / winH.W, Y: winH.Y / winH.W, Z: winH.Z / winH.W}
+			if !near(win.X, want.X, 1e-3) || !near(win.Y, want.Y, 1e-3) || !near(win.Z, want.Z, 1e-5) {
+				t.Errorf("clip %#x: P3Project(%v) = %v, the viewport matrix gives %v", clip, pnt, win, want)
+			}
+			if !P3Unproject(&back, &win, &viewProj, &vp, clip) || !p3Near(&back, &pnt, 1e-3) {
+				t.Errorf("clip %#x: P3Unproject(P3Project(%v)) = %v", clip, pnt, back)
+			}
+		}
+	}
+}
+
+func TestViewportProjectDepthRange(t *testing.T) {
+	var vp Viewport
+	var win Point3
+	ViewportMake(&vp, 0.0, 0.0, 100.0, 100.0, 0.25, 0.75)
+	for _, clip := range allClipSpaces() {
+		viewProj := makeTestViewProj(testCameraEye, testCameraTarget, 1.0, 1.6, 0.5, 100.0, clip)
+		// The centre of the view at the near and far planes, on the line
+		// from the eye through the target.
+		var dir Vector3
+		P3Sub(&dir, &testCameraTarget, &testCameraEye)
+		V3Normalize(&dir, &dir)
+		wantNear, wantFar := vp.MinDepth, vp.MaxDepth
+		if clip&ClipReverseZ != 0 {
+			wantNear, wantFar = wantFar, wantNear
+		}
+		for _, c := range []struct {
+			dist, want float32
+		}{{0.5, wantNear}, {100.0, wantFar}} {
+			var pnt Point3
+			var offset Vector3
+			V3ScalarMul(&offset, &dir, c.dist)
+			P3AddV3(&pnt, &testCameraEye, &offset)
+			if !P3Project(&win, &pnt, &viewProj, &vp, clip) {
+				t.Errorf("clip %#x: P3Project(%v) failed", clip, pnt)
+				continue
+			}
+			if !near(win.X, 50.0, 1e-3) || !near(win.Y, 50.0, 1e-3) || !near(win.Z, c.want, 1e-4) {
+				t.Errorf("clip %#x: the view centre at distance %v projects to %v, want (50, 50, %v)", clip, c.dist, win, c.want)
+			}
+		}
+	}
+}
+
+func TestViewportProjectBehindEye(t *testing.T) {
+	var vp Viewport
+	ViewportMake(&vp, 0.0, 0.0, 100.0, 100.0, 0.0, 1.0)
+	viewProj := makeTestViewProj(testCameraEye, testCameraTarget, 1.0, 1.6, 0.5, 100.0, ClipOpenGL)
+	result := Point3{X: 7.0, Y: 7.0, Z: 7.0}
+	for _, pnt := range []Point3{{X: 1.0, Y: 2.0, Z: 10.0}, {X: 1.5, Y: 5.0, Z: 20.0}} {
+		if P3Project(&result, &pnt, &viewProj, &vp, ClipOpenGL) {
+			t.Errorf("P3Project(%v) succeeded for a point not in front of the eye", pnt)
+		}
+	}
+	if result != (Point3{X: 7.0, Y: 7.0, Z: 7.0}) {
+		t.Errorf("P3Project changed result on failure: %v", result)
+	}
+}
+
+func TestViewportUnprojectInfiniteFar(t *testing.T) {
+	var vp Viewport
+	inf := float32(math.Inf(1))
+	ViewportMake(&vp, 0.0, 0.0, 800.0, 600.0, 0.0, 1.0)
+	for _, clip := range []ClipSpace{ClipOpenGL, ClipDirect3D, ClipDirect3D | ClipReverseZ, ClipVulkan | ClipReverseZ} {
+		viewProj := makeTestViewProj(testCameraEye, testCameraTarget, 1.0, 1.6, 0.5, inf, clip)
+		farDepth := vp.MaxDepth
+		if clip&ClipReverseZ != 0 {
+			farDepth = vp.MinDepth
+		}
+		for _, winXY := range [][2]float32{{400.0, 300.0}, {0.0, 0.0}, {123.0, 567.0}, {799.0, 1.0}} {
+			result := Point3{X: 7.0, Y: 7.0, Z: 7.0}
+			win := Point3{X: winXY[0], Y: winXY[1], Z: farDepth}
+			if P3Unproject(&result, &win, &viewProj, &vp, clip) {
+				t.Errorf("clip %#x: P3Unproject(%v) on the infinite far plane = %v, want failure", clip, win, result)
+			} else if result != (Point3{X: 7.0, Y: 7.0, Z: 7.0}) {
+				t.Errorf("clip %#x: P3Unproject changed result on failure: %v", clip, result)
+			}
+		}
+
+		// A depth read back from a depth buffer may be an ulp or two short of
+		// the far plane. Without reverse Z, w is then rounding error rather
+		// than a distance; with it, the depth is a denormal and the point
+		// is too far away to represent.
+		nearlyFar := farDepth
+		for i := 0; i < 2; i++ {
+			nearlyFar = math.Nextafter32(nearlyFar, 0.5)
+			result := Point3{X: 7.0, Y: 7.0, Z: 7.0}
+			win := Point3{X: 400.0, Y: 300.0, Z: nearlyFar}
+			if P3Unproject(&result, &win, &viewProj, &vp, clip) {
+				t.Errorf("clip %#x: P3Unproject(%v) just short of the infinite far plane = %v, want failure", clip, win, result)
+			}
+		}
+
+		// Points a long way off, but short of infinity, still round-trip.
+		var win, back Point3
+		pnt := Point3{X: -50.0, Y: -300.0, Z: -1000.0}
+		if !P3Project(&win, &pnt, &viewProj, &vp, clip) {
+			t.Errorf("clip %#x: P3Project(%v) failed", clip, pnt)
+			continue
+		}
+		if !P3Unproject(&back, &win, &viewProj, &vp, clip) || !p3Near(&back, &pnt, 5.0) {
+			t.Errorf("clip %#x: P3Unproject(P3Project(%v)) = %v", clip, pnt, back)
+		}
+	}
+}
+
+func TestRayMakeFromViewport(t *testing.T) {
+	var vp Viewport
+	var rayOut Ray
+	ViewportMake(&vp, 0.0, 0.0, 800.0, 600.0, 0.0, 1.0)
+	target := Point3{X: 2.0, Y: -1.0, Z: 3.0}
+	for _, clip := range []ClipSpace{ClipOpenGL, ClipVulkan | ClipReverseZ, ClipDirect3D | ClipLeftHanded} {
+		for _, zFar := range []float32{100.0, float32(math.Inf(1))} {
+			viewProj := makeTestViewProj(testCameraEye, testCameraTarget, 1.0, 1.6, 0.5, zFar, clip)
+			var win Point3
+			if !P3Project(&win, &target, &viewProj, &vp, clip) {
+				t.Errorf("clip %#x: P3Project(%v) failed", clip, target)
+				continue
+			}
+			if !RayMakeFromViewport(&rayOut, win.X, win.Y, &viewProj, &vp, clip) {
+				t.Errorf("clip %#x, far %v: RayMakeFromViewport failed", clip, zFar)
+				continue
+			}
+			if !near(rayOut.Direction.Length(), 1.0, 1e-6) {
+				t.Errorf("clip %#x, far %v: direction %v is not unit length", clip, zFar, rayOut.Direction)
+			}
+			// The ray passes through the eye's line of sight to target.
+			var toTarget, cross Vector3
+			P3Sub(&toTarget, &target, &rayOut.Origin)
+			V3Cross(&cross, &toTarget, &rayOut.Direction)
+			if cross.Length() > 1e-3 || V3Dot(&toTarget, &rayOut.Direction) <= 0.0 {
+				t.Errorf("clip %#x, far %v: ray %v misses %v", clip, zFar, rayOut, target)
+			}
+		}
+	}
+}